@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
@@ -35,6 +38,10 @@ func Run() {
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 	)
 
+	if enabled, err := strconv.ParseBool(os.Getenv("UNVERIFIED_PLAN")); err == nil {
+		model.UnverifiedPlanEnabled = enabled
+	}
+
 	grpcMetrics := grpc_prometheus.NewClientMetrics()
 	registry.MustRegister(grpcMetrics)
 
@@ -154,6 +161,12 @@ func Run() {
 		return
 	}
 
+	alertsCtx, cancelAlerts := context.WithCancel(context.Background())
+	defer cancelAlerts()
+	go profilesHandler.SearchAlertsUC.RunSearchAlertsJob(alertsCtx, model.SearchAlertsInterval)
+	go profilesHandler.MatchExpiryUC.RunMatchExpiryJob(alertsCtx, model.MatchExpiryInterval)
+	go profilesHandler.OutboxRelayUC.RunOutboxJob(alertsCtx, model.OutboxInterval)
+
 	r := mux.NewRouter()
 
 	metricsMiddleware := NewMetricsMiddleware(MetricsMiddlewareConfig{Registry: registry})
@@ -170,6 +183,9 @@ func Run() {
 	usersSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
 	usersSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizeStr)))
 
+	usersSubrouter.HandleFunc("/pause", usersHandler.GetPause).Methods("GET")
+	usersSubrouter.HandleFunc("/pause", usersHandler.PauseAccount).Methods("POST")
+	usersSubrouter.HandleFunc("/pause", usersHandler.ResumeAccount).Methods("DELETE")
 	usersSubrouter.HandleFunc("/{id}", usersHandler.DeleteUser).Methods("DELETE")
 	usersSubrouter.HandleFunc("/checkSession", sessionHandler.CheckSession).Methods("GET")
 	usersSubrouter.HandleFunc("/getParams", usersHandler.GetUserParams).Methods("GET")
@@ -181,12 +197,53 @@ func Run() {
 	profileSubrouter.HandleFunc("", profilesHandler.GetProfiles).Methods("GET")
 	profileSubrouter.HandleFunc("/like", profilesHandler.SetLike).Methods("POST")
 	profileSubrouter.HandleFunc("/match/{id}", profilesHandler.GetMatches).Methods("GET")
+	profileSubrouter.HandleFunc("/match/{id}", profilesHandler.Unmatch).Methods("DELETE")
+	profileSubrouter.HandleFunc("/match/{id}/extend", profilesHandler.ExtendMatch).Methods("POST")
+	profileSubrouter.HandleFunc("/matches/pending", profilesHandler.GetPendingMatches).Methods("GET")
+	profileSubrouter.HandleFunc("/second-look", profilesHandler.GetSecondLook).Methods("GET")
+	profileSubrouter.HandleFunc("/second-look", profilesHandler.SetSecondLook).Methods("POST")
+	profileSubrouter.HandleFunc("/blocks", profilesHandler.GetBlocks).Methods("GET")
+	profileSubrouter.HandleFunc("/blocks/{id}", profilesHandler.BlockProfile).Methods("POST")
+	profileSubrouter.HandleFunc("/blocks/{id}", profilesHandler.UnblockProfile).Methods("DELETE")
 	profileSubrouter.HandleFunc("/update", profilesHandler.UpdateProfile).Methods("POST")
 	profileSubrouter.HandleFunc("/search", profilesHandler.SearchProfiles).Methods("POST")
 	profileSubrouter.HandleFunc("/recommendations", profilesHandler.GetRecommendations).Methods("GET")
 	profileSubrouter.HandleFunc("/getStatistics", profilesHandler.GetStatistics).Methods("GET")
+	profileSubrouter.HandleFunc("/topPicks", profilesHandler.GetTopPicks).Methods("GET")
+	profileSubrouter.HandleFunc("/likes", profilesHandler.GetIncomingLikes).Methods("GET")
+	profileSubrouter.HandleFunc("/boost", profilesHandler.ActivateBoost).Methods("POST")
+	profileSubrouter.HandleFunc("/rewind", profilesHandler.RewindLike).Methods("POST")
+	profileSubrouter.HandleFunc("/savedSearches", profilesHandler.SaveSearch).Methods("POST")
+	profileSubrouter.HandleFunc("/savedSearches", profilesHandler.GetSavedSearches).Methods("GET")
+	profileSubrouter.HandleFunc("/savedSearches/{id}", profilesHandler.DeleteSavedSearch).Methods("DELETE")
+	profileSubrouter.HandleFunc("/photos", profilesHandler.GetPhotoURLs).Methods("GET")
+	profileSubrouter.HandleFunc("/photos/uploads", profilesHandler.CreatePhotoUpload).Methods("POST")
+	profileSubrouter.HandleFunc("/photos/uploads/finalize", profilesHandler.FinalizePhotoUpload).Methods("POST")
+	profileSubrouter.HandleFunc("/photos/order", profilesHandler.ReorderPhotos).Methods("POST")
+	profileSubrouter.HandleFunc("/photos/primary", profilesHandler.SetPrimaryPhoto).Methods("POST")
+	profileSubrouter.HandleFunc("/moderation/photos", profilesHandler.GetModerationQueue).Methods("GET")
+	profileSubrouter.HandleFunc("/moderation/photos/{id}", profilesHandler.ModeratePhoto).Methods("POST")
+	profileSubrouter.HandleFunc("/moderation/clusters", profilesHandler.GetPhotoClusters).Methods("GET")
+	profileSubrouter.HandleFunc("/verification", profilesHandler.GetVerification).Methods("GET")
+	profileSubrouter.HandleFunc("/verification", profilesHandler.StartVerification).Methods("POST")
+	profileSubrouter.HandleFunc("/verification/submit", profilesHandler.SubmitVerification).Methods("POST")
+	profileSubrouter.HandleFunc("/moderation/verifications", profilesHandler.GetVerificationQueue).Methods("GET")
+	profileSubrouter.HandleFunc("/moderation/verifications/{id}", profilesHandler.ReviewVerification).Methods("POST")
+	profileSubrouter.HandleFunc("/prompts", profilesHandler.GetPrompts).Methods("GET")
+	profileSubrouter.HandleFunc("/moderation/profiles/{id}/history", profilesHandler.GetProfileHistory).Methods("GET")
+	profileSubrouter.HandleFunc("/moderation/profiles/{id}/revert", profilesHandler.RevertProfile).Methods("POST")
 	profileSubrouter.HandleFunc("/{id}", profilesHandler.GetProfile).Methods("GET")
 
+	quotaSubrouter := r.PathPrefix("/quota").Subrouter()
+	quotaSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
+
+	quotaSubrouter.HandleFunc("", profilesHandler.GetQuota).Methods("GET")
+
+	autocompleteSubrouter := r.PathPrefix("/autocomplete").Subrouter()
+	autocompleteSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
+
+	autocompleteSubrouter.HandleFunc("/{dictionary}", profilesHandler.Autocomplete).Methods("GET")
+
 	photoSubrouter := r.PathPrefix("/profiles").Subrouter()
 	photoSubrouter.Use(AuthWithCSRFMiddleware(tokenValidator, sessionHandler, usersHandler))
 	photoSubrouter.Use(BodySizeLimitMiddleware(int64(model.Megabyte * model.MaxQuerySizePhoto)))
@@ -244,6 +301,7 @@ func Run() {
 
 	subscriptionSubrouter.HandleFunc("", subscripHandler.AddSubscription).Methods("POST")
 	subscriptionSubrouter.HandleFunc("/changeborder", subscripHandler.ChangeBorder).Methods("POST")
+	subscriptionSubrouter.HandleFunc("/incognito", subscripHandler.SetIncognito).Methods("POST")
 
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins: []string{
@@ -254,7 +312,8 @@ func Run() {
 			"http://beameye.ru",
 		},
 		AllowedMethods:   []string{"GET", "POST", "DELETE", "PUT"},
-		AllowedHeaders:   []string{"Origin", "Content-Length", "Content-Type", "Authorization"},
+		AllowedHeaders:   []string{"Origin", "Content-Length", "Content-Type", "Authorization", "Idempotency-Key"},
+		ExposedHeaders:   []string{"X-Quota-Kind", "X-Quota-Limit", "X-Quota-Remaining", "X-Quota-Reset"},
 		AllowCredentials: true,
 	})
 
@@ -483,11 +542,6 @@ func NewProfilesHandler(
 		return nil, err
 	}
 
-	GetProfileImages, err := usecase.NewGetUserPhotoUseCase(client, logger)
-	if err != nil {
-		return nil, err
-	}
-
 	GetProfileMatches, err := usecase.NewGetProfileMatchesUseCase(client, logger)
 	if err != nil {
 		return nil, err
@@ -523,7 +577,7 @@ func NewProfilesHandler(
 		return nil, err
 	}
 
-	AddNotification, err := usecase.NewAddNotificationUseCase(notifrepo, logger)
+	OutboxRelay, err := usecase.NewOutboxRelayUseCase(notifrepo, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -543,22 +597,236 @@ func NewProfilesHandler(
 		return nil, err
 	}
 
+	GetTopPicks, err := usecase.NewGetTopPicksUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetIncomingLikes, err := usecase.NewGetIncomingLikesUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ActivateBoost, err := usecase.NewActivateBoostUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	RewindLike, err := usecase.NewRewindLikeUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	quotaRepo, err := repository.NewQuotaRepo(Subscriber)
+	if err != nil {
+		return nil, err
+	}
+
+	Quota, err := usecase.NewQuotaUseCase(quotaRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	SaveSearch, err := usecase.NewSaveSearchUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetSavedSearches, err := usecase.NewGetSavedSearchesUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	DeleteSavedSearch, err := usecase.NewDeleteSavedSearchUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	SearchAlerts, err := usecase.NewSearchAlertsUseCase(client, notifrepo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	Autocomplete, err := usecase.NewAutocompleteUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	CreatePhotoUpload, err := usecase.NewCreatePhotoUploadUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	FinalizePhotoUpload, err := usecase.NewFinalizePhotoUploadUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetPhotoURLs, err := usecase.NewGetPhotoURLsUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ReorderPhotos, err := usecase.NewReorderPhotosUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	SetPrimaryPhoto, err := usecase.NewSetPrimaryPhotoUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetModerationQueue, err := usecase.NewGetModerationQueueUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ModeratePhoto, err := usecase.NewModeratePhotoUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetPhotoClusters, err := usecase.NewGetPhotoClustersUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	StartVerification, err := usecase.NewStartVerificationUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	SubmitVerification, err := usecase.NewSubmitVerificationUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetVerification, err := usecase.NewGetVerificationUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetVerificationQueue, err := usecase.NewGetVerificationQueueUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ReviewVerification, err := usecase.NewReviewVerificationUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetPrompts, err := usecase.NewGetPromptsUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetProfileHistory, err := usecase.NewGetProfileHistoryUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	RevertProfile, err := usecase.NewRevertProfileUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	Unmatch, err := usecase.NewUnmatchUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	BlockProfile, err := usecase.NewBlockProfileUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	UnblockProfile, err := usecase.NewUnblockProfileUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetBlocks, err := usecase.NewGetBlocksUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	MatchExpiry, err := usecase.NewMatchExpiryUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ExtendMatch, err := usecase.NewExtendMatchUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetPendingMatches, err := usecase.NewGetPendingMatchesUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	GetSecondLook, err := usecase.NewGetSecondLookUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	SetSecondLook, err := usecase.NewSetSecondLookUseCase(client, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ProfilesHandler{
-		DeleteImageUC:         *DeleteImage,
-		GetProfileImagesUC:    *GetProfileImages,
-		GetProfileMatchesUC:   *GetProfileMatches,
-		GetProfileUC:          *GetProfile,
-		GetProfilesUC:         *GetProfiles,
-		SetProfilesLikeUC:     *SetProfilesLike,
-		UpdateProfileUC:       *UpdateProfile,
-		UpdateProfileImagesUC: *UpdateProfileImages,
-		Subscriber:            Subscriber,
-		AddNotificationUC:     *AddNotification,
-		GetRecommendationsUC:  *GetRecommendations,
-		GetProfileStatsUC:     *GetProfileStats,
-		SearchProfileUC:       *SearchProfile,
-		GetAdminUC:            *GetAdmin,
-		Logger:                logger,
+		DeleteImageUC:          *DeleteImage,
+		GetProfileMatchesUC:    *GetProfileMatches,
+		GetProfileUC:           *GetProfile,
+		GetProfilesUC:          *GetProfiles,
+		SetProfilesLikeUC:      *SetProfilesLike,
+		UpdateProfileUC:        *UpdateProfile,
+		UpdateProfileImagesUC:  *UpdateProfileImages,
+		Subscriber:             Subscriber,
+		OutboxRelayUC:          *OutboxRelay,
+		GetRecommendationsUC:   *GetRecommendations,
+		GetProfileStatsUC:      *GetProfileStats,
+		GetTopPicksUC:          *GetTopPicks,
+		GetIncomingLikesUC:     *GetIncomingLikes,
+		ActivateBoostUC:        *ActivateBoost,
+		RewindLikeUC:           *RewindLike,
+		QuotaUC:                *Quota,
+		SaveSearchUC:           *SaveSearch,
+		GetSavedSearchesUC:     *GetSavedSearches,
+		DeleteSavedSearchUC:    *DeleteSavedSearch,
+		SearchAlertsUC:         *SearchAlerts,
+		AutocompleteUC:         *Autocomplete,
+		CreatePhotoUploadUC:    *CreatePhotoUpload,
+		FinalizePhotoUploadUC:  *FinalizePhotoUpload,
+		GetPhotoURLsUC:         *GetPhotoURLs,
+		ReorderPhotosUC:        *ReorderPhotos,
+		SetPrimaryPhotoUC:      *SetPrimaryPhoto,
+		GetModerationQueueUC:   *GetModerationQueue,
+		ModeratePhotoUC:        *ModeratePhoto,
+		GetPhotoClustersUC:     *GetPhotoClusters,
+		StartVerificationUC:    *StartVerification,
+		SubmitVerificationUC:   *SubmitVerification,
+		GetVerificationUC:      *GetVerification,
+		GetVerificationQueueUC: *GetVerificationQueue,
+		ReviewVerificationUC:   *ReviewVerification,
+		GetPromptsUC:           *GetPrompts,
+		GetProfileHistoryUC:    *GetProfileHistory,
+		RevertProfileUC:        *RevertProfile,
+		UnmatchUC:              *Unmatch,
+		BlockProfileUC:         *BlockProfile,
+		UnblockProfileUC:       *UnblockProfile,
+		GetBlocksUC:            *GetBlocks,
+		MatchExpiryUC:          *MatchExpiry,
+		ExtendMatchUC:          *ExtendMatch,
+		GetPendingMatchesUC:    *GetPendingMatches,
+		GetSecondLookUC:        *GetSecondLook,
+		SetSecondLookUC:        *SetSecondLook,
+		SearchProfileUC:        *SearchProfile,
+		GetAdminUC:             *GetAdmin,
+		Logger:                 logger,
 	}, nil
 }
 
@@ -636,12 +904,30 @@ func NewUsersHandler(
 		return &UserHandler{}, err
 	}
 
+	GetPauseUC, err := usecase.NewGetPauseUseCase(usersClient, logger)
+	if err != nil {
+		return &UserHandler{}, err
+	}
+
+	PauseAccountUC, err := usecase.NewPauseAccountUseCase(usersClient, logger)
+	if err != nil {
+		return &UserHandler{}, err
+	}
+
+	ResumeAccountUC, err := usecase.NewResumeAccountUseCase(usersClient, logger)
+	if err != nil {
+		return &UserHandler{}, err
+	}
+
 	return &UserHandler{
-		SignupUC:     *SignupUC,
-		DeleteUserUC: *DeleteUserUC,
-		GetParamsUC:  *GetUserParamsUC,
-		GetPremiumUC: *GetPremiumUC,
-		Logger:       logger,
+		SignupUC:        *SignupUC,
+		DeleteUserUC:    *DeleteUserUC,
+		GetParamsUC:     *GetUserParamsUC,
+		GetPremiumUC:    *GetPremiumUC,
+		GetPauseUC:      *GetPauseUC,
+		PauseAccountUC:  *PauseAccountUC,
+		ResumeAccountUC: *ResumeAccountUC,
+		Logger:          logger,
 	}, nil
 }
 
@@ -693,9 +979,14 @@ func NewSubHandler(
 	if err != nil {
 		return nil, err
 	}
+	Incognito, err := usecase.NewSetIncognitoUseCase(subClient, logger)
+	if err != nil {
+		return nil, err
+	}
 	return &SubHandler{
 		AddSubUC:       *AddSubscription,
 		UpdateBorderUC: *UpdateBorder,
+		IncognitoUC:    *Incognito,
 		Logger:         logger,
 	}, nil
 }
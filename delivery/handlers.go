@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
@@ -37,6 +38,7 @@ type SessionHandler struct {
 type SubHandler struct {
 	AddSubUC       usecase.AddSubscription
 	UpdateBorderUC usecase.UpdateBorder
+	IncognitoUC    usecase.SetIncognito
 
 	Logger *logger.LogrusLogger
 }
@@ -46,7 +48,12 @@ type UserHandler struct {
 	DeleteUserUC usecase.DeleteUser
 	GetParamsUC  usecase.UserGetParams
 	GetPremiumUC usecase.GetPremium
-	Logger       *logger.LogrusLogger
+
+	GetPauseUC      usecase.GetPause
+	PauseAccountUC  usecase.PauseAccount
+	ResumeAccountUC usecase.ResumeAccount
+
+	Logger *logger.LogrusLogger
 }
 
 type ComplaintHandler struct {
@@ -63,20 +70,54 @@ type ComplaintHandler struct {
 }
 
 type ProfilesHandler struct {
-	DeleteImageUC         usecase.DeleteStatic
-	GetProfileImagesUC    usecase.GetUserPhoto
-	GetProfileMatchesUC   usecase.GetProfileMatches
-	GetProfileUC          usecase.GetProfile
-	GetProfilesUC         usecase.GetProfilesForUser
-	SetProfilesLikeUC     usecase.ProfileSetLike
-	UpdateProfileUC       usecase.ProfileUpdate
-	UpdateProfileImagesUC usecase.StaticUpload
-	SearchProfileUC       usecase.SearchProfiles
-	AddNotificationUC     usecase.AddNotification
-	Subscriber            *redis.Client
-	GetAdminUC            usecase.GetAdmin
-	GetRecommendationsUC  usecase.GetRecommendations
-	GetProfileStatsUC     usecase.GetProfileStats
+	DeleteImageUC          usecase.DeleteStatic
+	GetProfileMatchesUC    usecase.GetProfileMatches
+	GetProfileUC           usecase.GetProfile
+	GetProfilesUC          usecase.GetProfilesForUser
+	SetProfilesLikeUC      usecase.ProfileSetLike
+	UpdateProfileUC        usecase.ProfileUpdate
+	UpdateProfileImagesUC  usecase.StaticUpload
+	SearchProfileUC        usecase.SearchProfiles
+	OutboxRelayUC          usecase.OutboxRelay
+	Subscriber             *redis.Client
+	GetAdminUC             usecase.GetAdmin
+	GetRecommendationsUC   usecase.GetRecommendations
+	GetProfileStatsUC      usecase.GetProfileStats
+	GetTopPicksUC          usecase.GetTopPicks
+	GetIncomingLikesUC     usecase.GetIncomingLikes
+	ActivateBoostUC        usecase.ActivateBoost
+	RewindLikeUC           usecase.RewindLike
+	QuotaUC                usecase.Quota
+	SaveSearchUC           usecase.SaveSearch
+	GetSavedSearchesUC     usecase.GetSavedSearches
+	DeleteSavedSearchUC    usecase.DeleteSavedSearch
+	SearchAlertsUC         usecase.SearchAlerts
+	AutocompleteUC         usecase.Autocomplete
+	CreatePhotoUploadUC    usecase.CreatePhotoUpload
+	FinalizePhotoUploadUC  usecase.FinalizePhotoUpload
+	GetPhotoURLsUC         usecase.GetPhotoURLs
+	ReorderPhotosUC        usecase.ReorderPhotos
+	SetPrimaryPhotoUC      usecase.SetPrimaryPhoto
+	GetModerationQueueUC   usecase.GetModerationQueue
+	ModeratePhotoUC        usecase.ModeratePhoto
+	GetPhotoClustersUC     usecase.GetPhotoClusters
+	StartVerificationUC    usecase.StartVerification
+	SubmitVerificationUC   usecase.SubmitVerification
+	GetVerificationUC      usecase.GetVerification
+	GetVerificationQueueUC usecase.GetVerificationQueue
+	ReviewVerificationUC   usecase.ReviewVerification
+	GetPromptsUC           usecase.GetPrompts
+	GetProfileHistoryUC    usecase.GetProfileHistory
+	RevertProfileUC        usecase.RevertProfile
+	UnmatchUC              usecase.Unmatch
+	BlockProfileUC         usecase.BlockProfile
+	UnblockProfileUC       usecase.UnblockProfile
+	GetBlocksUC            usecase.GetBlocks
+	MatchExpiryUC          usecase.MatchExpiry
+	ExtendMatchUC          usecase.ExtendMatch
+	GetPendingMatchesUC    usecase.GetPendingMatches
+	GetSecondLookUC        usecase.GetSecondLook
+	SetSecondLookUC        usecase.SetSecondLook
 
 	Logger *logger.LogrusLogger
 }
@@ -450,27 +491,32 @@ func (mh *MessageHandler) HandleChat(w http.ResponseWriter, r *http.Request) {
 				recieverID = second
 			}
 
-			notif := model.NotificationSend{
-				NotifType: "message",
-				Content:   fmt.Sprintf("User %d sent you a message!", payload.UserID),
-				Read:      0,
-			}
-
-			err := mh.AddNotificationUC.AddNotification(recieverID, notif)
-			if err != nil {
-				fmt.Println(err)
-				mh.Logger.Error("Failed to save notification: ", err)
-				conn.WriteJSON(map[string]interface{}{"error": "Failed to notify"})
-				return
-			}
-
 			go func(payload model.CreatePayload) {
 				messageID, err := mh.CreateMessagesUC.CreateMessages(payload.ChatID, payload.UserID, payload.Content)
+				if err == model.ErrChatReadOnly {
+					conn.WriteJSON(map[string]interface{}{"error": "Chat is read-only"})
+					return
+				}
+				if err == model.ErrProfileBlocked {
+					conn.WriteJSON(map[string]interface{}{"error": "You can't message this user"})
+					return
+				}
 				if err != nil {
 					mh.Logger.Error("Failed to create message: ", err)
 					conn.WriteJSON(map[string]interface{}{"error": "Failed to create message"})
 					return
 				}
+
+				notif := model.NotificationSend{
+					NotifType: "message",
+					Content:   fmt.Sprintf("User %d sent you a message!", payload.UserID),
+					Read:      0,
+				}
+				if err := mh.AddNotificationUC.AddNotification(recieverID, notif); err != nil {
+					mh.Logger.Error("Failed to save notification: ", err)
+					conn.WriteJSON(map[string]interface{}{"error": "Failed to notify"})
+				}
+
 				conn.WriteJSON(map[string]interface{}{"type": "created", "message_id": messageID})
 			}(payload)
 
@@ -583,6 +629,18 @@ func (mh *MessageHandler) CreateChat(w http.ResponseWriter, r *http.Request) {
 	}
 
 	chatID, err := mh.CreateChatUC.CreateChat(req.FristID, req.SecondID)
+	if err == model.ErrProfileBlocked {
+		MakeEasyJSONResponse(w, http.StatusForbidden,
+			&model.ErrorResponse{Message: "You can't chat with this user"},
+		)
+		return
+	}
+	if err == model.ErrChatExists {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Chat already exists"},
+		)
+		return
+	}
 	if err != nil {
 		mh.Logger.WithFields(&logrus.Fields{
 			"FirstID":  req.FristID,
@@ -763,6 +821,20 @@ func (ph *ProfilesHandler) UpdateProfile(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if profile.Timezone != "" {
+		if _, err := time.LoadLocation(profile.Timezone); err != nil || profile.Timezone == "Local" {
+			ph.Logger.WithFields(&logrus.Fields{
+				"timezone":   profile.Timezone,
+				"profile_id": profileId,
+			}).Warn("invalid timezone")
+
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid timezone"},
+			)
+			return
+		}
+	}
+
 	profile.ProfileId = int(profileId)
 
 	table_profile, err := ph.GetProfileUC.GetProfile(int(profileId))
@@ -779,6 +851,12 @@ func (ph *ProfilesHandler) UpdateProfile(w http.ResponseWriter, r *http.Request)
 	}
 
 	err = ph.UpdateProfileUC.UpdateProfile(profile, table_profile, int(profileId))
+	if err == model.ErrInvalidPrompts {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid prompts: answer at most 3 different catalog prompts, 300 characters each"},
+		)
+		return
+	}
 	if err != nil {
 		ph.Logger.WithFields(&logrus.Fields{
 			"profile_id":   profileId,
@@ -898,7 +976,13 @@ func (ph *ProfilesHandler) SearchProfiles(w http.ResponseWriter, r *http.Request
 		)
 		return
 	}
-	profiles, err := ph.SearchProfileUC.GetSearchProfiles(int(profileId), input)
+	found, err := ph.SearchProfileUC.GetSearchProfiles(int(profileId), input)
+	if err == model.ErrInvalidSearchRequest {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid cursor, sort key or filter"},
+		)
+		return
+	}
 	if err != nil {
 		ph.Logger.WithFields(&logrus.Fields{
 			"requester_id": profileId,
@@ -911,7 +995,7 @@ func (ph *ProfilesHandler) SearchProfiles(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	if len(profiles) == 0 {
+	if len(found.Profiles) == 0 {
 		MakeEasyJSONResponse(w, http.StatusAccepted,
 			&model.ErrorResponse{Message: "There are no profiles"},
 		)
@@ -920,10 +1004,10 @@ func (ph *ProfilesHandler) SearchProfiles(w http.ResponseWriter, r *http.Request
 
 	ph.Logger.WithFields(&logrus.Fields{
 		"requester_id":   profileId,
-		"profiles_count": len(profiles),
+		"profiles_count": len(found.Profiles),
 	}).Info("profiles list retrieved successfully")
 
-	MakeEasyJSONResponse(w, http.StatusOK, model.FoundProfileResponse{Profiles: profiles})
+	MakeEasyJSONResponse(w, http.StatusOK, found)
 }
 
 func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
@@ -992,31 +1076,116 @@ func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if (!IsPremium) && (status == 3) {
+	if int(profileId) != likeFrom {
 		ph.Logger.WithFields(&logrus.Fields{
 			"profile_id": profileId,
 			"like_from":  likeFrom,
-		}).Warn("no premium")
+		}).Warn("unauthorized like attempt")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "You are unauthorized to like this user"},
+		)
+		return
+	}
 
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+	if utf8.RuneCountInString(idempotencyKey) > model.MaxIdempotencyKeyLength {
 		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "You cannot use superlike with no subscription"},
+			&model.ErrorResponse{Message: fmt.Sprintf("Idempotency-Key must be at most %d characters", model.MaxIdempotencyKeyLength)},
 		)
 		return
 	}
 
-	if int(profileId) != likeFrom {
+	hasComment := input.Comment != nil && strings.TrimSpace(input.Comment.Text) != ""
+	if hasComment && (status == 2 || utf8.RuneCountInString(strings.TrimSpace(input.Comment.Text)) > model.MaxLikeCommentLength) {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: fmt.Sprintf("Only likes can carry a comment, of at most %d characters", model.MaxLikeCommentLength)},
+		)
+		return
+	}
+
+	quotaKind := model.QuotaSwipes
+	if status == 3 {
+		quotaKind = model.QuotaSuperlikes
+	}
+
+	plan := ph.quotaPlan(r, IsPremium)
+
+	quota, err := ph.QuotaUC.Consume(likeFrom, plan, quotaKind)
+	if err == model.ErrQuotaExceeded {
 		ph.Logger.WithFields(&logrus.Fields{
 			"profile_id": profileId,
 			"like_from":  likeFrom,
-		}).Warn("unauthorized like attempt")
+			"quota":      quotaKind,
+		}).Warn("quota exceeded")
 
-		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "You are unauthorized to like this user"},
+		SetQuotaHeaders(w, quota)
+		if quotaKind == model.QuotaSuperlikes && quota.Limit == 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "You cannot use superlike with no subscription"},
+			)
+			return
+		}
+		MakeEasyJSONResponse(w, http.StatusTooManyRequests,
+			&model.ErrorResponse{Message: fmt.Sprintf("You have no %s left", quotaKind)},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to check quota")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Error checking quota"},
 		)
 		return
 	}
+	SetQuotaHeaders(w, quota)
+
+	if hasComment {
+		commentQuota, err := ph.QuotaUC.Consume(likeFrom, plan, model.QuotaComments)
+		if err != nil {
+			_ = ph.QuotaUC.Refund(likeFrom, plan, quotaKind)
+		}
+		if err == model.ErrQuotaExceeded {
+			SetQuotaHeaders(w, commentQuota)
+			if commentQuota.Limit == 0 {
+				MakeEasyJSONResponse(w, http.StatusBadRequest,
+					&model.ErrorResponse{Message: "You cannot comment on likes with no subscription"},
+				)
+				return
+			}
+			MakeEasyJSONResponse(w, http.StatusTooManyRequests,
+				&model.ErrorResponse{Message: fmt.Sprintf("You have no %s left", model.QuotaComments)},
+			)
+			return
+		}
+		if err != nil {
+			ph.Logger.WithFields(&logrus.Fields{
+				"profile_id": profileId,
+				"error":      err.Error(),
+			}).Error("failed to check quota")
+
+			MakeEasyJSONResponse(w, http.StatusInternalServerError,
+				&model.ErrorResponse{Message: "Error checking quota"},
+			)
+			return
+		}
+	}
 
-	like_id, err := ph.SetProfilesLikeUC.SetLike(likeFrom, likeTo, status)
+	result, err := ph.SetProfilesLikeUC.SetLike(likeFrom, likeTo, status, input.Comment, idempotencyKey)
+	like_id := result.LikeId
+	// A replayed like was paid for by the request it repeats. Every try is
+	// charged up front, so concurrent retries cannot slip past the quota,
+	// and all but the one that stored the like get it back.
+	if (like_id == 0) || (err != nil) || result.Replayed {
+		_ = ph.QuotaUC.Refund(likeFrom, plan, quotaKind)
+		if hasComment {
+			_ = ph.QuotaUC.Refund(likeFrom, plan, model.QuotaComments)
+		}
+	}
 	if (like_id == 0) && (err == nil) {
 		ph.Logger.WithFields(&logrus.Fields{
 			"like_from": likeFrom,
@@ -1028,6 +1197,30 @@ func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
 		)
 		return
 	}
+	if err == model.ErrProfileBlocked {
+		MakeEasyJSONResponse(w, http.StatusForbidden,
+			&model.ErrorResponse{Message: "You can't like this user"},
+		)
+		return
+	}
+	if err == model.ErrInvalidLikeComment {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid comment: it can point at one photo or prompt of the profile"},
+		)
+		return
+	}
+	if err == model.ErrInvalidIdempotencyKey {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: fmt.Sprintf("Idempotency-Key must be at most %d characters", model.MaxIdempotencyKeyLength)},
+		)
+		return
+	}
+	if err == model.ErrIdempotencyKeyReused {
+		MakeEasyJSONResponse(w, http.StatusUnprocessableEntity,
+			&model.ErrorResponse{Message: "Idempotency-Key was already used for another like"},
+		)
+		return
+	}
 	if err != nil {
 		ph.Logger.WithFields(&logrus.Fields{
 			"like_from": likeFrom,
@@ -1041,44 +1234,32 @@ func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if like_id == -1 {
-		recieverID := likeTo
-
-		notif := model.NotificationSend{
-			NotifType: "match",
-			Content:   fmt.Sprintf("You have matched with user %d!", likeFrom),
-			Read:      0,
-		}
-
-		err := ph.AddNotificationUC.AddNotification(recieverID, notif)
-		if err != nil {
-			ph.Logger.Error("Failed to save notification: ", err)
-			return
-		}
-
-		recieverID = likeFrom
+	// A match is announced, and its chat opened, by the outbox relay.
+	ph.dropCachedProfile(int(profileId), likeTo)
 
-		notif = model.NotificationSend{
-			NotifType: "match",
-			Content:   fmt.Sprintf("You have natched with user %d!", likeTo),
-			Read:      0,
-		}
+	ph.Logger.WithFields(&logrus.Fields{
+		"like_id":   like_id,
+		"like_from": likeFrom,
+		"like_to":   likeTo,
+		"status":    status,
+		"replayed":  result.Replayed,
+	}).Info("like successfully processed")
 
-		err = ph.AddNotificationUC.AddNotification(recieverID, notif)
-		if err != nil {
-			ph.Logger.Error("Failed to save notification: ", err)
-			return
-		}
-	}
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Liked"},
+	)
+}
 
+// dropCachedProfile removes a profile the user has just rated from their
+// cached feed. The cache is only an optimisation, so failures are logged.
+func (ph *ProfilesHandler) dropCachedProfile(profileId int, likedId int) {
 	redisKey := fmt.Sprintf("cached_profiles:%d", profileId)
 
 	cachedData, err := ph.Subscriber.Get(context.Background(), redisKey).Result()
 	if err != nil {
-		if err == redis.Nil {
-			return
+		if err != redis.Nil {
+			ph.Logger.WithError(err).Error("failed to get cached profiles")
 		}
-		ph.Logger.WithError(err).Error("failed to get cached profiles")
 		return
 	}
 
@@ -1090,7 +1271,7 @@ func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
 
 	filtered := make([]model.Profile, 0, len(profiles))
 	for _, p := range profiles {
-		if p.ProfileId != likeTo {
+		if p.ProfileId != likedId {
 			filtered = append(filtered, p)
 		}
 	}
@@ -1112,17 +1293,6 @@ func (ph *ProfilesHandler) SetLike(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		ph.Logger.WithError(err).Error("failed to update cached profiles in redis")
 	}
-
-	ph.Logger.WithFields(&logrus.Fields{
-		"like_id":   like_id,
-		"like_from": likeFrom,
-		"like_to":   likeTo,
-		"status":    status,
-	}).Info("like successfully processed")
-
-	MakeEasyJSONResponse(w, http.StatusOK,
-		&model.ErrorResponse{Message: "Liked"},
-	)
 }
 
 func CreateCookies(session model.Session) (*model.Cookie, error) {
@@ -1233,17 +1403,6 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 		}
 		defer file.Close()
 
-		sanitizedType := sanitizer.Sanitize(contentType)
-		if !allowedTypes[sanitizedType] {
-			ph.Logger.WithFields(&logrus.Fields{
-				"file_name":    fileName,
-				"content_type": sanitizedType,
-			}).Warn("unsupported file type")
-
-			failedUploads = append(failedUploads, fileName+" (unsupported type)")
-			continue
-		}
-
 		buf, err := io.ReadAll(file)
 		if err != nil {
 			ph.Logger.WithFields(&logrus.Fields{
@@ -1255,6 +1414,20 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
+		// The client Content-Type is only logged; the type is sniffed from
+		// the magic bytes.
+		sniffedType := http.DetectContentType(buf)
+		if !allowedTypes[sniffedType] {
+			ph.Logger.WithFields(&logrus.Fields{
+				"file_name":    fileName,
+				"content_type": sanitizer.Sanitize(contentType),
+				"sniffed_type": sniffedType,
+			}).Warn("unsupported file type")
+
+			failedUploads = append(failedUploads, fileName+" (unsupported type)")
+			continue
+		}
+
 		filename := fmt.Sprintf("/%d_%d_%s", user_id, time.Now().UnixNano(), fileName)
 
 		ph.Logger.WithFields(&logrus.Fields{
@@ -1263,7 +1436,23 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 			"data_size": len(buf),
 		}).Debug("uploading file to storage")
 
-		err = ph.UpdateProfileImagesUC.UploadUserPhoto(int(user_id), buf, filename, sanitizedType)
+		storedPath, err := ph.UpdateProfileImagesUC.UploadUserPhoto(int(user_id), buf, filename, sniffedType)
+		if err == model.ErrUnsupportedImage {
+			failedUploads = append(failedUploads, fileName+" (unsupported type)")
+			continue
+		}
+		if err == model.ErrTooManyPhotos {
+			failedUploads = append(failedUploads, fileName+" (photo limit reached)")
+			continue
+		}
+		if err == model.ErrLowQualityPhoto {
+			failedUploads = append(failedUploads, fileName+" (too small or blank)")
+			continue
+		}
+		if err == model.ErrDuplicatePhoto {
+			failedUploads = append(failedUploads, fileName+" (duplicate photo)")
+			continue
+		}
 		if err != nil {
 			ph.Logger.WithFields(&logrus.Fields{
 				"user_id":   user_id,
@@ -1275,10 +1464,10 @@ func (ph *ProfilesHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
-		successUploads = append(successUploads, filename)
+		successUploads = append(successUploads, storedPath)
 		ph.Logger.WithFields(&logrus.Fields{
 			"user_id":   user_id,
-			"file_name": filename,
+			"file_name": storedPath,
 		}).Info("file uploaded successfully")
 	}
 
@@ -1511,6 +1700,12 @@ func (uh *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	profileId, err := uh.SignupUC.SaveUserProfile(profile)
+	if err == model.ErrInvalidPrompts {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid prompts: answer at most 3 different catalog prompts, 300 characters each"},
+		)
+		return
+	}
 	if err != nil {
 		MakeEasyJSONResponse(w, http.StatusInternalServerError,
 			&model.ErrorResponse{Message: fmt.Sprintf("Failed to save user profile %v", err)},
@@ -1879,22 +2074,25 @@ func (ph *ProfilesHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	}
 
 	profileIsAdmin := model.ProfileIsAdmin{
-		ProfileId:   profile.ProfileId,
-		FirstName:   profile.FirstName,
-		LastName:    profile.LastName,
-		IsMale:      profile.IsMale,
-		Goal:        profile.Goal,
-		Height:      profile.Height,
-		Birthday:    profile.Birthday,
-		Description: profile.Description,
-		Location:    profile.Location,
-		Interests:   profile.Interests,
-		LikedBy:     profile.LikedBy,
-		Preferences: profile.Preferences,
-		Parameters:  profile.Parameters,
-		Photos:      profile.Photos,
-		Premium:     profile.Premium,
-		IsAdmin:     is_admin,
+		ProfileId:    profile.ProfileId,
+		FirstName:    profile.FirstName,
+		LastName:     profile.LastName,
+		IsMale:       profile.IsMale,
+		Goal:         profile.Goal,
+		Height:       profile.Height,
+		Birthday:     profile.Birthday,
+		Description:  profile.Description,
+		Location:     profile.Location,
+		Interests:    profile.Interests,
+		Preferences:  profile.Preferences,
+		Parameters:   profile.Parameters,
+		Photos:       profile.Photos,
+		Premium:      profile.Premium,
+		Verified:     profile.Verified,
+		Prompts:      profile.Prompts,
+		Completeness: profile.Completeness,
+		Timezone:     profile.Timezone,
+		IsAdmin:      is_admin,
 	}
 
 	MakeEasyJSONResponse(w, http.StatusOK, profileIsAdmin)
@@ -1930,14 +2128,21 @@ func (ph *ProfilesHandler) GetProfiles(w http.ResponseWriter, r *http.Request) {
 		"requester_id": profileId,
 	}).Debug("attempting to get profiles list")
 
+	// The verified-only deck is a narrower view of the feed and is not
+	// cached, so the regular deck cache stays in sync with likes.
+	verifiedOnly := r.URL.Query().Get("verified") == "true"
 	redisKey := fmt.Sprintf("cached_profiles:%d", profileId)
 
-	cached, err := ph.Subscriber.Exists(context.Background(), redisKey).Result()
-	if err != nil {
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: "Redis error"},
-		)
-		return
+	var cached int64
+	var err error
+	if !verifiedOnly {
+		cached, err = ph.Subscriber.Exists(context.Background(), redisKey).Result()
+		if err != nil {
+			MakeEasyJSONResponse(w, http.StatusInternalServerError,
+				&model.ErrorResponse{Message: "Redis error"},
+			)
+			return
+		}
 	}
 	if cached > 0 {
 		cachedData, err := ph.Subscriber.Get(context.Background(), redisKey).Result()
@@ -1950,38 +2155,26 @@ func (ph *ProfilesHandler) GetProfiles(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if !IsPremium {
-		viewKey := fmt.Sprintf("profile_view_limit:%d", profileId)
-		countStr, err := ph.Subscriber.Get(context.Background(), viewKey).Result()
-		if err != nil && err != redis.Nil {
-			MakeEasyJSONResponse(w, http.StatusInternalServerError,
-				&model.ErrorResponse{Message: "Redis error"},
-			)
-			return
-		}
-
-		viewCount := 0
-		if countStr != "" {
-			viewCount, _ = strconv.Atoi(countStr)
-		}
-
-		if viewCount >= model.MaxProfileViewsWithoutSub {
-			MakeEasyJSONResponse(w, http.StatusAccepted,
-				&model.ErrorResponse{Message: "Go touch grass"},
-			)
-			return
-		}
-
-		pipe := ph.Subscriber.TxPipeline()
-		pipe.Incr(context.Background(), viewKey)
-		if viewCount == 0 {
-			pipe.Expire(context.Background(), viewKey, 12*time.Hour)
-		}
-		_, _ = pipe.Exec(context.Background())
+	plan := ph.quotaPlan(r, IsPremium)
+	quota, err := ph.QuotaUC.Consume(int(profileId), plan, model.QuotaFeeds)
+	if err == model.ErrQuotaExceeded {
+		SetQuotaHeaders(w, quota)
+		MakeEasyJSONResponse(w, http.StatusAccepted,
+			&model.ErrorResponse{Message: "Go touch grass"},
+		)
+		return
+	}
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Redis error"},
+		)
+		return
 	}
+	SetQuotaHeaders(w, quota)
 
-	profiles, err := ph.GetProfilesUC.GetProfiles(int(profileId))
+	profiles, err := ph.GetProfilesUC.GetProfiles(int(profileId), verifiedOnly)
 	if err != nil {
+		_ = ph.QuotaUC.Refund(int(profileId), plan, model.QuotaFeeds)
 		ph.Logger.WithFields(&logrus.Fields{
 			"requester_id": profileId,
 			"error":        err.Error(),
@@ -1994,7 +2187,7 @@ func (ph *ProfilesHandler) GetProfiles(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data, err := json.Marshal(profiles)
-	if err == nil {
+	if err == nil && !verifiedOnly {
 		_ = ph.Subscriber.Set(context.Background(), redisKey, data, 30*time.Minute).Err()
 	}
 
@@ -2793,18 +2986,19 @@ func (sh *SubHandler) ChangeBorder(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
-func (ch *ComplaintHandler) FindComplaint(w http.ResponseWriter, r *http.Request) {
-	ch.Logger.WithFields(&logrus.Fields{
+func (sh *SubHandler) SetIncognito(w http.ResponseWriter, r *http.Request) {
+	sh.Logger.WithFields(&logrus.Fields{
 		"method":     r.Method,
 		"path":       r.URL.Path,
 		"request_id": r.Header.Get("request_id"),
-		"ip":         r.RemoteAddr,
-	}).Info("GetComplaints request started")
+	}).Info("SetIncognito request started")
 
 	userIDRaw := r.Context().Value(userIDKey)
-	user_id, ok := userIDRaw.(uint32)
+	profileId, ok := userIDRaw.(uint32)
 	if !ok {
-		ch.Logger.Warn("unauthorized query access attempt")
+		sh.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
 
 		MakeEasyJSONResponse(w, http.StatusUnauthorized,
 			&model.ErrorResponse{Message: "You don't have access"},
@@ -2812,33 +3006,114 @@ func (ch *ComplaintHandler) FindComplaint(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	is_admin, err := ch.GetAdminUC.GetAdmin(int(user_id))
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		MakeEasyJSONResponse(w, http.StatusInternalServerError,
-			&model.ErrorResponse{Message: "Error getting admin permissions"},
-		)
-		return
-	}
+		sh.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to read request body")
 
-	if !is_admin {
-		MakeEasyJSONResponse(w, http.StatusUnauthorized,
-			&model.ErrorResponse{Message: "You don't have permissions"},
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Failed to read request body"},
 		)
 		return
 	}
 
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		ch.Logger.Warn("failed to read request body")
+	var input model.IncognitoRequest
+	lexer := jlexer.Lexer{Data: body}
+	input.UnmarshalEasyJSON(&lexer)
+	if lexer.Error() != nil {
+		sh.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      lexer.Error().Error(),
+		}).Warn("failed to decode incognito request body")
+
 		MakeEasyJSONResponse(w, http.StatusBadRequest,
-			&model.ErrorResponse{Message: "Failed to read request body"},
+			&model.ErrorResponse{Message: "Invalid JSON"},
 		)
 		return
 	}
 
-	if len(body) == 0 {
-		complaints, err := ch.GetComplaintsUC.GetAllComplaints()
-		if err != nil {
+	if !IsPremium {
+		sh.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"enabled":    input.Enabled,
+		}).Warn("no premium")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "You cannot use incognito mode with no subscription"},
+		)
+		return
+	}
+
+	err = sh.IncognitoUC.SetIncognito(int(profileId), input.Enabled)
+	if err != nil {
+		sh.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"enabled":    input.Enabled,
+			"error":      err.Error(),
+		}).Error("failed to set incognito")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error setting incognito: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Changed"},
+	)
+}
+
+func (ch *ComplaintHandler) FindComplaint(w http.ResponseWriter, r *http.Request) {
+	ch.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetComplaints request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ch.Logger.Warn("unauthorized query access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	is_admin, err := ch.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Error getting admin permissions"},
+		)
+		return
+	}
+
+	if !is_admin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ch.Logger.Warn("failed to read request body")
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Failed to read request body"},
+		)
+		return
+	}
+
+	if len(body) == 0 {
+		complaints, err := ch.GetComplaintsUC.GetAllComplaints()
+		if err != nil {
 			MakeEasyJSONResponse(w, http.StatusInternalServerError,
 				&model.ErrorResponse{Message: "Error getting complaints"},
 			)
@@ -3296,3 +3571,2156 @@ func (ph *ProfilesHandler) GetStatistics(w http.ResponseWriter, r *http.Request)
 
 	MakeEasyJSONResponse(w, http.StatusOK, stats)
 }
+
+func (ph *ProfilesHandler) GetTopPicks(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetTopPicks request started")
+
+	profilesListRetrieved.WithLabelValues("get top picks").Inc()
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized top picks access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	if !IsPremium {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+		}).Warn("no premium")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "You cannot get top picks with no subscription"},
+		)
+		return
+	}
+
+	picks, err := ph.GetTopPicksUC.GetTopPicks(int(profileId))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get top picks")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting top picks: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id":  profileId,
+		"picks_count": len(picks.Profiles),
+	}).Info("top picks retrieved successfully")
+
+	MakeEasyJSONResponse(w, http.StatusOK, picks)
+}
+
+func (ph *ProfilesHandler) GetIncomingLikes(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetIncomingLikes request started")
+
+	profilesListRetrieved.WithLabelValues("get incoming likes").Inc()
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized incoming likes access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	likes, err := ph.GetIncomingLikesUC.GetIncomingLikes(int(profileId), IsPremium)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get incoming likes")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting incoming likes: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id":  profileId,
+		"likes_count": likes.Total,
+		"premium":     IsPremium,
+	}).Info("incoming likes retrieved successfully")
+
+	MakeEasyJSONResponse(w, http.StatusOK, likes)
+}
+
+func (ph *ProfilesHandler) ActivateBoost(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("ActivateBoost request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized boost attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	// The plan's allowance is spent first; once it is used up, or the plan
+	// has none, the boost comes from the purchased inventory.
+	plan := ph.quotaPlan(r, IsPremium)
+	quota, err := ph.QuotaUC.Consume(int(profileId), plan, model.QuotaBoosts)
+	if err != nil && err != model.ErrQuotaExceeded {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to check quota")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Error checking quota"},
+		)
+		return
+	}
+	useAllowance := err == nil
+	SetQuotaHeaders(w, quota)
+
+	boost, err := ph.ActivateBoostUC.ActivateBoost(int(profileId), useAllowance)
+	if err != nil && useAllowance {
+		_ = ph.QuotaUC.Refund(int(profileId), plan, model.QuotaBoosts)
+	}
+	if err == model.ErrBoostAlreadyActive {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Boost is already active"},
+		)
+		return
+	}
+	if err == model.ErrNoBoostsLeft {
+		MakeEasyJSONResponse(w, http.StatusForbidden,
+			&model.ErrorResponse{Message: "You have no boosts left"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to activate boost")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error activating boost: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"boost_id":   boost.Boost.BoostID,
+		"remaining":  boost.Remaining,
+	}).Info("boost activated successfully")
+
+	MakeEasyJSONResponse(w, http.StatusOK, boost)
+}
+
+func (ph *ProfilesHandler) RewindLike(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("RewindLike request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized rewind attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	plan := ph.quotaPlan(r, IsPremium)
+	quota, err := ph.QuotaUC.Consume(int(profileId), plan, model.QuotaRewinds)
+	if err == model.ErrQuotaExceeded {
+		SetQuotaHeaders(w, quota)
+		if quota.Limit == 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "You cannot rewind with no subscription"},
+			)
+			return
+		}
+		MakeEasyJSONResponse(w, http.StatusTooManyRequests,
+			&model.ErrorResponse{Message: fmt.Sprintf("You have no %s left", model.QuotaRewinds)},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to check quota")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: "Error checking quota"},
+		)
+		return
+	}
+	SetQuotaHeaders(w, quota)
+
+	dislikedId, err := ph.RewindLikeUC.RewindLike(int(profileId))
+	if err != nil {
+		_ = ph.QuotaUC.Refund(int(profileId), plan, model.QuotaRewinds)
+	}
+	if err == model.ErrNothingToRewind {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Nothing to rewind"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to rewind like")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error rewinding like: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id":  profileId,
+		"disliked_id": dislikedId,
+	}).Info("like rewound")
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.RewindLikeResponse{ProfileId: dislikedId})
+}
+
+// quotaPlan resolves the quota plan of a user from what the auth
+// middleware already loaded with the session, so picking a plan costs no
+// extra lookup.
+func (ph *ProfilesHandler) quotaPlan(r *http.Request, isPremium bool) string {
+	isVerified, ok := r.Context().Value(isVerifiedKey).(bool)
+	if !ok && !isPremium {
+		ph.Logger.WithFields(&logrus.Fields{
+			"path":  r.URL.Path,
+			"error": "missing verification flag in context",
+		}).Error("failed to resolve quota plan")
+	}
+	return usecase.QuotaPlan(isPremium, isVerified)
+}
+
+func (ph *ProfilesHandler) GetQuota(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetQuota request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized quota access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+
+	quotas, err := ph.QuotaUC.GetQuotas(int(profileId), ph.quotaPlan(r, IsPremium))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get quotas")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting quotas: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, quotas)
+}
+
+func (ph *ProfilesHandler) SaveSearch(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("SaveSearch request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to read request body")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.SaveSearchRequest
+	if err := input.UnmarshalJSON(body); err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to unmarshal save search request")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	search, err := ph.SaveSearchUC.SaveSearch(int(profileId), input)
+	if err == model.ErrInvalidSearchRequest {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid search name or filter"},
+		)
+		return
+	}
+	if err == model.ErrTooManySavedSearches {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "You have too many saved searches"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to save search")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error saving search: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusCreated, search)
+}
+
+func (ph *ProfilesHandler) GetSavedSearches(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetSavedSearches request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	searches, err := ph.GetSavedSearchesUC.GetSavedSearches(int(profileId))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get saved searches")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting saved searches: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, searches)
+}
+
+func (ph *ProfilesHandler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("DeleteSavedSearch request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	searchId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid search id format"},
+		)
+		return
+	}
+
+	err = ph.DeleteSavedSearchUC.DeleteSavedSearch(int(profileId), searchId)
+	if err == model.ErrSavedSearchNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Saved search not found"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"search_id":  searchId,
+			"error":      err.Error(),
+		}).Error("failed to delete saved search")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error deleting saved search: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Deleted"},
+	)
+}
+
+func (ph *ProfilesHandler) Autocomplete(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":       r.Method,
+		"path":         r.URL.Path,
+		"request_id":   r.Header.Get("request_id"),
+		"ip":           r.RemoteAddr,
+		"query_params": r.URL.Query(),
+	}).Info("Autocomplete request started")
+
+	if _, ok := r.Context().Value(userIDKey).(uint32); !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	dictionary := mux.Vars(r)["dictionary"]
+	query := r.URL.Query().Get("q")
+	description := r.URL.Query().Get("description")
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit format"},
+			)
+			return
+		}
+	}
+
+	suggestions, err := ph.AutocompleteUC.Autocomplete(dictionary, query, description, limit)
+	if err == model.ErrUnknownDictionary {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Unknown dictionary"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"dictionary": dictionary,
+			"error":      err.Error(),
+		}).Error("failed to autocomplete")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error autocompleting: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, suggestions)
+}
+
+func (ph *ProfilesHandler) CreatePhotoUpload(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("CreatePhotoUpload request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized upload attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	upload, err := ph.CreatePhotoUploadUC.CreatePhotoUpload(int(user_id))
+	if err == model.ErrTooManyPhotos {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Photo limit reached"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to create photo upload")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error creating upload: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusCreated, upload)
+}
+
+func (ph *ProfilesHandler) FinalizePhotoUpload(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("FinalizePhotoUpload request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized upload attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.FinalizePhotoUploadRequest
+	if err := input.UnmarshalJSON(body); err != nil || input.UploadKey == "" {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	path, err := ph.FinalizePhotoUploadUC.FinalizePhotoUpload(int(user_id), input.UploadKey)
+	if err == model.ErrInvalidUpload {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Upload is not a supported image or is too large"},
+		)
+		return
+	}
+	if err == model.ErrUploadNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Upload not found"},
+		)
+		return
+	}
+	if err == model.ErrLowQualityPhoto {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Photo is too small or blank"},
+		)
+		return
+	}
+	if err == model.ErrDuplicatePhoto {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Photo is already on the profile"},
+		)
+		return
+	}
+	if err == model.ErrTooManyPhotos {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Photo limit reached"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id":    user_id,
+			"upload_key": input.UploadKey,
+			"error":      err.Error(),
+		}).Error("failed to finalize photo upload")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error finalizing upload: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusCreated, &model.FinalizePhotoUploadResponse{Path: path})
+}
+
+func (ph *ProfilesHandler) GetPhotoURLs(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetPhotoURLs request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	urls, err := ph.GetPhotoURLsUC.GetPhotoURLs(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get photo urls")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting photos: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, urls)
+}
+
+func (ph *ProfilesHandler) ReorderPhotos(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("ReorderPhotos request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.ReorderPhotosRequest
+	if err := input.UnmarshalJSON(body); err != nil || len(input.Paths) == 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	err = ph.ReorderPhotosUC.ReorderPhotos(int(user_id), input.Paths)
+	if err == model.ErrInvalidPhotoOrder {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Order must list every photo exactly once"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to reorder photos")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error reordering photos: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Reordered"},
+	)
+}
+
+func (ph *ProfilesHandler) SetPrimaryPhoto(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("SetPrimaryPhoto request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.SetPrimaryPhotoRequest
+	if err := input.UnmarshalJSON(body); err != nil || input.Path == "" {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	err = ph.SetPrimaryPhotoUC.SetPrimaryPhoto(int(user_id), input.Path)
+	if err == model.ErrPhotoNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Photo not found"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"path":    input.Path,
+			"error":   err.Error(),
+		}).Error("failed to set primary photo")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error setting primary photo: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Updated"},
+	)
+}
+
+func (ph *ProfilesHandler) GetModerationQueue(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetModerationQueue request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	isAdmin, err := ph.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return
+	}
+
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit format"},
+			)
+			return
+		}
+	}
+
+	queue, err := ph.GetModerationQueueUC.GetModerationQueue(limit)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get moderation queue")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting moderation queue: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, queue)
+}
+
+func (ph *ProfilesHandler) ModeratePhoto(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("ModeratePhoto request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	isAdmin, err := ph.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return
+	}
+
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	photoId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || photoId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid photo ID"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.ModeratePhotoRequest
+	if err := input.UnmarshalJSON(body); err != nil || len(input.Reason) > 255 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	err = ph.ModeratePhotoUC.ModeratePhoto(photoId, int(user_id), input.Approve, input.Reason)
+	if err == model.ErrPhotoNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "No pending photo with this ID"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id":  user_id,
+			"photo_id": photoId,
+			"error":    err.Error(),
+		}).Error("failed to moderate photo")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error moderating photo: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Moderated"},
+	)
+}
+
+func (ph *ProfilesHandler) GetPhotoClusters(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetPhotoClusters request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	isAdmin, err := ph.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return
+	}
+
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit format"},
+			)
+			return
+		}
+	}
+
+	clusters, err := ph.GetPhotoClustersUC.GetPhotoClusters(limit)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get photo clusters")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting photo clusters: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, clusters)
+}
+
+func (ph *ProfilesHandler) GetVerification(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetVerification request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized verification access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	verification, err := ph.GetVerificationUC.GetVerification(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get verification")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting verification: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, verification)
+}
+
+func (ph *ProfilesHandler) StartVerification(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("StartVerification request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized verification attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	challenge, err := ph.StartVerificationUC.StartVerification(int(user_id))
+	if err == model.ErrAlreadyVerified {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Profile is already verified"},
+		)
+		return
+	}
+	if err == model.ErrVerificationPending {
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Verification is waiting for review"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to start verification")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error starting verification: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusCreated, challenge)
+}
+
+func (ph *ProfilesHandler) SubmitVerification(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("SubmitVerification request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized verification attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	verification, err := ph.SubmitVerificationUC.SubmitVerification(int(user_id))
+	switch err {
+	case nil:
+	case model.ErrVerificationNotFound:
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "No open verification challenge"},
+		)
+		return
+	case model.ErrUploadNotFound:
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Selfie not uploaded"},
+		)
+		return
+	case model.ErrInvalidUpload:
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Selfie is not a supported image"},
+		)
+		return
+	default:
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to submit verification")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error submitting verification: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, verification)
+}
+
+func (ph *ProfilesHandler) GetVerificationQueue(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetVerificationQueue request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	isAdmin, err := ph.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return
+	}
+
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit format"},
+			)
+			return
+		}
+	}
+
+	queue, err := ph.GetVerificationQueueUC.GetVerificationQueue(limit)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get verification queue")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting verification queue: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, queue)
+}
+
+func (ph *ProfilesHandler) ReviewVerification(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("ReviewVerification request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	isAdmin, err := ph.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return
+	}
+
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	requestId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || requestId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid verification ID"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.ReviewVerificationRequest
+	if err := input.UnmarshalJSON(body); err != nil || len(input.Reason) > 255 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	err = ph.ReviewVerificationUC.ReviewVerification(requestId, int(user_id), input.Approve, input.Reason)
+	if err == model.ErrVerificationNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "No submitted verification with this ID"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id":    user_id,
+			"request_id": requestId,
+			"error":      err.Error(),
+		}).Error("failed to review verification")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error reviewing verification: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Reviewed"},
+	)
+}
+
+func (ph *ProfilesHandler) GetPrompts(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetPrompts request started")
+
+	prompts, err := ph.GetPromptsUC.GetPrompts()
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": err.Error(),
+		}).Error("failed to get prompts")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting prompts: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, &model.PromptsResponse{Prompts: prompts})
+}
+
+func (ph *ProfilesHandler) GetProfileHistory(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetProfileHistory request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	isAdmin, err := ph.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return
+	}
+
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	profileId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || profileId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile ID"},
+		)
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid limit format"},
+			)
+			return
+		}
+	}
+
+	history, err := ph.GetProfileHistoryUC.GetProfileHistory(profileId, limit)
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id":    user_id,
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get profile history")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting profile history: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, history)
+}
+
+func (ph *ProfilesHandler) RevertProfile(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("RevertProfile request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	user_id, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	isAdmin, err := ph.GetAdminUC.GetAdmin(int(user_id))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id": user_id,
+			"error":   err.Error(),
+		}).Error("failed to get admin permissions")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting admin permissions: %v", err)},
+		)
+		return
+	}
+
+	if !isAdmin {
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have permissions"},
+		)
+		return
+	}
+
+	profileId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || profileId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile ID"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.RevertProfileRequest
+	if err := input.UnmarshalJSON(body); err != nil || input.VersionId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	err = ph.RevertProfileUC.RevertProfile(profileId, input.VersionId, int(user_id))
+	if err == model.ErrVersionNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "No version with this ID"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"user_id":    user_id,
+			"profile_id": profileId,
+			"version_id": input.VersionId,
+			"error":      err.Error(),
+		}).Error("failed to revert profile")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error reverting profile: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Reverted"},
+	)
+}
+
+func (uh *UserHandler) GetPause(w http.ResponseWriter, r *http.Request) {
+	uh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetPause request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	userID, ok := userIDRaw.(uint32)
+	if !ok {
+		uh.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	pause, err := uh.GetPauseUC.GetPause(int(userID))
+	if err != nil {
+		uh.Logger.WithFields(&logrus.Fields{
+			"user_id": userID,
+			"error":   err.Error(),
+		}).Error("failed to get pause")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting pause: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, pause)
+}
+
+func (uh *UserHandler) PauseAccount(w http.ResponseWriter, r *http.Request) {
+	uh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("PauseAccount request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	userID, ok := userIDRaw.(uint32)
+	if !ok {
+		uh.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid request body"},
+		)
+		return
+	}
+
+	var input model.PauseAccountRequest
+	if len(body) > 0 {
+		if err := input.UnmarshalJSON(body); err != nil {
+			MakeEasyJSONResponse(w, http.StatusBadRequest,
+				&model.ErrorResponse{Message: "Invalid JSON"},
+			)
+			return
+		}
+	}
+
+	pause, err := uh.PauseAccountUC.PauseAccount(int(userID), input.ResumeAt)
+	if err == model.ErrInvalidResumeDate {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Resume date must be in the future and within a year"},
+		)
+		return
+	}
+	if err != nil {
+		uh.Logger.WithFields(&logrus.Fields{
+			"user_id": userID,
+			"error":   err.Error(),
+		}).Error("failed to pause account")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error pausing account: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, pause)
+}
+
+func (uh *UserHandler) ResumeAccount(w http.ResponseWriter, r *http.Request) {
+	uh.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("ResumeAccount request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	userID, ok := userIDRaw.(uint32)
+	if !ok {
+		uh.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	if err := uh.ResumeAccountUC.ResumeAccount(int(userID)); err != nil {
+		uh.Logger.WithFields(&logrus.Fields{
+			"user_id": userID,
+			"error":   err.Error(),
+		}).Error("failed to resume account")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error resuming account: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.PauseStatus{Paused: false},
+	)
+}
+
+// readBlockReason reads the optional {"reason": ...} body of an unmatch or a
+// block. An empty body means no reason.
+func readBlockReason(r *http.Request) (string, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return "", false
+	}
+	if len(body) == 0 {
+		return "", true
+	}
+
+	var input model.BlockRequest
+	if err := input.UnmarshalJSON(body); err != nil {
+		return "", false
+	}
+	reason := strings.TrimSpace(input.Reason)
+	if utf8.RuneCountInString(reason) > model.MaxBlockReasonLength {
+		return "", false
+	}
+	return reason, true
+}
+
+func (ph *ProfilesHandler) Unmatch(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("Unmatch request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	matchedId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || matchedId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile ID"},
+		)
+		return
+	}
+
+	reason, ok := readBlockReason(r)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: fmt.Sprintf("Invalid JSON: reason is at most %d characters", model.MaxBlockReasonLength)},
+		)
+		return
+	}
+
+	err = ph.UnmatchUC.Unmatch(int(profileId), matchedId, reason)
+	if err == model.ErrMatchNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "You have no match with this user"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"matched_id": matchedId,
+			"error":      err.Error(),
+		}).Error("failed to unmatch")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error unmatching: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Unmatched"},
+	)
+}
+
+func (ph *ProfilesHandler) GetBlocks(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetBlocks request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	blocks, err := ph.GetBlocksUC.GetBlocks(int(profileId))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get blocks")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting blocks: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, blocks)
+}
+
+func (ph *ProfilesHandler) BlockProfile(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("BlockProfile request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	blockedId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || blockedId <= 0 || blockedId == int(profileId) {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile ID"},
+		)
+		return
+	}
+
+	reason, ok := readBlockReason(r)
+	if !ok {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: fmt.Sprintf("Invalid JSON: reason is at most %d characters", model.MaxBlockReasonLength)},
+		)
+		return
+	}
+
+	err = ph.BlockProfileUC.BlockProfile(int(profileId), blockedId, reason)
+	if err == model.ErrProfileNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "No profile with this ID"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"blocked_id": blockedId,
+			"error":      err.Error(),
+		}).Error("failed to block profile")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error blocking profile: %v", err)},
+		)
+		return
+	}
+
+	// Both feeds may still hold the other profile.
+	err = ph.Subscriber.Del(context.Background(),
+		fmt.Sprintf("cached_profiles:%d", profileId),
+		fmt.Sprintf("cached_profiles:%d", blockedId),
+	).Err()
+	if err != nil {
+		ph.Logger.WithError(err).Error("failed to drop cached profiles")
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Blocked"},
+	)
+}
+
+func (ph *ProfilesHandler) UnblockProfile(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("UnblockProfile request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	blockedId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || blockedId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile ID"},
+		)
+		return
+	}
+
+	err = ph.UnblockProfileUC.UnblockProfile(int(profileId), blockedId)
+	if err == model.ErrBlockNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "You have not blocked this user"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"blocked_id": blockedId,
+			"error":      err.Error(),
+		}).Error("failed to unblock profile")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error unblocking profile: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Unblocked"},
+	)
+}
+
+func (ph *ProfilesHandler) GetPendingMatches(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetPendingMatches request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	matches, err := ph.GetPendingMatchesUC.GetPendingMatches(int(profileId))
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get pending matches")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting pending matches: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, matches)
+}
+
+func (ph *ProfilesHandler) ExtendMatch(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("ExtendMatch request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	IsPremiumRaw := r.Context().Value(isPremiumKey)
+	IsPremium, _ := IsPremiumRaw.(bool)
+	if !IsPremium {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+		}).Warn("no premium")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "You cannot extend a match with no subscription"},
+		)
+		return
+	}
+
+	matchedId, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil || matchedId <= 0 {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid profile ID"},
+		)
+		return
+	}
+
+	match, err := ph.ExtendMatchUC.ExtendMatch(int(profileId), matchedId)
+	switch err {
+	case nil:
+		MakeEasyJSONResponse(w, http.StatusOK, match)
+		return
+	case model.ErrMatchNotFound:
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "You have no match with this user"},
+		)
+		return
+	case model.ErrMatchNotExtendable:
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "Only a match nobody has written in yet can be extended"},
+		)
+		return
+	case model.ErrMatchAlreadyExtended:
+		MakeEasyJSONResponse(w, http.StatusConflict,
+			&model.ErrorResponse{Message: "This match has already been extended"},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"matched_id": matchedId,
+		"error":      err.Error(),
+	}).Error("failed to extend match")
+
+	MakeEasyJSONResponse(w, http.StatusInternalServerError,
+		&model.ErrorResponse{Message: fmt.Sprintf("Error extending match: %v", err)},
+	)
+}
+
+func (ph *ProfilesHandler) GetSecondLook(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("GetSecondLook request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	settings, err := ph.GetSecondLookUC.GetSecondLook(int(profileId))
+	if err == model.ErrProfileNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Profile not found"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to get second look")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error getting second look: %v", err)},
+		)
+		return
+	}
+
+	MakeEasyJSONResponse(w, http.StatusOK, settings)
+}
+
+func (ph *ProfilesHandler) SetSecondLook(w http.ResponseWriter, r *http.Request) {
+	ph.Logger.WithFields(&logrus.Fields{
+		"method":     r.Method,
+		"path":       r.URL.Path,
+		"request_id": r.Header.Get("request_id"),
+		"ip":         r.RemoteAddr,
+	}).Info("SetSecondLook request started")
+
+	userIDRaw := r.Context().Value(userIDKey)
+	profileId, ok := userIDRaw.(uint32)
+	if !ok {
+		ph.Logger.WithFields(&logrus.Fields{
+			"error": "missing or invalid userID in context",
+		}).Warn("unauthorized access attempt")
+
+		MakeEasyJSONResponse(w, http.StatusUnauthorized,
+			&model.ErrorResponse{Message: "You don't have access"},
+		)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Failed to read request body"},
+		)
+		return
+	}
+
+	var input model.SecondLookRequest
+	if err := input.UnmarshalJSON(body); err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Warn("failed to decode second look request body")
+
+		MakeEasyJSONResponse(w, http.StatusBadRequest,
+			&model.ErrorResponse{Message: "Invalid JSON"},
+		)
+		return
+	}
+
+	err = ph.SetSecondLookUC.SetSecondLook(int(profileId), input.Enabled)
+	if err == model.ErrProfileNotFound {
+		MakeEasyJSONResponse(w, http.StatusNotFound,
+			&model.ErrorResponse{Message: "Profile not found"},
+		)
+		return
+	}
+	if err != nil {
+		ph.Logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err.Error(),
+		}).Error("failed to set second look")
+
+		MakeEasyJSONResponse(w, http.StatusInternalServerError,
+			&model.ErrorResponse{Message: fmt.Sprintf("Error setting second look: %v", err)},
+		)
+		return
+	}
+
+	ph.Logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"enabled":    input.Enabled,
+	}).Info("second look updated")
+
+	MakeEasyJSONResponse(w, http.StatusOK,
+		&model.ErrorResponse{Message: "Second look updated"},
+	)
+}
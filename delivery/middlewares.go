@@ -27,6 +27,7 @@ type contextKey string
 const userIDKey contextKey = "userID"
 
 const isPremiumKey contextKey = "isPremium"
+const isVerifiedKey contextKey = "isVerified"
 
 const requestIDKey contextKey = "request_id"
 
@@ -85,7 +86,7 @@ func AuthWithCSRFMiddleware(tokenValidator *repository.JwtToken, sessionHandler
 				return
 			}
 
-			is_premium, _, err := userHandler.GetPremiumUC.GetPremium(int(userID))
+			is_premium, _, is_verified, err := userHandler.GetPremiumUC.GetPremium(int(userID))
 			if err != nil {
 				fmt.Println("Error getting premium at...", r.URL.Path)
 				http.Redirect(w, r, "/", http.StatusFound)
@@ -115,6 +116,7 @@ func AuthWithCSRFMiddleware(tokenValidator *repository.JwtToken, sessionHandler
 
 			ctx := context.WithValue(r.Context(), userIDKey, uint32(userID))
 			ctx = context.WithValue(ctx, isPremiumKey, is_premium)
+			ctx = context.WithValue(ctx, isVerifiedKey, is_verified)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
@@ -47,3 +48,10 @@ func GetUrlForMetrics(url string) string {
 	}
 	return strings.Join(newUrl, "/")
 }
+
+func SetQuotaHeaders(w http.ResponseWriter, quota model.QuotaStatus) {
+	w.Header().Set("X-Quota-Kind", quota.Kind)
+	w.Header().Set("X-Quota-Limit", strconv.Itoa(quota.Limit))
+	w.Header().Set("X-Quota-Remaining", strconv.Itoa(quota.Remaining))
+	w.Header().Set("X-Quota-Reset", strconv.FormatInt(quota.ResetsAt.Unix(), 10))
+}
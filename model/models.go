@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"time"
+
+	profilesmodel "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 )
 
 var MinPasswordLength = 8
@@ -19,7 +21,20 @@ const Megabyte int = 1 << 23
 const MaxQuerySizeStr int = 5
 const MaxQuerySizePhoto int = 15 * 6
 
-var MaxProfileViewsWithoutSub = 5
+var BoostsPerSubscription = 3
+
+var SearchAlertsInterval = 15 * time.Minute
+
+var MatchExpiryInterval = 10 * time.Minute
+
+// The outbox relay turns up to OutboxBatch events into notifications every
+// OutboxInterval. Sent events are kept for OutboxRetention.
+var OutboxInterval = 5 * time.Second
+var OutboxBatch = 100
+var OutboxRetention = 7 * 24 * time.Hour
+
+// MaxIdempotencyKeyLength bounds the Idempotency-Key header of a like.
+const MaxIdempotencyKeyLength = 64
 
 var Key string = "Hello"
 
@@ -66,6 +81,58 @@ var (
 	ErrStaticUploadUC        = errors.New("failed to upload static")
 	ErrGetActiveQueriesUC    = errors.New("failed to get active queries")
 	ErrUserGetParamsUC       = errors.New("failed to get user params")
+	ErrGetTopPicksUC         = errors.New("failed to get top picks")
+	ErrGetIncomingLikesUC    = errors.New("failed to get incoming likes")
+	ErrActivateBoostUC       = errors.New("failed to activate boost")
+	ErrSetIncognitoUC        = errors.New("failed to set incognito")
+	ErrQuotaUC               = errors.New("failed to check quota")
+	ErrInvalidSearchRequest  = errors.New("invalid search request")
+	ErrSavedSearchUC         = errors.New("failed to process saved search")
+	ErrSavedSearchNotFound   = errors.New("saved search not found")
+	ErrTooManySavedSearches  = errors.New("too many saved searches")
+	ErrAutocompleteUC        = errors.New("failed to autocomplete")
+	ErrUnknownDictionary     = errors.New("unknown dictionary")
+	ErrUnsupportedImage      = errors.New("unsupported image format")
+	ErrPhotoUploadUC         = errors.New("failed to process photo upload")
+	ErrInvalidUpload         = errors.New("invalid photo upload")
+	ErrUploadNotFound        = errors.New("photo upload not found")
+	ErrTooManyPhotos         = errors.New("too many photos")
+	ErrPhotoOrderUC          = errors.New("failed to order photos")
+	ErrInvalidPhotoOrder     = errors.New("invalid photo order")
+	ErrPhotoNotFound         = errors.New("photo not found")
+	ErrLowQualityPhoto       = errors.New("photo is too small or blank")
+	ErrDuplicatePhoto        = errors.New("photo is already on the profile")
+	ErrModerationUC          = errors.New("failed to moderate photos")
+	ErrVerificationUC        = errors.New("failed to process verification")
+	ErrAlreadyVerified       = errors.New("profile is already verified")
+	ErrVerificationPending   = errors.New("verification is waiting for review")
+	ErrVerificationNotFound  = errors.New("verification not found")
+	ErrGetPromptsUC          = errors.New("failed to get prompts")
+	ErrInvalidPrompts        = errors.New("invalid profile prompts")
+	ErrProfileHistoryUC      = errors.New("failed to process profile history")
+	ErrVersionNotFound       = errors.New("profile version not found")
+	ErrPauseUC               = errors.New("failed to process account pause")
+	ErrInvalidResumeDate     = errors.New("invalid resume date")
+	ErrBlockUC               = errors.New("failed to process block")
+	ErrMatchNotFound         = errors.New("match not found")
+	ErrBlockNotFound         = errors.New("block not found")
+	ErrProfileBlocked        = errors.New("profile is blocked")
+	ErrChatReadOnly          = errors.New("chat is read-only")
+	ErrChatExists            = errors.New("chat already exists")
+	ErrInvalidLikeComment    = errors.New("invalid like comment")
+	ErrMatchExpiryUC         = errors.New("failed to process match expiry")
+	ErrMatchNotExtendable    = errors.New("match cannot be extended")
+	ErrMatchAlreadyExtended  = errors.New("match is already extended")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was used for another like")
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	ErrOutboxRelayUC         = errors.New("failed to relay outbox")
+	ErrSecondLookUC          = errors.New("failed to handle second look")
+	ErrQuotaExceeded         = errors.New("quota exceeded")
+	ErrUnknownQuota          = errors.New("unknown quota kind")
+	ErrBoostAlreadyActive    = errors.New("boost is already active")
+	ErrNoBoostsLeft          = errors.New("no boosts left")
+	ErrNothingToRewind       = errors.New("nothing to rewind")
+	ErrRewindLikeUC          = errors.New("failed to rewind like")
 )
 
 //easyjson:json
@@ -76,41 +143,82 @@ type Preference struct {
 
 //easyjson:json
 type Profile struct {
-	ProfileId   int          `yaml:"profileId" json:"profileId"`
-	FirstName   string       `yaml:"firstName" json:"firstName"`
-	LastName    string       `yaml:"lastName" json:"lastName"`
-	IsMale      bool         `yaml:"isMale" json:"isMale"`
-	Goal        int          `yaml:"goal" json:"goal"`
-	Height      int          `yaml:"height" json:"height"`
-	Birthday    time.Time    `yaml:"birthday" json:"birthday"`
-	Description string       `yaml:"description" json:"description"`
-	Location    string       `yaml:"location" json:"location"`
-	Interests   []string     `yaml:"interests" json:"interests"`
-	LikedBy     []int        `yaml:"likedBy" json:"likedBy"`
-	Preferences []Preference `yaml:"preferences" json:"preferences"`
-	Parameters  []Preference `yaml:"parameters" json:"parameters"`
-	Photos      []string     `yaml:"photos" json:"photos"`
-	Premium     Premium      `yaml:"Premium" json:"Premium"`
+	ProfileId   int             `yaml:"profileId" json:"profileId"`
+	FirstName   string          `yaml:"firstName" json:"firstName"`
+	LastName    string          `yaml:"lastName" json:"lastName"`
+	IsMale      bool            `yaml:"isMale" json:"isMale"`
+	Goal        int             `yaml:"goal" json:"goal"`
+	Height      int             `yaml:"height" json:"height"`
+	Birthday    time.Time       `yaml:"birthday" json:"birthday"`
+	Description string          `yaml:"description" json:"description"`
+	Location    string          `yaml:"location" json:"location"`
+	Interests   []string        `yaml:"interests" json:"interests"`
+	LikedBy     []int           `yaml:"likedBy" json:"likedBy,omitempty"`
+	Preferences []Preference    `yaml:"preferences" json:"preferences"`
+	Parameters  []Preference    `yaml:"parameters" json:"parameters"`
+	Photos      []string        `yaml:"photos" json:"photos"`
+	Premium     Premium         `yaml:"Premium" json:"Premium"`
+	Verified    bool            `yaml:"verified" json:"verified"`
+	Prompts     []ProfilePrompt `yaml:"prompts" json:"prompts"`
+	// Completeness is only filled in for the profile's owner.
+	Completeness *Completeness `yaml:"completeness" json:"completeness,omitempty"`
+	// Timezone is the IANA zone top picks are generated in.
+	Timezone string `yaml:"timezone" json:"timezone,omitempty"`
 }
 
 //easyjson:json
 type ProfileIsAdmin struct {
-	ProfileId   int          `yaml:"profileId" json:"profileId"`
-	FirstName   string       `yaml:"firstName" json:"firstName"`
-	LastName    string       `yaml:"lastName" json:"lastName"`
-	IsMale      bool         `yaml:"isMale" json:"isMale"`
-	Goal        int          `yaml:"goal" json:"goal"`
-	Height      int          `yaml:"height" json:"height"`
-	Birthday    time.Time    `yaml:"birthday" json:"birthday"`
-	Description string       `yaml:"description" json:"description"`
-	Location    string       `yaml:"location" json:"location"`
-	Interests   []string     `yaml:"interests" json:"interests"`
-	LikedBy     []int        `yaml:"likedBy" json:"likedBy"`
-	Preferences []Preference `yaml:"preferences" json:"preferences"`
-	Parameters  []Preference `yaml:"parameters" json:"parameters"`
-	Photos      []string     `yaml:"photos" json:"photos"`
-	Premium     Premium      `yaml:"Premium" json:"Premium"`
-	IsAdmin     bool         `yaml:"isAdmin" json:"isAdmin"`
+	ProfileId    int             `yaml:"profileId" json:"profileId"`
+	FirstName    string          `yaml:"firstName" json:"firstName"`
+	LastName     string          `yaml:"lastName" json:"lastName"`
+	IsMale       bool            `yaml:"isMale" json:"isMale"`
+	Goal         int             `yaml:"goal" json:"goal"`
+	Height       int             `yaml:"height" json:"height"`
+	Birthday     time.Time       `yaml:"birthday" json:"birthday"`
+	Description  string          `yaml:"description" json:"description"`
+	Location     string          `yaml:"location" json:"location"`
+	Interests    []string        `yaml:"interests" json:"interests"`
+	Preferences  []Preference    `yaml:"preferences" json:"preferences"`
+	Parameters   []Preference    `yaml:"parameters" json:"parameters"`
+	Photos       []string        `yaml:"photos" json:"photos"`
+	Premium      Premium         `yaml:"Premium" json:"Premium"`
+	Verified     bool            `yaml:"verified" json:"verified"`
+	Prompts      []ProfilePrompt `yaml:"prompts" json:"prompts"`
+	Completeness *Completeness   `yaml:"completeness" json:"completeness,omitempty"`
+	Timezone     string          `yaml:"timezone" json:"timezone,omitempty"`
+	IsAdmin      bool            `yaml:"isAdmin" json:"isAdmin"`
+}
+
+// Completeness scores a profile out of 100 against a weighted checklist.
+// Missing lists the items still to fill in, most valuable first: "photo",
+// "more_photos", "description", "interests", "preferences", "parameters"
+// and "prompts".
+//
+//easyjson:json
+type Completeness struct {
+	Score   int      `json:"score"`
+	Missing []string `json:"missing"`
+}
+
+// ProfilePrompt is an answered prompt card. On update, a blank Answer
+// removes the prompt; Question is filled in by the server.
+//
+//easyjson:json
+type ProfilePrompt struct {
+	PromptId int    `yaml:"promptId" json:"promptId"`
+	Question string `yaml:"question" json:"question"`
+	Answer   string `yaml:"answer" json:"answer"`
+}
+
+//easyjson:json
+type Prompt struct {
+	PromptId int    `json:"promptId"`
+	Question string `json:"question"`
+}
+
+//easyjson:json
+type PromptsResponse struct {
+	Prompts []Prompt `json:"prompts"`
 }
 
 //easyjson:json
@@ -187,6 +295,7 @@ type Chat struct {
 	LastMessage        string `yaml:"lastMessage" json:"lastMessage"`
 	IsRead             bool   `yaml:"isRead" json:"isRead"`
 	IsSelf             bool   `yaml:"isSelf" json:"isSelf"`
+	ReadOnly           bool   `yaml:"readOnly" json:"readOnly"`
 }
 
 //easyjson:json
@@ -280,8 +389,19 @@ type SearchProfileRequest struct {
 	Preferences []Preference `yaml:"preferences" json:"preferences"`
 	Country     string       `json:"country"`
 	City        string       `json:"city"`
+	Cursor      string       `json:"cursor"`
+	PageSize    int          `json:"pageSize"`
+	SortBy      string       `json:"sortBy"`
+	Filter      *FilterExpr  `json:"filter,omitempty"`
 }
 
+// FilterExpr is a search filter tree: "and", "or" and "not" nodes combine
+// children, "interest", "parameter", "preference" and "goal" leaves match
+// by description and value, and a "verified" leaf keeps verified profiles.
+// It is the profiles service's own type, so both sides share one proto
+// mapping.
+type FilterExpr = profilesmodel.FilterExpr
+
 //easyjson:json
 type FoundProfile struct {
 	IDUser   int    `json:"idUser"`
@@ -289,6 +409,11 @@ type FoundProfile struct {
 	Fullname string `json:"fullname"`
 	Age      int    `json:"age"`
 	Goal     int    `yaml:"goal" json:"goal"`
+	// NameHighlight and Snippet are HTML-escaped, with matches wrapped in
+	// <mark> tags.
+	NameHighlight string `json:"nameHighlight,omitempty"`
+	Snippet       string `json:"snippet,omitempty"`
+	Verified      bool   `json:"verified"`
 }
 
 //easyjson:json
@@ -327,9 +452,81 @@ type DeleteChatRequest struct {
 
 //easyjson:json
 type SetLike struct {
-	LikeFrom int `json:"likeFrom"`
-	LikeTo   int `json:"likeTo"`
-	Status   int `json:"status"`
+	LikeFrom int          `json:"likeFrom"`
+	LikeTo   int          `json:"likeTo"`
+	Status   int          `json:"status"`
+	Comment  *LikeComment `json:"comment,omitempty"`
+}
+
+// MaxLikeCommentLength bounds the comment sent with a like.
+const MaxLikeCommentLength = 150
+
+// LikeComment is a short message sent with a like. It may point at one of
+// the liked profile's photos, by path, or at one of its answered prompts,
+// but not at both.
+//
+//easyjson:json
+type LikeComment struct {
+	Text     string `json:"text"`
+	Photo    string `json:"photo,omitempty"`
+	PromptId int    `json:"promptId,omitempty"`
+}
+
+// MatchStatus is the expiry of a match nobody has written in yet. State is
+// "created" or "expiring"; once somebody writes the match no longer
+// expires.
+//
+//easyjson:json
+type MatchStatus struct {
+	ProfileId int       `json:"profileId"`
+	State     string    `json:"state"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Extended  bool      `json:"extended"`
+}
+
+//easyjson:json
+type PendingMatchesResponse struct {
+	Matches []MatchStatus `json:"matches"`
+}
+
+// MatchComment is a like comment that opens the chat of a new match.
+type MatchComment struct {
+	ProfileId int    `json:"profileId"`
+	Text      string `json:"text"`
+}
+
+// LikeResult is what setting a like did. LikeId is -1 when it made a match.
+// Replayed is set when the like repeats an earlier request with the same
+// idempotency key and so changed nothing.
+type LikeResult struct {
+	LikeId   int
+	Replayed bool
+}
+
+// OutboxEvent is an event queued by the profiles service for ProfileId,
+// with Payload as raw JSON.
+type OutboxEvent struct {
+	EventId   int
+	EventType string
+	ProfileId int
+	Payload   []byte
+}
+
+// ChatChange opens or closes the chat between FirstId and SecondId. A chat
+// the pair does not have yet is created with Seed, if there is any.
+type ChatChange struct {
+	FirstId  int
+	SecondId int
+	ReadOnly bool
+	Seed     []MatchComment
+}
+
+// OutboxEffect is what relaying an outbox event does: the notification to
+// store for the event's profile and the chat change to apply, either of
+// which may be nil.
+type OutboxEffect struct {
+	Notification *NotificationSend
+	Chat         *ChatChange
 }
 
 //easyjson:json
@@ -382,6 +579,62 @@ type ChangeBorderRequest struct {
 	NewBorder int `json:"new_border"`
 }
 
+//easyjson:json
+type IncognitoRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SecondLook is whether disliked profiles come back in the deck once their
+// dislike expires, and how many the user has swiped again. Being shown one
+// does not count.
+//
+//easyjson:json
+type SecondLook struct {
+	Enabled  bool `json:"enabled"`
+	Recycled int  `json:"recycled"`
+}
+
+//easyjson:json
+type SecondLookRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// MaxBlockReasonLength bounds the optional reason for a block or an unmatch.
+// Both take the same BlockRequest body.
+const MaxBlockReasonLength = 255
+
+//easyjson:json
+type BlockRequest struct {
+	Reason string `json:"reason"`
+}
+
+//easyjson:json
+type BlockedProfile struct {
+	ProfileId int       `json:"profileId"`
+	FirstName string    `json:"firstName"`
+	Avatar    string    `json:"avatar"`
+	BlockedAt time.Time `json:"blockedAt"`
+}
+
+//easyjson:json
+type BlocksResponse struct {
+	Blocks []BlockedProfile `json:"blocks"`
+}
+
+// PauseStatus tells whether the account is hidden from feeds, search and
+// recommendations. ResumeAt is set when the pause ends on its own.
+//
+//easyjson:json
+type PauseStatus struct {
+	Paused   bool       `json:"paused"`
+	ResumeAt *time.Time `json:"resumeAt,omitempty"`
+}
+
+//easyjson:json
+type PauseAccountRequest struct {
+	ResumeAt *time.Time `json:"resumeAt"`
+}
+
 //easyjson:json
 type AddSubRequet struct {
 	Label string `json:"label"`
@@ -440,6 +693,176 @@ type LoginResponse struct {
 	UserID  int    `json:"user_id"`
 }
 
+// PhotoVariants holds the object keys of one photo's size variants.
+//
+//easyjson:json
+type PhotoVariants struct {
+	Thumb string `json:"thumb"`
+	Card  string `json:"card"`
+	Full  string `json:"full"`
+}
+
+//easyjson:json
+type PhotoUpload struct {
+	UploadKey string    `json:"uploadKey"`
+	URL       string    `json:"url"`
+	Method    string    `json:"method"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	MaxSize   int64     `json:"maxSize"`
+}
+
+//easyjson:json
+type FinalizePhotoUploadRequest struct {
+	UploadKey string `json:"uploadKey"`
+}
+
+//easyjson:json
+type FinalizePhotoUploadResponse struct {
+	Path string `json:"path"`
+}
+
+//easyjson:json
+type PhotoURLs struct {
+	Path    string `json:"path"`
+	Thumb   string `json:"thumb"`
+	Card    string `json:"card"`
+	Full    string `json:"full"`
+	Primary bool   `json:"primary"`
+	Status  string `json:"status,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+//easyjson:json
+type PendingPhoto struct {
+	PhotoId    int       `json:"photoId"`
+	ProfileId  int       `json:"profileId"`
+	FirstName  string    `json:"firstName"`
+	Photo      PhotoURLs `json:"photo"`
+	UploadedAt time.Time `json:"uploadedAt"`
+}
+
+//easyjson:json
+type ModerationQueueResponse struct {
+	Photos    []PendingPhoto `json:"photos"`
+	ExpiresAt time.Time      `json:"expiresAt"`
+}
+
+//easyjson:json
+type PhotoCluster struct {
+	ProfileIds []int       `json:"profileIds"`
+	Photos     []PhotoURLs `json:"photos"`
+}
+
+//easyjson:json
+type PhotoClustersResponse struct {
+	Clusters  []PhotoCluster `json:"clusters"`
+	ExpiresAt time.Time      `json:"expiresAt"`
+}
+
+//easyjson:json
+type VerificationChallenge struct {
+	RequestId   int       `json:"requestId"`
+	Pose        string    `json:"pose"`
+	Instruction string    `json:"instruction"`
+	UploadURL   string    `json:"uploadUrl"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	MaxSize     int64     `json:"maxSize"`
+}
+
+// VerificationStatus is one of "none", "challenged", "submitted",
+// "approved", "rejected" or "expired". Pose and ExpiresAt are only set
+// while a challenge is open.
+//
+//easyjson:json
+type VerificationStatus struct {
+	Verified    bool       `json:"verified"`
+	Status      string     `json:"status"`
+	Pose        string     `json:"pose,omitempty"`
+	Instruction string     `json:"instruction,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+}
+
+//easyjson:json
+type PendingVerification struct {
+	RequestId   int         `json:"requestId"`
+	ProfileId   int         `json:"profileId"`
+	FirstName   string      `json:"firstName"`
+	Pose        string      `json:"pose"`
+	Instruction string      `json:"instruction"`
+	SelfieURL   string      `json:"selfieUrl"`
+	Photos      []PhotoURLs `json:"photos"`
+	SubmittedAt time.Time   `json:"submittedAt"`
+}
+
+//easyjson:json
+type VerificationQueueResponse struct {
+	Requests  []PendingVerification `json:"requests"`
+	ExpiresAt time.Time             `json:"expiresAt"`
+}
+
+//easyjson:json
+type ReviewVerificationRequest struct {
+	Approve bool   `json:"approve"`
+	Reason  string `json:"reason"`
+}
+
+// FieldChange holds the old and new values of a profile field as JSON.
+//
+//easyjson:json
+type FieldChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
+
+// ProfileVersion is a profile as it was after one change. Action is one of
+// "create", "update", "photos", "moderation" or "revert"; ActorId is 0 when
+// nobody is known to have made the change.
+//
+//easyjson:json
+type ProfileVersion struct {
+	VersionId int           `json:"versionId"`
+	ProfileId int           `json:"profileId"`
+	ActorId   int           `json:"actorId"`
+	Action    string        `json:"action"`
+	Snapshot  Profile       `json:"snapshot"`
+	Changes   []FieldChange `json:"changes"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+//easyjson:json
+type ProfileHistoryResponse struct {
+	Versions []ProfileVersion `json:"versions"`
+}
+
+//easyjson:json
+type RevertProfileRequest struct {
+	VersionId int `json:"versionId"`
+}
+
+//easyjson:json
+type ModeratePhotoRequest struct {
+	Approve bool   `json:"approve"`
+	Reason  string `json:"reason"`
+}
+
+//easyjson:json
+type ReorderPhotosRequest struct {
+	Paths []string `json:"paths"`
+}
+
+//easyjson:json
+type SetPrimaryPhotoRequest struct {
+	Path string `json:"path"`
+}
+
+//easyjson:json
+type PhotoURLsResponse struct {
+	Photos    []PhotoURLs `json:"photos"`
+	ExpiresAt time.Time   `json:"expiresAt"`
+}
+
 //easyjson:json
 type UploadResponse struct {
 	Message          string   `json:"message"`
@@ -451,9 +874,84 @@ type ProfileResponse struct {
 	Profiles []Profile `json:"profiles"`
 }
 
+//easyjson:json
+type TopPicksResponse struct {
+	Profiles  []Profile `json:"profiles"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+//easyjson:json
+type IncomingLike struct {
+	Profile Profile      `json:"profile"`
+	Status  int          `json:"status"`
+	LikedAt time.Time    `json:"likedAt"`
+	Blurred bool         `json:"blurred"`
+	Comment *LikeComment `json:"comment,omitempty"`
+}
+
+//easyjson:json
+type IncomingLikesResponse struct {
+	Likes []IncomingLike `json:"likes"`
+	Total int            `json:"total"`
+}
+
+// FoundProfileResponse is one page of search results. Total and Facets
+// cover the whole result set and come only with the first page; pages
+// fetched with a cursor omit both.
+//
 //easyjson:json
 type FoundProfileResponse struct {
-	Profiles []FoundProfile `json:"profiles"`
+	Profiles   []FoundProfile `json:"profiles"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+	Total      *int           `json:"total,omitempty"`
+	Facets     *SearchFacets  `json:"facets,omitempty"`
+}
+
+//easyjson:json
+type SavedSearch struct {
+	SearchID  int                  `json:"searchId"`
+	Name      string               `json:"name"`
+	Params    SearchProfileRequest `json:"params"`
+	Alerts    bool                 `json:"alerts"`
+	CreatedAt time.Time            `json:"createdAt"`
+}
+
+//easyjson:json
+type SaveSearchRequest struct {
+	Name   string               `json:"name"`
+	Params SearchProfileRequest `json:"params"`
+	Alerts bool                 `json:"alerts"`
+}
+
+//easyjson:json
+type SavedSearchesResponse struct {
+	Searches []SavedSearch `json:"searches"`
+}
+
+//easyjson:json
+type Suggestion struct {
+	Value      string `json:"value"`
+	Context    string `json:"context,omitempty"`
+	Popularity int    `json:"popularity"`
+}
+
+//easyjson:json
+type AutocompleteResponse struct {
+	Suggestions []Suggestion `json:"suggestions"`
+}
+
+//easyjson:json
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+//easyjson:json
+type SearchFacets struct {
+	Goals      []FacetCount `json:"goals"`
+	AgeBuckets []FacetCount `json:"ageBuckets"`
+	Cities     []FacetCount `json:"cities"`
+	Interests  []FacetCount `json:"interests"`
 }
 
 //easyjson:json
@@ -500,11 +998,120 @@ type UserAnswer struct {
 
 //easyjson:json
 type ProfileStats struct {
-	LikesGiven         int `json:"likesGiven"`
-	LikesReceived      int `json:"likesReceived"`
-	Matches            int `json:"matches"`
-	ComplaintsMade     int `json:"complaintsMade"`
-	ComplaintsReceived int `json:"complaintsReceived"`
-	MessagesSent       int `json:"messagesSent"`
-	ChatCount          int `json:"chatCount"`
+	LikesGiven         int          `json:"likesGiven"`
+	LikesReceived      int          `json:"likesReceived"`
+	Matches            int          `json:"matches"`
+	ComplaintsMade     int          `json:"complaintsMade"`
+	ComplaintsReceived int          `json:"complaintsReceived"`
+	MessagesSent       int          `json:"messagesSent"`
+	ChatCount          int          `json:"chatCount"`
+	Recycled           int          `json:"recycled"`
+	Boosts             []Boost      `json:"boosts"`
+	Completeness       Completeness `json:"completeness"`
+}
+
+//easyjson:json
+type Boost struct {
+	BoostID   int       `json:"boostId"`
+	Source    string    `json:"source"`
+	StartedAt time.Time `json:"startedAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	Views     int       `json:"views"`
+	Likes     int       `json:"likes"`
+	Matches   int       `json:"matches"`
+}
+
+//easyjson:json
+type RewindLikeResponse struct {
+	ProfileId int `json:"profileId"`
+}
+
+//easyjson:json
+type ActivateBoostResponse struct {
+	Boost     Boost `json:"boost"`
+	Remaining int   `json:"remaining"`
+}
+
+const (
+	PlanFree    = "free"
+	PlanPremium = "premium"
+	// PlanUnverified applies to free accounts that have not passed selfie
+	// verification yet, when UnverifiedPlanEnabled is set.
+	PlanUnverified = "unverified"
+)
+
+// UnverifiedPlanEnabled puts unverified free accounts on PlanUnverified.
+// It is off by default, so they get PlanFree; set UNVERIFIED_PLAN=true to
+// opt in.
+var UnverifiedPlanEnabled = false
+
+const (
+	QuotaFeeds      = "feeds"
+	QuotaSwipes     = "swipes"
+	QuotaSuperlikes = "superlikes"
+	QuotaRewinds    = "rewinds"
+	QuotaBoosts     = "boosts"
+	QuotaComments   = "comments"
+)
+
+// A calendar window resets at fixed UTC boundaries (every Period since the
+// Unix epoch), a rolling window expires Period after the first use in it.
+const (
+	WindowCalendar = "calendar"
+	WindowRolling  = "rolling"
+)
+
+const QuotaUnlimited = -1
+
+type QuotaRule struct {
+	Limit  int
+	Window string
+	Period time.Duration
+}
+
+// QuotaBoosts is the plan's boost allowance; once it is used up, boosts come
+// from the purchased inventory the profiles service keeps.
+var QuotaKinds = []string{QuotaFeeds, QuotaSwipes, QuotaSuperlikes, QuotaRewinds, QuotaBoosts, QuotaComments}
+
+var QuotaPlans = map[string]map[string]QuotaRule{
+	PlanUnverified: {
+		QuotaFeeds:      {Limit: 3, Window: WindowRolling, Period: 12 * time.Hour},
+		QuotaSwipes:     {Limit: 30, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaSuperlikes: {Limit: 0, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaRewinds:    {Limit: 0, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaBoosts:     {Limit: 0, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaComments:   {Limit: 0, Window: WindowCalendar, Period: 24 * time.Hour},
+	},
+	PlanFree: {
+		QuotaFeeds:      {Limit: 5, Window: WindowRolling, Period: 12 * time.Hour},
+		QuotaSwipes:     {Limit: 100, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaSuperlikes: {Limit: 0, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaRewinds:    {Limit: 0, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaBoosts:     {Limit: 0, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaComments:   {Limit: 1, Window: WindowCalendar, Period: 24 * time.Hour},
+	},
+	PlanPremium: {
+		QuotaFeeds:      {Limit: QuotaUnlimited, Window: WindowRolling, Period: 12 * time.Hour},
+		QuotaSwipes:     {Limit: QuotaUnlimited, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaSuperlikes: {Limit: 5, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaRewinds:    {Limit: 10, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaBoosts:     {Limit: 1, Window: WindowCalendar, Period: 24 * time.Hour},
+		QuotaComments:   {Limit: 10, Window: WindowCalendar, Period: 24 * time.Hour},
+	},
+}
+
+//easyjson:json
+type QuotaStatus struct {
+	Kind      string    `json:"kind"`
+	Limit     int       `json:"limit"`
+	Used      int       `json:"used"`
+	Remaining int       `json:"remaining"`
+	Window    string    `json:"window"`
+	ResetsAt  time.Time `json:"resetsAt"`
+}
+
+//easyjson:json
+type QuotaResponse struct {
+	Plan   string        `json:"plan"`
+	Quotas []QuotaStatus `json:"quotas"`
 }
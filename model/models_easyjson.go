@@ -4,6 +4,7 @@ package model
 
 import (
 	json "encoding/json"
+	model "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -93,7 +94,7 @@ func (v *WSMessage) UnmarshalJSON(data []byte) error {
 func (v *WSMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel1(in *jlexer.Lexer, out *UsersForQuery) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel1(in *jlexer.Lexer, out *VerificationStatus) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -112,20 +113,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel1(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "minScore":
-			out.MinScore = int(in.Int())
-		case "maxScore":
-			out.MaxScore = int(in.Int())
-		case "login":
-			out.Login = string(in.String())
-		case "answer":
-			out.Answer = string(in.String())
-		case "score":
-			out.Score = int(in.Int())
+		case "verified":
+			out.Verified = bool(in.Bool())
+		case "status":
+			out.Status = string(in.String())
+		case "pose":
+			out.Pose = string(in.String())
+		case "instruction":
+			out.Instruction = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "expiresAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpiresAt = nil
+			} else {
+				if out.ExpiresAt == nil {
+					out.ExpiresAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpiresAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -136,72 +145,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel1(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel1(out *jwriter.Writer, in UsersForQuery) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel1(out *jwriter.Writer, in VerificationStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"verified\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.Bool(bool(in.Verified))
 	}
 	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"minScore\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.Int(int(in.MinScore))
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"maxScore\":"
+	if in.Pose != "" {
+		const prefix string = ",\"pose\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxScore))
+		out.String(string(in.Pose))
 	}
-	{
-		const prefix string = ",\"login\":"
+	if in.Instruction != "" {
+		const prefix string = ",\"instruction\":"
 		out.RawString(prefix)
-		out.String(string(in.Login))
+		out.String(string(in.Instruction))
 	}
-	{
-		const prefix string = ",\"answer\":"
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
 		out.RawString(prefix)
-		out.String(string(in.Answer))
+		out.String(string(in.Reason))
 	}
-	{
-		const prefix string = ",\"score\":"
+	if in.ExpiresAt != nil {
+		const prefix string = ",\"expiresAt\":"
 		out.RawString(prefix)
-		out.Int(int(in.Score))
+		out.Raw((*in.ExpiresAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UsersForQuery) MarshalJSON() ([]byte, error) {
+func (v VerificationStatus) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UsersForQuery) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VerificationStatus) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UsersForQuery) UnmarshalJSON(data []byte) error {
+func (v *VerificationStatus) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UsersForQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VerificationStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel1(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel2(in *jlexer.Lexer, out *UserAnswer) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel2(in *jlexer.Lexer, out *VerificationQueueResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -220,12 +224,33 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel2(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "score":
-			out.Score = int32(in.Int32())
-		case "answer":
-			out.Answer = string(in.String())
+		case "requests":
+			if in.IsNull() {
+				in.Skip()
+				out.Requests = nil
+			} else {
+				in.Delim('[')
+				if out.Requests == nil {
+					if !in.IsDelim(']') {
+						out.Requests = make([]PendingVerification, 0, 0)
+					} else {
+						out.Requests = []PendingVerification{}
+					}
+				} else {
+					out.Requests = (out.Requests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 PendingVerification
+					(v1).UnmarshalEasyJSON(in)
+					out.Requests = append(out.Requests, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -236,52 +261,58 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel2(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel2(out *jwriter.Writer, in UserAnswer) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel2(out *jwriter.Writer, in VerificationQueueResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"requests\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"score\":"
-		out.RawString(prefix)
-		out.Int32(int32(in.Score))
+		if in.Requests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Requests {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"answer\":"
+		const prefix string = ",\"expiresAt\":"
 		out.RawString(prefix)
-		out.String(string(in.Answer))
+		out.Raw((in.ExpiresAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UserAnswer) MarshalJSON() ([]byte, error) {
+func (v VerificationQueueResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UserAnswer) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VerificationQueueResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UserAnswer) UnmarshalJSON(data []byte) error {
+func (v *VerificationQueueResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UserAnswer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VerificationQueueResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel2(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel3(in *jlexer.Lexer, out *User) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel3(in *jlexer.Lexer, out *VerificationChallenge) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -300,18 +331,20 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel3(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "id":
-			out.UserId = int(in.Int())
-		case "login":
-			out.Login = string(in.String())
-		case "password":
-			out.Password = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "phone":
-			out.Phone = string(in.String())
-		case "status":
-			out.Status = int(in.Int())
+		case "requestId":
+			out.RequestId = int(in.Int())
+		case "pose":
+			out.Pose = string(in.String())
+		case "instruction":
+			out.Instruction = string(in.String())
+		case "uploadUrl":
+			out.UploadURL = string(in.String())
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "maxSize":
+			out.MaxSize = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -322,67 +355,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel3(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel3(out *jwriter.Writer, in User) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel3(out *jwriter.Writer, in VerificationChallenge) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"requestId\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.UserId))
+		out.Int(int(in.RequestId))
 	}
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"pose\":"
 		out.RawString(prefix)
-		out.String(string(in.Login))
+		out.String(string(in.Pose))
 	}
 	{
-		const prefix string = ",\"password\":"
+		const prefix string = ",\"instruction\":"
 		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.String(string(in.Instruction))
 	}
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"uploadUrl\":"
 		out.RawString(prefix)
-		out.String(string(in.Email))
+		out.String(string(in.UploadURL))
 	}
 	{
-		const prefix string = ",\"phone\":"
+		const prefix string = ",\"expiresAt\":"
 		out.RawString(prefix)
-		out.String(string(in.Phone))
+		out.Raw((in.ExpiresAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"maxSize\":"
 		out.RawString(prefix)
-		out.Int(int(in.Status))
+		out.Int64(int64(in.MaxSize))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v User) MarshalJSON() ([]byte, error) {
+func (v VerificationChallenge) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v User) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VerificationChallenge) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *User) UnmarshalJSON(data []byte) error {
+func (v *VerificationChallenge) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VerificationChallenge) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel3(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel4(in *jlexer.Lexer, out *UploadResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel4(in *jlexer.Lexer, out *UsersForQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -401,31 +434,20 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel4(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
-		case "sucessful_uploads":
-			if in.IsNull() {
-				in.Skip()
-				out.SucessfulUploads = nil
-			} else {
-				in.Delim('[')
-				if out.SucessfulUploads == nil {
-					if !in.IsDelim(']') {
-						out.SucessfulUploads = make([]string, 0, 4)
-					} else {
-						out.SucessfulUploads = []string{}
-					}
-				} else {
-					out.SucessfulUploads = (out.SucessfulUploads)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 string
-					v1 = string(in.String())
-					out.SucessfulUploads = append(out.SucessfulUploads, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "minScore":
+			out.MinScore = int(in.Int())
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		case "login":
+			out.Login = string(in.String())
+		case "answer":
+			out.Answer = string(in.String())
+		case "score":
+			out.Score = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -436,58 +458,72 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel4(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel4(out *jwriter.Writer, in UploadResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel4(out *jwriter.Writer, in UsersForQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"sucessful_uploads\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		if in.SucessfulUploads == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v2, v3 := range in.SucessfulUploads {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v3))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"minScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinScore))
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix)
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"answer\":"
+		out.RawString(prefix)
+		out.String(string(in.Answer))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UploadResponse) MarshalJSON() ([]byte, error) {
+func (v UsersForQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UploadResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UsersForQuery) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UploadResponse) UnmarshalJSON(data []byte) error {
+func (v *UsersForQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UploadResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UsersForQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Lexer, out *TimeConstraints) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Lexer, out *UserAnswer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -506,14 +542,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "time_from":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.TimeFrom).UnmarshalJSON(data))
-			}
-		case "time_to":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.TimeTo).UnmarshalJSON(data))
-			}
+		case "name":
+			out.Name = string(in.String())
+		case "score":
+			out.Score = int32(in.Int32())
+		case "answer":
+			out.Answer = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -524,47 +558,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(out *jwriter.Writer, in TimeConstraints) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(out *jwriter.Writer, in UserAnswer) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"time_from\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Raw((in.TimeFrom).MarshalJSON())
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"time_to\":"
+		const prefix string = ",\"score\":"
 		out.RawString(prefix)
-		out.Raw((in.TimeTo).MarshalJSON())
+		out.Int32(int32(in.Score))
+	}
+	{
+		const prefix string = ",\"answer\":"
+		out.RawString(prefix)
+		out.String(string(in.Answer))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TimeConstraints) MarshalJSON() ([]byte, error) {
+func (v UserAnswer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UserAnswer) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TimeConstraints) UnmarshalJSON(data []byte) error {
+func (v *UserAnswer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UserAnswer) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(in *jlexer.Lexer, out *SignUpRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(in *jlexer.Lexer, out *User) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -583,61 +622,89 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "user":
-			(out.User).UnmarshalEasyJSON(in)
-		case "profile":
-			(out.Profile).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
+		case "id":
+			out.UserId = int(in.Int())
+		case "login":
+			out.Login = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "status":
+			out.Status = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(out *jwriter.Writer, in SignUpRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(out *jwriter.Writer, in User) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		(in.User).MarshalEasyJSON(out)
+		out.Int(int(in.UserId))
 	}
 	{
-		const prefix string = ",\"profile\":"
+		const prefix string = ",\"login\":"
 		out.RawString(prefix)
-		(in.Profile).MarshalEasyJSON(out)
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SignUpRequest) MarshalJSON() ([]byte, error) {
+func (v User) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SignUpRequest) MarshalEasyJSON(w *jwriter.Writer) {
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SignUpRequest) UnmarshalJSON(data []byte) error {
+func (v *User) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SignUpRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Lexer, out *SetLike) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Lexer, out *UploadResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -656,12 +723,31 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "likeFrom":
-			out.LikeFrom = int(in.Int())
-		case "likeTo":
-			out.LikeTo = int(in.Int())
-		case "status":
-			out.Status = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "sucessful_uploads":
+			if in.IsNull() {
+				in.Skip()
+				out.SucessfulUploads = nil
+			} else {
+				in.Delim('[')
+				if out.SucessfulUploads == nil {
+					if !in.IsDelim(']') {
+						out.SucessfulUploads = make([]string, 0, 4)
+					} else {
+						out.SucessfulUploads = []string{}
+					}
+				} else {
+					out.SucessfulUploads = (out.SucessfulUploads)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.SucessfulUploads = append(out.SucessfulUploads, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -672,52 +758,58 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(out *jwriter.Writer, in SetLike) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(out *jwriter.Writer, in UploadResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"likeFrom\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.LikeFrom))
-	}
-	{
-		const prefix string = ",\"likeTo\":"
-		out.RawString(prefix)
-		out.Int(int(in.LikeTo))
+		out.String(string(in.Message))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"sucessful_uploads\":"
 		out.RawString(prefix)
-		out.Int(int(in.Status))
+		if in.SucessfulUploads == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.SucessfulUploads {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SetLike) MarshalJSON() ([]byte, error) {
+func (v UploadResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SetLike) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UploadResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SetLike) UnmarshalJSON(data []byte) error {
+func (v *UploadResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SetLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UploadResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel7(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Lexer, out *SessionCheckSuccessResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Lexer, out *TopPicksResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -736,12 +828,33 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
-		case "inSession":
-			out.InSession = bool(in.Bool())
-		case "id":
-			out.UserId = int(in.Int())
+		case "profiles":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]Profile, 0, 0)
+					} else {
+						out.Profiles = []Profile{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 Profile
+					(v7).UnmarshalEasyJSON(in)
+					out.Profiles = append(out.Profiles, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -752,52 +865,58 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(out *jwriter.Writer, in SessionCheckSuccessResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(out *jwriter.Writer, in TopPicksResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"profiles\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
-	}
-	{
-		const prefix string = ",\"inSession\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.InSession))
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Profiles {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"expiresAt\":"
 		out.RawString(prefix)
-		out.Int(int(in.UserId))
+		out.Raw((in.ExpiresAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SessionCheckSuccessResponse) MarshalJSON() ([]byte, error) {
+func (v TopPicksResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SessionCheckSuccessResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TopPicksResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SessionCheckSuccessResponse) UnmarshalJSON(data []byte) error {
+func (v *TopPicksResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SessionCheckSuccessResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TopPicksResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel8(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Lexer, out *SessionCheckResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Lexer, out *TimeConstraints) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -816,10 +935,14 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Le
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
-		case "inSession":
-			out.InSession = bool(in.Bool())
+		case "time_from":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TimeFrom).UnmarshalJSON(data))
+			}
+		case "time_to":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TimeTo).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -830,47 +953,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(in *jlexer.Le
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(out *jwriter.Writer, in SessionCheckResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(out *jwriter.Writer, in TimeConstraints) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"time_from\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.Raw((in.TimeFrom).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"inSession\":"
+		const prefix string = ",\"time_to\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.InSession))
+		out.Raw((in.TimeTo).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SessionCheckResponse) MarshalJSON() ([]byte, error) {
+func (v TimeConstraints) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SessionCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SessionCheckResponse) UnmarshalJSON(data []byte) error {
+func (v *TimeConstraints) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SessionCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel9(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.Lexer, out *Session) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.Lexer, out *Suggestion) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -889,12 +1012,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "sessionId":
-			out.SessionId = string(in.String())
-		case "userId":
-			out.UserId = int(in.Int())
-		case "expires":
-			out.Expires = time.Duration(in.Int64())
+		case "value":
+			out.Value = string(in.String())
+		case "context":
+			out.Context = string(in.String())
+		case "popularity":
+			out.Popularity = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -905,52 +1028,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(out *jwriter.Writer, in Session) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(out *jwriter.Writer, in Suggestion) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"sessionId\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.SessionId))
+		out.String(string(in.Value))
 	}
-	{
-		const prefix string = ",\"userId\":"
+	if in.Context != "" {
+		const prefix string = ",\"context\":"
 		out.RawString(prefix)
-		out.Int(int(in.UserId))
+		out.String(string(in.Context))
 	}
 	{
-		const prefix string = ",\"expires\":"
+		const prefix string = ",\"popularity\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Expires))
+		out.Int(int(in.Popularity))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Session) MarshalJSON() ([]byte, error) {
+func (v Suggestion) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Session) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Suggestion) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Session) UnmarshalJSON(data []byte) error {
+func (v *Suggestion) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Session) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Suggestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel10(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.Lexer, out *SearchProfileRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.Lexer, out *SignUpRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -969,47 +1092,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "input":
-			out.Input = string(in.String())
-		case "isMale":
-			out.IsMale = string(in.String())
-		case "ageMin":
-			out.AgeMin = int(in.Int())
-		case "ageMax":
-			out.AgeMax = int(in.Int())
-		case "heightMin":
-			out.HeightMin = int(in.Int())
-		case "heightMax":
-			out.HeightMax = int(in.Int())
-		case "goal":
-			out.Goal = int(in.Int())
-		case "preferences":
-			if in.IsNull() {
-				in.Skip()
-				out.Preferences = nil
-			} else {
-				in.Delim('[')
-				if out.Preferences == nil {
-					if !in.IsDelim(']') {
-						out.Preferences = make([]Preference, 0, 2)
-					} else {
-						out.Preferences = []Preference{}
-					}
-				} else {
-					out.Preferences = (out.Preferences)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 Preference
-					(v4).UnmarshalEasyJSON(in)
-					out.Preferences = append(out.Preferences, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "country":
-			out.Country = string(in.String())
-		case "city":
-			out.City = string(in.String())
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1020,98 +1106,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(out *jwriter.Writer, in SearchProfileRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(out *jwriter.Writer, in SignUpRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"input\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Input))
-	}
-	{
-		const prefix string = ",\"isMale\":"
-		out.RawString(prefix)
-		out.String(string(in.IsMale))
-	}
-	{
-		const prefix string = ",\"ageMin\":"
-		out.RawString(prefix)
-		out.Int(int(in.AgeMin))
-	}
-	{
-		const prefix string = ",\"ageMax\":"
-		out.RawString(prefix)
-		out.Int(int(in.AgeMax))
-	}
-	{
-		const prefix string = ",\"heightMin\":"
-		out.RawString(prefix)
-		out.Int(int(in.HeightMin))
-	}
-	{
-		const prefix string = ",\"heightMax\":"
-		out.RawString(prefix)
-		out.Int(int(in.HeightMax))
-	}
-	{
-		const prefix string = ",\"goal\":"
-		out.RawString(prefix)
-		out.Int(int(in.Goal))
-	}
-	{
-		const prefix string = ",\"preferences\":"
-		out.RawString(prefix)
-		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.Preferences {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				(v6).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"country\":"
-		out.RawString(prefix)
-		out.String(string(in.Country))
+		(in.User).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"city\":"
+		const prefix string = ",\"profile\":"
 		out.RawString(prefix)
-		out.String(string(in.City))
+		(in.Profile).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SearchProfileRequest) MarshalJSON() ([]byte, error) {
+func (v SignUpRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SignUpRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchProfileRequest) UnmarshalJSON(data []byte) error {
+func (v *SignUpRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SignUpRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel11(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.Lexer, out *ReadPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.Lexer, out *SetPrimaryPhotoRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1130,8 +1165,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
-			out.ChatID = int(in.Int())
+		case "path":
+			out.Path = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1142,42 +1177,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(out *jwriter.Writer, in ReadPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(out *jwriter.Writer, in SetPrimaryPhotoRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"path\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatID))
+		out.String(string(in.Path))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReadPayload) MarshalJSON() ([]byte, error) {
+func (v SetPrimaryPhotoRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReadPayload) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SetPrimaryPhotoRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReadPayload) UnmarshalJSON(data []byte) error {
+func (v *SetPrimaryPhotoRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReadPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SetPrimaryPhotoRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel12(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.Lexer, out *ReadNotifPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.Lexer, out *SetLike) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1196,8 +1231,22 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "notif_type":
-			out.NotifType = string(in.String())
+		case "likeFrom":
+			out.LikeFrom = int(in.Int())
+		case "likeTo":
+			out.LikeTo = int(in.Int())
+		case "status":
+			out.Status = int(in.Int())
+		case "comment":
+			if in.IsNull() {
+				in.Skip()
+				out.Comment = nil
+			} else {
+				if out.Comment == nil {
+					out.Comment = new(LikeComment)
+				}
+				(*out.Comment).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1208,42 +1257,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(out *jwriter.Writer, in ReadNotifPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(out *jwriter.Writer, in SetLike) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notif_type\":"
+		const prefix string = ",\"likeFrom\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.NotifType))
+		out.Int(int(in.LikeFrom))
+	}
+	{
+		const prefix string = ",\"likeTo\":"
+		out.RawString(prefix)
+		out.Int(int(in.LikeTo))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	if in.Comment != nil {
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		(*in.Comment).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReadNotifPayload) MarshalJSON() ([]byte, error) {
+func (v SetLike) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReadNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SetLike) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReadNotifPayload) UnmarshalJSON(data []byte) error {
+func (v *SetLike) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReadNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SetLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel13(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.Lexer, out *RawTimeConstraints) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.Lexer, out *SessionCheckSuccessResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1262,26 +1326,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "time_from":
-			if in.IsNull() {
-				in.Skip()
-				out.TimeFrom = nil
-			} else {
-				if out.TimeFrom == nil {
-					out.TimeFrom = new(string)
-				}
-				*out.TimeFrom = string(in.String())
-			}
-		case "time_to":
-			if in.IsNull() {
-				in.Skip()
-				out.TimeTo = nil
-			} else {
-				if out.TimeTo == nil {
-					out.TimeTo = new(string)
-				}
-				*out.TimeTo = string(in.String())
-			}
+		case "message":
+			out.Message = string(in.String())
+		case "inSession":
+			out.InSession = bool(in.Bool())
+		case "id":
+			out.UserId = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1292,55 +1342,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(out *jwriter.Writer, in RawTimeConstraints) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(out *jwriter.Writer, in SessionCheckSuccessResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"time_from\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		if in.TimeFrom == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.TimeFrom))
-		}
+		out.String(string(in.Message))
 	}
 	{
-		const prefix string = ",\"time_to\":"
+		const prefix string = ",\"inSession\":"
 		out.RawString(prefix)
-		if in.TimeTo == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.TimeTo))
-		}
+		out.Bool(bool(in.InSession))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserId))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RawTimeConstraints) MarshalJSON() ([]byte, error) {
+func (v SessionCheckSuccessResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RawTimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SessionCheckSuccessResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RawTimeConstraints) UnmarshalJSON(data []byte) error {
+func (v *SessionCheckSuccessResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RawTimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SessionCheckSuccessResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel14(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.Lexer, out *QueryStats) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.Lexer, out *SessionCheckResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1359,14 +1406,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "TotalAnswers":
-			out.TotalAnswers = int(in.Int())
-		case "AverageScore":
-			out.AverageScore = float64(in.Float64())
-		case "MinScore":
-			out.MinScore = int(in.Int())
-		case "MaxScore":
-			out.MaxScore = int(in.Int())
+		case "message":
+			out.Message = string(in.String())
+		case "inSession":
+			out.InSession = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1377,57 +1420,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(out *jwriter.Writer, in QueryStats) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(out *jwriter.Writer, in SessionCheckResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"TotalAnswers\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalAnswers))
-	}
-	{
-		const prefix string = ",\"AverageScore\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.AverageScore))
-	}
-	{
-		const prefix string = ",\"MinScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MinScore))
+		out.String(string(in.Message))
 	}
 	{
-		const prefix string = ",\"MaxScore\":"
+		const prefix string = ",\"inSession\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxScore))
+		out.Bool(bool(in.InSession))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryStats) MarshalJSON() ([]byte, error) {
+func (v SessionCheckResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueryStats) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SessionCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryStats) UnmarshalJSON(data []byte) error {
+func (v *SessionCheckResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueryStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SessionCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel15(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.Lexer, out *QueryResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.Lexer, out *Session) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1446,29 +1479,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "queryis":
-			if in.IsNull() {
-				in.Skip()
-				out.Queries = nil
-			} else {
-				in.Delim('[')
-				if out.Queries == nil {
-					if !in.IsDelim(']') {
-						out.Queries = make([]QueryForUser, 0, 0)
-					} else {
-						out.Queries = []QueryForUser{}
-					}
-				} else {
-					out.Queries = (out.Queries)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 QueryForUser
-					(v7).UnmarshalEasyJSON(in)
-					out.Queries = append(out.Queries, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "sessionId":
+			out.SessionId = string(in.String())
+		case "userId":
+			out.UserId = int(in.Int())
+		case "expires":
+			out.Expires = time.Duration(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1479,53 +1495,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(out *jwriter.Writer, in QueryResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(out *jwriter.Writer, in Session) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"queryis\":"
+		const prefix string = ",\"sessionId\":"
 		out.RawString(prefix[1:])
-		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Queries {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.SessionId))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v QueryResponse) MarshalJSON() ([]byte, error) {
+	{
+		const prefix string = ",\"userId\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserId))
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Expires))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Session) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v Session) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryResponse) UnmarshalJSON(data []byte) error {
+func (v *Session) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *Session) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel16(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.Lexer, out *QueryForUser) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.Lexer, out *SecondLookRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1544,18 +1559,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "minScore":
-			out.MinScore = int(in.Int())
-		case "maxScore":
-			out.MaxScore = int(in.Int())
-		case "score":
-			out.Score = int(in.Int())
-		case "answer":
-			out.Answer = string(in.String())
+		case "enabled":
+			out.Enabled = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1566,67 +1571,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(out *jwriter.Writer, in QueryForUser) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(out *jwriter.Writer, in SecondLookRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"enabled\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"minScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MinScore))
-	}
-	{
-		const prefix string = ",\"maxScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MaxScore))
-	}
-	{
-		const prefix string = ",\"score\":"
-		out.RawString(prefix)
-		out.Int(int(in.Score))
-	}
-	{
-		const prefix string = ",\"answer\":"
-		out.RawString(prefix)
-		out.String(string(in.Answer))
+		out.Bool(bool(in.Enabled))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QueryForUser) MarshalJSON() ([]byte, error) {
+func (v SecondLookRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QueryForUser) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SecondLookRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QueryForUser) UnmarshalJSON(data []byte) error {
+func (v *SecondLookRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QueryForUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SecondLookRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel17(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.Lexer, out *Query) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.Lexer, out *SecondLook) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1645,14 +1625,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "minScore":
-			out.MinScore = int(in.Int())
-		case "maxScore":
-			out.MaxScore = int(in.Int())
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		case "recycled":
+			out.Recycled = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1663,57 +1639,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(out *jwriter.Writer, in Query) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(out *jwriter.Writer, in SecondLook) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"enabled\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Bool(bool(in.Enabled))
 	}
 	{
-		const prefix string = ",\"minScore\":"
-		out.RawString(prefix)
-		out.Int(int(in.MinScore))
-	}
-	{
-		const prefix string = ",\"maxScore\":"
+		const prefix string = ",\"recycled\":"
 		out.RawString(prefix)
-		out.Int(int(in.MaxScore))
+		out.Int(int(in.Recycled))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Query) MarshalJSON() ([]byte, error) {
+func (v SecondLook) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Query) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SecondLook) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Query) UnmarshalJSON(data []byte) error {
+func (v *SecondLook) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Query) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SecondLook) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel18(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.Lexer, out *QuerResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.Lexer, out *SearchProfileRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1732,29 +1698,63 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "queryis":
+		case "input":
+			out.Input = string(in.String())
+		case "isMale":
+			out.IsMale = string(in.String())
+		case "ageMin":
+			out.AgeMin = int(in.Int())
+		case "ageMax":
+			out.AgeMax = int(in.Int())
+		case "heightMin":
+			out.HeightMin = int(in.Int())
+		case "heightMax":
+			out.HeightMax = int(in.Int())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "preferences":
 			if in.IsNull() {
 				in.Skip()
-				out.Queries = nil
+				out.Preferences = nil
 			} else {
 				in.Delim('[')
-				if out.Queries == nil {
+				if out.Preferences == nil {
 					if !in.IsDelim(']') {
-						out.Queries = make([]Query, 0, 1)
+						out.Preferences = make([]Preference, 0, 2)
 					} else {
-						out.Queries = []Query{}
+						out.Preferences = []Preference{}
 					}
 				} else {
-					out.Queries = (out.Queries)[:0]
+					out.Preferences = (out.Preferences)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 Query
+					var v10 Preference
 					(v10).UnmarshalEasyJSON(in)
-					out.Queries = append(out.Queries, v10)
+					out.Preferences = append(out.Preferences, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "country":
+			out.Country = string(in.String())
+		case "city":
+			out.City = string(in.String())
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "pageSize":
+			out.PageSize = int(in.Int())
+		case "sortBy":
+			out.SortBy = string(in.String())
+		case "filter":
+			if in.IsNull() {
+				in.Skip()
+				out.Filter = nil
+			} else {
+				if out.Filter == nil {
+					out.Filter = new(model.FilterExpr)
+				}
+				easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebProfilesMicroModel(in, out.Filter)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1765,18 +1765,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(out *jwriter.Writer, in QuerResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(out *jwriter.Writer, in SearchProfileRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"queryis\":"
+		const prefix string = ",\"input\":"
 		out.RawString(prefix[1:])
-		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.String(string(in.Input))
+	}
+	{
+		const prefix string = ",\"isMale\":"
+		out.RawString(prefix)
+		out.String(string(in.IsMale))
+	}
+	{
+		const prefix string = ",\"ageMin\":"
+		out.RawString(prefix)
+		out.Int(int(in.AgeMin))
+	}
+	{
+		const prefix string = ",\"ageMax\":"
+		out.RawString(prefix)
+		out.Int(int(in.AgeMax))
+	}
+	{
+		const prefix string = ",\"heightMin\":"
+		out.RawString(prefix)
+		out.Int(int(in.HeightMin))
+	}
+	{
+		const prefix string = ",\"heightMax\":"
+		out.RawString(prefix)
+		out.Int(int(in.HeightMax))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix)
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Queries {
+			for v11, v12 := range in.Preferences {
 				if v11 > 0 {
 					out.RawByte(',')
 				}
@@ -1785,33 +1820,63 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(out *jwriter
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"country\":"
+		out.RawString(prefix)
+		out.String(string(in.Country))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"pageSize\":"
+		out.RawString(prefix)
+		out.Int(int(in.PageSize))
+	}
+	{
+		const prefix string = ",\"sortBy\":"
+		out.RawString(prefix)
+		out.String(string(in.SortBy))
+	}
+	if in.Filter != nil {
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebProfilesMicroModel(out, *in.Filter)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v QuerResponse) MarshalJSON() ([]byte, error) {
+func (v SearchProfileRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v QuerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v SearchProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *QuerResponse) UnmarshalJSON(data []byte) error {
+func (v *SearchProfileRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *QuerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *SearchProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel19(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.Lexer, out *ProfileStats) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebProfilesMicroModel(in *jlexer.Lexer, out *model.FilterExpr) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1830,20 +1895,35 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "likesGiven":
-			out.LikesGiven = int(in.Int())
-		case "likesReceived":
-			out.LikesReceived = int(in.Int())
-		case "matches":
-			out.Matches = int(in.Int())
-		case "complaintsMade":
-			out.ComplaintsMade = int(in.Int())
-		case "complaintsReceived":
-			out.ComplaintsReceived = int(in.Int())
-		case "messagesSent":
-			out.MessagesSent = int(in.Int())
-		case "chatCount":
-			out.ChatCount = int(in.Int())
+		case "op":
+			out.Op = string(in.String())
+		case "children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]model.FilterExpr, 0, 0)
+					} else {
+						out.Children = []model.FilterExpr{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 model.FilterExpr
+					easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebProfilesMicroModel(in, &v13)
+					out.Children = append(out.Children, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "value":
+			out.Value = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1854,170 +1934,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(out *jwriter.Writer, in ProfileStats) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebProfilesMicroModel(out *jwriter.Writer, in model.FilterExpr) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"likesGiven\":"
+		const prefix string = ",\"op\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.LikesGiven))
-	}
-	{
-		const prefix string = ",\"likesReceived\":"
-		out.RawString(prefix)
-		out.Int(int(in.LikesReceived))
+		out.String(string(in.Op))
 	}
-	{
-		const prefix string = ",\"matches\":"
+	if len(in.Children) != 0 {
+		const prefix string = ",\"children\":"
 		out.RawString(prefix)
-		out.Int(int(in.Matches))
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.Children {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebProfilesMicroModel(out, v15)
+			}
+			out.RawByte(']')
+		}
 	}
-	{
-		const prefix string = ",\"complaintsMade\":"
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.Int(int(in.ComplaintsMade))
+		out.String(string(in.Description))
 	}
-	{
-		const prefix string = ",\"complaintsReceived\":"
+	if in.Value != "" {
+		const prefix string = ",\"value\":"
 		out.RawString(prefix)
-		out.Int(int(in.ComplaintsReceived))
-	}
-	{
-		const prefix string = ",\"messagesSent\":"
-		out.RawString(prefix)
-		out.Int(int(in.MessagesSent))
-	}
-	{
-		const prefix string = ",\"chatCount\":"
-		out.RawString(prefix)
-		out.Int(int(in.ChatCount))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v ProfileStats) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileStats) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileStats) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(l, v)
-}
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(in *jlexer.Lexer, out *ProfileResponse) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "profiles":
-			if in.IsNull() {
-				in.Skip()
-				out.Profiles = nil
-			} else {
-				in.Delim('[')
-				if out.Profiles == nil {
-					if !in.IsDelim(']') {
-						out.Profiles = make([]Profile, 0, 0)
-					} else {
-						out.Profiles = []Profile{}
-					}
-				} else {
-					out.Profiles = (out.Profiles)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v13 Profile
-					(v13).UnmarshalEasyJSON(in)
-					out.Profiles = append(out.Profiles, v13)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(out *jwriter.Writer, in ProfileResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"profiles\":"
-		out.RawString(prefix[1:])
-		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.Profiles {
-				if v14 > 0 {
-					out.RawByte(',')
-				}
-				(v15).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Value))
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v ProfileResponse) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(l, v)
-}
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.Lexer, out *ProfileIsAdmin) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(in *jlexer.Lexer, out *SearchFacets) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2036,145 +1988,98 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profileId":
-			out.ProfileId = int(in.Int())
-		case "firstName":
-			out.FirstName = string(in.String())
-		case "lastName":
-			out.LastName = string(in.String())
-		case "isMale":
-			out.IsMale = bool(in.Bool())
-		case "goal":
-			out.Goal = int(in.Int())
-		case "height":
-			out.Height = int(in.Int())
-		case "birthday":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Birthday).UnmarshalJSON(data))
-			}
-		case "description":
-			out.Description = string(in.String())
-		case "location":
-			out.Location = string(in.String())
-		case "interests":
+		case "goals":
 			if in.IsNull() {
 				in.Skip()
-				out.Interests = nil
+				out.Goals = nil
 			} else {
 				in.Delim('[')
-				if out.Interests == nil {
+				if out.Goals == nil {
 					if !in.IsDelim(']') {
-						out.Interests = make([]string, 0, 4)
+						out.Goals = make([]FacetCount, 0, 2)
 					} else {
-						out.Interests = []string{}
+						out.Goals = []FacetCount{}
 					}
 				} else {
-					out.Interests = (out.Interests)[:0]
+					out.Goals = (out.Goals)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 string
-					v16 = string(in.String())
-					out.Interests = append(out.Interests, v16)
+					var v16 FacetCount
+					(v16).UnmarshalEasyJSON(in)
+					out.Goals = append(out.Goals, v16)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "likedBy":
+		case "ageBuckets":
 			if in.IsNull() {
 				in.Skip()
-				out.LikedBy = nil
+				out.AgeBuckets = nil
 			} else {
 				in.Delim('[')
-				if out.LikedBy == nil {
+				if out.AgeBuckets == nil {
 					if !in.IsDelim(']') {
-						out.LikedBy = make([]int, 0, 8)
+						out.AgeBuckets = make([]FacetCount, 0, 2)
 					} else {
-						out.LikedBy = []int{}
+						out.AgeBuckets = []FacetCount{}
 					}
 				} else {
-					out.LikedBy = (out.LikedBy)[:0]
+					out.AgeBuckets = (out.AgeBuckets)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v17 int
-					v17 = int(in.Int())
-					out.LikedBy = append(out.LikedBy, v17)
+					var v17 FacetCount
+					(v17).UnmarshalEasyJSON(in)
+					out.AgeBuckets = append(out.AgeBuckets, v17)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "preferences":
+		case "cities":
 			if in.IsNull() {
 				in.Skip()
-				out.Preferences = nil
+				out.Cities = nil
 			} else {
 				in.Delim('[')
-				if out.Preferences == nil {
+				if out.Cities == nil {
 					if !in.IsDelim(']') {
-						out.Preferences = make([]Preference, 0, 2)
+						out.Cities = make([]FacetCount, 0, 2)
 					} else {
-						out.Preferences = []Preference{}
+						out.Cities = []FacetCount{}
 					}
 				} else {
-					out.Preferences = (out.Preferences)[:0]
+					out.Cities = (out.Cities)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v18 Preference
+					var v18 FacetCount
 					(v18).UnmarshalEasyJSON(in)
-					out.Preferences = append(out.Preferences, v18)
+					out.Cities = append(out.Cities, v18)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "parameters":
+		case "interests":
 			if in.IsNull() {
 				in.Skip()
-				out.Parameters = nil
+				out.Interests = nil
 			} else {
 				in.Delim('[')
-				if out.Parameters == nil {
+				if out.Interests == nil {
 					if !in.IsDelim(']') {
-						out.Parameters = make([]Preference, 0, 2)
+						out.Interests = make([]FacetCount, 0, 2)
 					} else {
-						out.Parameters = []Preference{}
+						out.Interests = []FacetCount{}
 					}
 				} else {
-					out.Parameters = (out.Parameters)[:0]
+					out.Interests = (out.Interests)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 Preference
+					var v19 FacetCount
 					(v19).UnmarshalEasyJSON(in)
-					out.Parameters = append(out.Parameters, v19)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "photos":
-			if in.IsNull() {
-				in.Skip()
-				out.Photos = nil
-			} else {
-				in.Delim('[')
-				if out.Photos == nil {
-					if !in.IsDelim(']') {
-						out.Photos = make([]string, 0, 4)
-					} else {
-						out.Photos = []string{}
-					}
-				} else {
-					out.Photos = (out.Photos)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v20 string
-					v20 = string(in.String())
-					out.Photos = append(out.Photos, v20)
+					out.Interests = append(out.Interests, v19)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "Premium":
-			(out.Premium).UnmarshalEasyJSON(in)
-		case "isAdmin":
-			out.IsAdmin = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -2185,172 +2090,5969 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(out *jwriter.Writer, in ProfileIsAdmin) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(out *jwriter.Writer, in SearchFacets) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profileId\":"
+		const prefix string = ",\"goals\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ProfileId))
-	}
-	{
-		const prefix string = ",\"firstName\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstName))
-	}
-	{
-		const prefix string = ",\"lastName\":"
-		out.RawString(prefix)
-		out.String(string(in.LastName))
-	}
-	{
-		const prefix string = ",\"isMale\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsMale))
-	}
-	{
-		const prefix string = ",\"goal\":"
-		out.RawString(prefix)
-		out.Int(int(in.Goal))
-	}
-	{
-		const prefix string = ",\"height\":"
-		out.RawString(prefix)
-		out.Int(int(in.Height))
-	}
-	{
-		const prefix string = ",\"birthday\":"
-		out.RawString(prefix)
-		out.Raw((in.Birthday).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"location\":"
-		out.RawString(prefix)
-		out.String(string(in.Location))
-	}
-	{
-		const prefix string = ",\"interests\":"
-		out.RawString(prefix)
-		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Goals == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v21, v22 := range in.Interests {
-				if v21 > 0 {
+			for v20, v21 := range in.Goals {
+				if v20 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v22))
+				(v21).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"likedBy\":"
+		const prefix string = ",\"ageBuckets\":"
 		out.RawString(prefix)
-		if in.LikedBy == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.AgeBuckets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v23, v24 := range in.LikedBy {
-				if v23 > 0 {
+			for v22, v23 := range in.AgeBuckets {
+				if v22 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v24))
+				(v23).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"preferences\":"
+		const prefix string = ",\"cities\":"
 		out.RawString(prefix)
-		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Cities == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Preferences {
-				if v25 > 0 {
+			for v24, v25 := range in.Cities {
+				if v24 > 0 {
 					out.RawByte(',')
 				}
-				(v26).MarshalEasyJSON(out)
+				(v25).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"parameters\":"
+		const prefix string = ",\"interests\":"
 		out.RawString(prefix)
-		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v27, v28 := range in.Parameters {
-				if v27 > 0 {
+			for v26, v27 := range in.Interests {
+				if v26 > 0 {
 					out.RawByte(',')
 				}
-				(v28).MarshalEasyJSON(out)
+				(v27).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"photos\":"
-		out.RawString(prefix)
-		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v29, v30 := range in.Photos {
-				if v29 > 0 {
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchFacets) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchFacets) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchFacets) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchFacets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(in *jlexer.Lexer, out *SavedSearchesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "searches":
+			if in.IsNull() {
+				in.Skip()
+				out.Searches = nil
+			} else {
+				in.Delim('[')
+				if out.Searches == nil {
+					if !in.IsDelim(']') {
+						out.Searches = make([]SavedSearch, 0, 0)
+					} else {
+						out.Searches = []SavedSearch{}
+					}
+				} else {
+					out.Searches = (out.Searches)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 SavedSearch
+					(v28).UnmarshalEasyJSON(in)
+					out.Searches = append(out.Searches, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(out *jwriter.Writer, in SavedSearchesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"searches\":"
+		out.RawString(prefix[1:])
+		if in.Searches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Searches {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				(v30).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedSearchesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearchesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearchesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearchesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(in *jlexer.Lexer, out *SavedSearch) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "searchId":
+			out.SearchID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "params":
+			(out.Params).UnmarshalEasyJSON(in)
+		case "alerts":
+			out.Alerts = bool(in.Bool())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(out *jwriter.Writer, in SavedSearch) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"searchId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.SearchID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"params\":"
+		out.RawString(prefix)
+		(in.Params).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"alerts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Alerts))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SavedSearch) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SavedSearch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SavedSearch) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SavedSearch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.Lexer, out *SaveSearchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "params":
+			(out.Params).UnmarshalEasyJSON(in)
+		case "alerts":
+			out.Alerts = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(out *jwriter.Writer, in SaveSearchRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"params\":"
+		out.RawString(prefix)
+		(in.Params).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"alerts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Alerts))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SaveSearchRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SaveSearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SaveSearchRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SaveSearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.Lexer, out *RewindLikeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(out *jwriter.Writer, in RewindLikeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RewindLikeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RewindLikeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RewindLikeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RewindLikeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.Lexer, out *ReviewVerificationRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "approve":
+			out.Approve = bool(in.Bool())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(out *jwriter.Writer, in ReviewVerificationRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"approve\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Approve))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewVerificationRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewVerificationRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewVerificationRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewVerificationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.Lexer, out *RevertProfileRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "versionId":
+			out.VersionId = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(out *jwriter.Writer, in RevertProfileRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"versionId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.VersionId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RevertProfileRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RevertProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RevertProfileRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RevertProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.Lexer, out *ReorderPhotosRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "paths":
+			if in.IsNull() {
+				in.Skip()
+				out.Paths = nil
+			} else {
+				in.Delim('[')
+				if out.Paths == nil {
+					if !in.IsDelim(']') {
+						out.Paths = make([]string, 0, 4)
+					} else {
+						out.Paths = []string{}
+					}
+				} else {
+					out.Paths = (out.Paths)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 string
+					v31 = string(in.String())
+					out.Paths = append(out.Paths, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(out *jwriter.Writer, in ReorderPhotosRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"paths\":"
+		out.RawString(prefix[1:])
+		if in.Paths == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Paths {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v33))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReorderPhotosRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReorderPhotosRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReorderPhotosRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReorderPhotosRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.Lexer, out *ReadPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(out *jwriter.Writer, in ReadPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"chat_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ChatID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReadPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReadPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReadPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReadPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.Lexer, out *ReadNotifPayload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notif_type":
+			out.NotifType = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(out *jwriter.Writer, in ReadNotifPayload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notif_type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.NotifType))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReadNotifPayload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReadNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReadNotifPayload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReadNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.Lexer, out *RawTimeConstraints) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "time_from":
+			if in.IsNull() {
+				in.Skip()
+				out.TimeFrom = nil
+			} else {
+				if out.TimeFrom == nil {
+					out.TimeFrom = new(string)
+				}
+				*out.TimeFrom = string(in.String())
+			}
+		case "time_to":
+			if in.IsNull() {
+				in.Skip()
+				out.TimeTo = nil
+			} else {
+				if out.TimeTo == nil {
+					out.TimeTo = new(string)
+				}
+				*out.TimeTo = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(out *jwriter.Writer, in RawTimeConstraints) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"time_from\":"
+		out.RawString(prefix[1:])
+		if in.TimeFrom == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.TimeFrom))
+		}
+	}
+	{
+		const prefix string = ",\"time_to\":"
+		out.RawString(prefix)
+		if in.TimeTo == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.TimeTo))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RawTimeConstraints) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RawTimeConstraints) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RawTimeConstraints) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RawTimeConstraints) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.Lexer, out *QuotaStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = string(in.String())
+		case "limit":
+			out.Limit = int(in.Int())
+		case "used":
+			out.Used = int(in.Int())
+		case "remaining":
+			out.Remaining = int(in.Int())
+		case "window":
+			out.Window = string(in.String())
+		case "resetsAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ResetsAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(out *jwriter.Writer, in QuotaStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"used\":"
+		out.RawString(prefix)
+		out.Int(int(in.Used))
+	}
+	{
+		const prefix string = ",\"remaining\":"
+		out.RawString(prefix)
+		out.Int(int(in.Remaining))
+	}
+	{
+		const prefix string = ",\"window\":"
+		out.RawString(prefix)
+		out.String(string(in.Window))
+	}
+	{
+		const prefix string = ",\"resetsAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ResetsAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuotaStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuotaStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuotaStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuotaStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.Lexer, out *QuotaRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Limit":
+			out.Limit = int(in.Int())
+		case "Window":
+			out.Window = string(in.String())
+		case "Period":
+			out.Period = time.Duration(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(out *jwriter.Writer, in QuotaRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Limit))
+	}
+	{
+		const prefix string = ",\"Window\":"
+		out.RawString(prefix)
+		out.String(string(in.Window))
+	}
+	{
+		const prefix string = ",\"Period\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Period))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuotaRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuotaRule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuotaRule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuotaRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.Lexer, out *QuotaResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "plan":
+			out.Plan = string(in.String())
+		case "quotas":
+			if in.IsNull() {
+				in.Skip()
+				out.Quotas = nil
+			} else {
+				in.Delim('[')
+				if out.Quotas == nil {
+					if !in.IsDelim(']') {
+						out.Quotas = make([]QuotaStatus, 0, 0)
+					} else {
+						out.Quotas = []QuotaStatus{}
+					}
+				} else {
+					out.Quotas = (out.Quotas)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 QuotaStatus
+					(v34).UnmarshalEasyJSON(in)
+					out.Quotas = append(out.Quotas, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(out *jwriter.Writer, in QuotaResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"plan\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Plan))
+	}
+	{
+		const prefix string = ",\"quotas\":"
+		out.RawString(prefix)
+		if in.Quotas == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Quotas {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuotaResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuotaResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuotaResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuotaResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.Lexer, out *QueryStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "TotalAnswers":
+			out.TotalAnswers = int(in.Int())
+		case "AverageScore":
+			out.AverageScore = float64(in.Float64())
+		case "MinScore":
+			out.MinScore = int(in.Int())
+		case "MaxScore":
+			out.MaxScore = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(out *jwriter.Writer, in QueryStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"TotalAnswers\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalAnswers))
+	}
+	{
+		const prefix string = ",\"AverageScore\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AverageScore))
+	}
+	{
+		const prefix string = ",\"MinScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinScore))
+	}
+	{
+		const prefix string = ",\"MaxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.Lexer, out *QueryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "queryis":
+			if in.IsNull() {
+				in.Skip()
+				out.Queries = nil
+			} else {
+				in.Delim('[')
+				if out.Queries == nil {
+					if !in.IsDelim(']') {
+						out.Queries = make([]QueryForUser, 0, 0)
+					} else {
+						out.Queries = []QueryForUser{}
+					}
+				} else {
+					out.Queries = (out.Queries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 QueryForUser
+					(v37).UnmarshalEasyJSON(in)
+					out.Queries = append(out.Queries, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(out *jwriter.Writer, in QueryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"queryis\":"
+		out.RawString(prefix[1:])
+		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Queries {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				(v39).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.Lexer, out *QueryForUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "minScore":
+			out.MinScore = int(in.Int())
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "answer":
+			out.Answer = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(out *jwriter.Writer, in QueryForUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"minScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinScore))
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"answer\":"
+		out.RawString(prefix)
+		out.String(string(in.Answer))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QueryForUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QueryForUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QueryForUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QueryForUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(in *jlexer.Lexer, out *Query) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "minScore":
+			out.MinScore = int(in.Int())
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(out *jwriter.Writer, in Query) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"minScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinScore))
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Query) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Query) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Query) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Query) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.Lexer, out *QuerResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "queryis":
+			if in.IsNull() {
+				in.Skip()
+				out.Queries = nil
+			} else {
+				in.Delim('[')
+				if out.Queries == nil {
+					if !in.IsDelim(']') {
+						out.Queries = make([]Query, 0, 1)
+					} else {
+						out.Queries = []Query{}
+					}
+				} else {
+					out.Queries = (out.Queries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 Query
+					(v40).UnmarshalEasyJSON(in)
+					out.Queries = append(out.Queries, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(out *jwriter.Writer, in QuerResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"queryis\":"
+		out.RawString(prefix[1:])
+		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Queries {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				(v42).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuerResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuerResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuerResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuerResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.Lexer, out *PromptsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "prompts":
+			if in.IsNull() {
+				in.Skip()
+				out.Prompts = nil
+			} else {
+				in.Delim('[')
+				if out.Prompts == nil {
+					if !in.IsDelim(']') {
+						out.Prompts = make([]Prompt, 0, 2)
+					} else {
+						out.Prompts = []Prompt{}
+					}
+				} else {
+					out.Prompts = (out.Prompts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 Prompt
+					(v43).UnmarshalEasyJSON(in)
+					out.Prompts = append(out.Prompts, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(out *jwriter.Writer, in PromptsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"prompts\":"
+		out.RawString(prefix[1:])
+		if in.Prompts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Prompts {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				(v45).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromptsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromptsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromptsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromptsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.Lexer, out *Prompt) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "promptId":
+			out.PromptId = int(in.Int())
+		case "question":
+			out.Question = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(out *jwriter.Writer, in Prompt) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"promptId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.PromptId))
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Prompt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Prompt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Prompt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Prompt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.Lexer, out *ProfileVersion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "versionId":
+			out.VersionId = int(in.Int())
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "actorId":
+			out.ActorId = int(in.Int())
+		case "action":
+			out.Action = string(in.String())
+		case "snapshot":
+			(out.Snapshot).UnmarshalEasyJSON(in)
+		case "changes":
+			if in.IsNull() {
+				in.Skip()
+				out.Changes = nil
+			} else {
+				in.Delim('[')
+				if out.Changes == nil {
+					if !in.IsDelim(']') {
+						out.Changes = make([]FieldChange, 0, 1)
+					} else {
+						out.Changes = []FieldChange{}
+					}
+				} else {
+					out.Changes = (out.Changes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 FieldChange
+					(v46).UnmarshalEasyJSON(in)
+					out.Changes = append(out.Changes, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(out *jwriter.Writer, in ProfileVersion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"versionId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.VersionId))
+	}
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix)
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"actorId\":"
+		out.RawString(prefix)
+		out.Int(int(in.ActorId))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"snapshot\":"
+		out.RawString(prefix)
+		(in.Snapshot).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix)
+		if in.Changes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Changes {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				(v48).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileVersion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileVersion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileVersion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileVersion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.Lexer, out *ProfileStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "likesGiven":
+			out.LikesGiven = int(in.Int())
+		case "likesReceived":
+			out.LikesReceived = int(in.Int())
+		case "matches":
+			out.Matches = int(in.Int())
+		case "complaintsMade":
+			out.ComplaintsMade = int(in.Int())
+		case "complaintsReceived":
+			out.ComplaintsReceived = int(in.Int())
+		case "messagesSent":
+			out.MessagesSent = int(in.Int())
+		case "chatCount":
+			out.ChatCount = int(in.Int())
+		case "recycled":
+			out.Recycled = int(in.Int())
+		case "boosts":
+			if in.IsNull() {
+				in.Skip()
+				out.Boosts = nil
+			} else {
+				in.Delim('[')
+				if out.Boosts == nil {
+					if !in.IsDelim(']') {
+						out.Boosts = make([]Boost, 0, 0)
+					} else {
+						out.Boosts = []Boost{}
+					}
+				} else {
+					out.Boosts = (out.Boosts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 Boost
+					(v49).UnmarshalEasyJSON(in)
+					out.Boosts = append(out.Boosts, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "completeness":
+			(out.Completeness).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(out *jwriter.Writer, in ProfileStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"likesGiven\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LikesGiven))
+	}
+	{
+		const prefix string = ",\"likesReceived\":"
+		out.RawString(prefix)
+		out.Int(int(in.LikesReceived))
+	}
+	{
+		const prefix string = ",\"matches\":"
+		out.RawString(prefix)
+		out.Int(int(in.Matches))
+	}
+	{
+		const prefix string = ",\"complaintsMade\":"
+		out.RawString(prefix)
+		out.Int(int(in.ComplaintsMade))
+	}
+	{
+		const prefix string = ",\"complaintsReceived\":"
+		out.RawString(prefix)
+		out.Int(int(in.ComplaintsReceived))
+	}
+	{
+		const prefix string = ",\"messagesSent\":"
+		out.RawString(prefix)
+		out.Int(int(in.MessagesSent))
+	}
+	{
+		const prefix string = ",\"chatCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.ChatCount))
+	}
+	{
+		const prefix string = ",\"recycled\":"
+		out.RawString(prefix)
+		out.Int(int(in.Recycled))
+	}
+	{
+		const prefix string = ",\"boosts\":"
+		out.RawString(prefix)
+		if in.Boosts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.Boosts {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				(v51).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"completeness\":"
+		out.RawString(prefix)
+		(in.Completeness).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.Lexer, out *ProfileResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profiles":
+			if in.IsNull() {
+				in.Skip()
+				out.Profiles = nil
+			} else {
+				in.Delim('[')
+				if out.Profiles == nil {
+					if !in.IsDelim(']') {
+						out.Profiles = make([]Profile, 0, 0)
+					} else {
+						out.Profiles = []Profile{}
+					}
+				} else {
+					out.Profiles = (out.Profiles)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 Profile
+					(v52).UnmarshalEasyJSON(in)
+					out.Profiles = append(out.Profiles, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(out *jwriter.Writer, in ProfileResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profiles\":"
+		out.RawString(prefix[1:])
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Profiles {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				(v54).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.Lexer, out *ProfilePrompt) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "promptId":
+			out.PromptId = int(in.Int())
+		case "question":
+			out.Question = string(in.String())
+		case "answer":
+			out.Answer = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(out *jwriter.Writer, in ProfilePrompt) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"promptId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.PromptId))
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"answer\":"
+		out.RawString(prefix)
+		out.String(string(in.Answer))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfilePrompt) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfilePrompt) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfilePrompt) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfilePrompt) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.Lexer, out *ProfileIsAdmin) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "lastName":
+			out.LastName = string(in.String())
+		case "isMale":
+			out.IsMale = bool(in.Bool())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "birthday":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Birthday).UnmarshalJSON(data))
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "location":
+			out.Location = string(in.String())
+		case "interests":
+			if in.IsNull() {
+				in.Skip()
+				out.Interests = nil
+			} else {
+				in.Delim('[')
+				if out.Interests == nil {
+					if !in.IsDelim(']') {
+						out.Interests = make([]string, 0, 4)
+					} else {
+						out.Interests = []string{}
+					}
+				} else {
+					out.Interests = (out.Interests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 string
+					v55 = string(in.String())
+					out.Interests = append(out.Interests, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "preferences":
+			if in.IsNull() {
+				in.Skip()
+				out.Preferences = nil
+			} else {
+				in.Delim('[')
+				if out.Preferences == nil {
+					if !in.IsDelim(']') {
+						out.Preferences = make([]Preference, 0, 2)
+					} else {
+						out.Preferences = []Preference{}
+					}
+				} else {
+					out.Preferences = (out.Preferences)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v56 Preference
+					(v56).UnmarshalEasyJSON(in)
+					out.Preferences = append(out.Preferences, v56)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parameters":
+			if in.IsNull() {
+				in.Skip()
+				out.Parameters = nil
+			} else {
+				in.Delim('[')
+				if out.Parameters == nil {
+					if !in.IsDelim(']') {
+						out.Parameters = make([]Preference, 0, 2)
+					} else {
+						out.Parameters = []Preference{}
+					}
+				} else {
+					out.Parameters = (out.Parameters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v57 Preference
+					(v57).UnmarshalEasyJSON(in)
+					out.Parameters = append(out.Parameters, v57)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]string, 0, 4)
+					} else {
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 string
+					v58 = string(in.String())
+					out.Photos = append(out.Photos, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Premium":
+			(out.Premium).UnmarshalEasyJSON(in)
+		case "verified":
+			out.Verified = bool(in.Bool())
+		case "prompts":
+			if in.IsNull() {
+				in.Skip()
+				out.Prompts = nil
+			} else {
+				in.Delim('[')
+				if out.Prompts == nil {
+					if !in.IsDelim(']') {
+						out.Prompts = make([]ProfilePrompt, 0, 1)
+					} else {
+						out.Prompts = []ProfilePrompt{}
+					}
+				} else {
+					out.Prompts = (out.Prompts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 ProfilePrompt
+					(v59).UnmarshalEasyJSON(in)
+					out.Prompts = append(out.Prompts, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "completeness":
+			if in.IsNull() {
+				in.Skip()
+				out.Completeness = nil
+			} else {
+				if out.Completeness == nil {
+					out.Completeness = new(Completeness)
+				}
+				(*out.Completeness).UnmarshalEasyJSON(in)
+			}
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "isAdmin":
+			out.IsAdmin = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(out *jwriter.Writer, in ProfileIsAdmin) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastName\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	{
+		const prefix string = ",\"isMale\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMale))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix)
+		out.Raw((in.Birthday).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	{
+		const prefix string = ",\"interests\":"
+		out.RawString(prefix)
+		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v60, v61 := range in.Interests {
+				if v60 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v61))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix)
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v62, v63 := range in.Preferences {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				(v63).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parameters\":"
+		out.RawString(prefix)
+		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v64, v65 := range in.Parameters {
+				if v64 > 0 {
+					out.RawByte(',')
+				}
+				(v65).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v66, v67 := range in.Photos {
+				if v66 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v67))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Premium\":"
+		out.RawString(prefix)
+		(in.Premium).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
+	}
+	{
+		const prefix string = ",\"prompts\":"
+		out.RawString(prefix)
+		if in.Prompts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Prompts {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				(v69).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Completeness != nil {
+		const prefix string = ",\"completeness\":"
+		out.RawString(prefix)
+		(*in.Completeness).MarshalEasyJSON(out)
+	}
+	if in.Timezone != "" {
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"isAdmin\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdmin))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileIsAdmin) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileIsAdmin) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileIsAdmin) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileIsAdmin) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.Lexer, out *ProfileHistoryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "versions":
+			if in.IsNull() {
+				in.Skip()
+				out.Versions = nil
+			} else {
+				in.Delim('[')
+				if out.Versions == nil {
+					if !in.IsDelim(']') {
+						out.Versions = make([]ProfileVersion, 0, 0)
+					} else {
+						out.Versions = []ProfileVersion{}
+					}
+				} else {
+					out.Versions = (out.Versions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 ProfileVersion
+					(v70).UnmarshalEasyJSON(in)
+					out.Versions = append(out.Versions, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(out *jwriter.Writer, in ProfileHistoryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"versions\":"
+		out.RawString(prefix[1:])
+		if in.Versions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v71, v72 := range in.Versions {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				(v72).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileHistoryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileHistoryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileHistoryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileHistoryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.Lexer, out *Profile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "lastName":
+			out.LastName = string(in.String())
+		case "isMale":
+			out.IsMale = bool(in.Bool())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "height":
+			out.Height = int(in.Int())
+		case "birthday":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Birthday).UnmarshalJSON(data))
+			}
+		case "description":
+			out.Description = string(in.String())
+		case "location":
+			out.Location = string(in.String())
+		case "interests":
+			if in.IsNull() {
+				in.Skip()
+				out.Interests = nil
+			} else {
+				in.Delim('[')
+				if out.Interests == nil {
+					if !in.IsDelim(']') {
+						out.Interests = make([]string, 0, 4)
+					} else {
+						out.Interests = []string{}
+					}
+				} else {
+					out.Interests = (out.Interests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 string
+					v73 = string(in.String())
+					out.Interests = append(out.Interests, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "likedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.LikedBy = nil
+			} else {
+				in.Delim('[')
+				if out.LikedBy == nil {
+					if !in.IsDelim(']') {
+						out.LikedBy = make([]int, 0, 8)
+					} else {
+						out.LikedBy = []int{}
+					}
+				} else {
+					out.LikedBy = (out.LikedBy)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v74 int
+					v74 = int(in.Int())
+					out.LikedBy = append(out.LikedBy, v74)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "preferences":
+			if in.IsNull() {
+				in.Skip()
+				out.Preferences = nil
+			} else {
+				in.Delim('[')
+				if out.Preferences == nil {
+					if !in.IsDelim(']') {
+						out.Preferences = make([]Preference, 0, 2)
+					} else {
+						out.Preferences = []Preference{}
+					}
+				} else {
+					out.Preferences = (out.Preferences)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 Preference
+					(v75).UnmarshalEasyJSON(in)
+					out.Preferences = append(out.Preferences, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "parameters":
+			if in.IsNull() {
+				in.Skip()
+				out.Parameters = nil
+			} else {
+				in.Delim('[')
+				if out.Parameters == nil {
+					if !in.IsDelim(']') {
+						out.Parameters = make([]Preference, 0, 2)
+					} else {
+						out.Parameters = []Preference{}
+					}
+				} else {
+					out.Parameters = (out.Parameters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 Preference
+					(v76).UnmarshalEasyJSON(in)
+					out.Parameters = append(out.Parameters, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]string, 0, 4)
+					} else {
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v77 string
+					v77 = string(in.String())
+					out.Photos = append(out.Photos, v77)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Premium":
+			(out.Premium).UnmarshalEasyJSON(in)
+		case "verified":
+			out.Verified = bool(in.Bool())
+		case "prompts":
+			if in.IsNull() {
+				in.Skip()
+				out.Prompts = nil
+			} else {
+				in.Delim('[')
+				if out.Prompts == nil {
+					if !in.IsDelim(']') {
+						out.Prompts = make([]ProfilePrompt, 0, 1)
+					} else {
+						out.Prompts = []ProfilePrompt{}
+					}
+				} else {
+					out.Prompts = (out.Prompts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v78 ProfilePrompt
+					(v78).UnmarshalEasyJSON(in)
+					out.Prompts = append(out.Prompts, v78)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "completeness":
+			if in.IsNull() {
+				in.Skip()
+				out.Completeness = nil
+			} else {
+				if out.Completeness == nil {
+					out.Completeness = new(Completeness)
+				}
+				(*out.Completeness).UnmarshalEasyJSON(in)
+			}
+		case "timezone":
+			out.Timezone = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(out *jwriter.Writer, in Profile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastName\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	{
+		const prefix string = ",\"isMale\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMale))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	{
+		const prefix string = ",\"height\":"
+		out.RawString(prefix)
+		out.Int(int(in.Height))
+	}
+	{
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix)
+		out.Raw((in.Birthday).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"location\":"
+		out.RawString(prefix)
+		out.String(string(in.Location))
+	}
+	{
+		const prefix string = ",\"interests\":"
+		out.RawString(prefix)
+		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v79, v80 := range in.Interests {
+				if v79 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v80))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.LikedBy) != 0 {
+		const prefix string = ",\"likedBy\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v81, v82 := range in.LikedBy {
+				if v81 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v82))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix)
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.Preferences {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				(v84).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"parameters\":"
+		out.RawString(prefix)
+		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v85, v86 := range in.Parameters {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				(v86).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v87, v88 := range in.Photos {
+				if v87 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v88))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Premium\":"
+		out.RawString(prefix)
+		(in.Premium).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
+	}
+	{
+		const prefix string = ",\"prompts\":"
+		out.RawString(prefix)
+		if in.Prompts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.Prompts {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				(v90).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Completeness != nil {
+		const prefix string = ",\"completeness\":"
+		out.RawString(prefix)
+		(*in.Completeness).MarshalEasyJSON(out)
+	}
+	if in.Timezone != "" {
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Profile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Profile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Profile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Profile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.Lexer, out *Premium) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Status":
+			out.Status = bool(in.Bool())
+		case "Border":
+			out.Border = int32(in.Int32())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(out *jwriter.Writer, in Premium) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Status))
+	}
+	{
+		const prefix string = ",\"Border\":"
+		out.RawString(prefix)
+		out.Int32(int32(in.Border))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Premium) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Premium) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Premium) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Premium) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.Lexer, out *Preference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "preference_description":
+			out.Description = string(in.String())
+		case "preference_value":
+			out.Value = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(out *jwriter.Writer, in Preference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"preference_description\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"preference_value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Preference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Preference) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Preference) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Preference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.Lexer, out *PhotoVariants) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "thumb":
+			out.Thumb = string(in.String())
+		case "card":
+			out.Card = string(in.String())
+		case "full":
+			out.Full = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(out *jwriter.Writer, in PhotoVariants) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"thumb\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Thumb))
+	}
+	{
+		const prefix string = ",\"card\":"
+		out.RawString(prefix)
+		out.String(string(in.Card))
+	}
+	{
+		const prefix string = ",\"full\":"
+		out.RawString(prefix)
+		out.String(string(in.Full))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoVariants) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoVariants) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoVariants) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoVariants) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.Lexer, out *PhotoUpload) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "uploadKey":
+			out.UploadKey = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "method":
+			out.Method = string(in.String())
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "maxSize":
+			out.MaxSize = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(out *jwriter.Writer, in PhotoUpload) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"uploadKey\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UploadKey))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"method\":"
+		out.RawString(prefix)
+		out.String(string(in.Method))
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"maxSize\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxSize))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoUpload) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoUpload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoUpload) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoUpload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.Lexer, out *PhotoURLsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]PhotoURLs, 0, 0)
+					} else {
+						out.Photos = []PhotoURLs{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v91 PhotoURLs
+					(v91).UnmarshalEasyJSON(in)
+					out.Photos = append(out.Photos, v91)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(out *jwriter.Writer, in PhotoURLsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix[1:])
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.Photos {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				(v93).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoURLsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoURLsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoURLsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoURLsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.Lexer, out *PhotoURLs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "path":
+			out.Path = string(in.String())
+		case "thumb":
+			out.Thumb = string(in.String())
+		case "card":
+			out.Card = string(in.String())
+		case "full":
+			out.Full = string(in.String())
+		case "primary":
+			out.Primary = bool(in.Bool())
+		case "status":
+			out.Status = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(out *jwriter.Writer, in PhotoURLs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"thumb\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumb))
+	}
+	{
+		const prefix string = ",\"card\":"
+		out.RawString(prefix)
+		out.String(string(in.Card))
+	}
+	{
+		const prefix string = ",\"full\":"
+		out.RawString(prefix)
+		out.String(string(in.Full))
+	}
+	{
+		const prefix string = ",\"primary\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Primary))
+	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoURLs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoURLs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoURLs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoURLs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.Lexer, out *PhotoClustersResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clusters":
+			if in.IsNull() {
+				in.Skip()
+				out.Clusters = nil
+			} else {
+				in.Delim('[')
+				if out.Clusters == nil {
+					if !in.IsDelim(']') {
+						out.Clusters = make([]PhotoCluster, 0, 1)
+					} else {
+						out.Clusters = []PhotoCluster{}
+					}
+				} else {
+					out.Clusters = (out.Clusters)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v94 PhotoCluster
+					(v94).UnmarshalEasyJSON(in)
+					out.Clusters = append(out.Clusters, v94)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(out *jwriter.Writer, in PhotoClustersResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"clusters\":"
+		out.RawString(prefix[1:])
+		if in.Clusters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v95, v96 := range in.Clusters {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				(v96).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoClustersResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoClustersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoClustersResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoClustersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.Lexer, out *PhotoCluster) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileIds":
+			if in.IsNull() {
+				in.Skip()
+				out.ProfileIds = nil
+			} else {
+				in.Delim('[')
+				if out.ProfileIds == nil {
+					if !in.IsDelim(']') {
+						out.ProfileIds = make([]int, 0, 8)
+					} else {
+						out.ProfileIds = []int{}
+					}
+				} else {
+					out.ProfileIds = (out.ProfileIds)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 int
+					v97 = int(in.Int())
+					out.ProfileIds = append(out.ProfileIds, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]PhotoURLs, 0, 0)
+					} else {
+						out.Photos = []PhotoURLs{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v98 PhotoURLs
+					(v98).UnmarshalEasyJSON(in)
+					out.Photos = append(out.Photos, v98)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter.Writer, in PhotoCluster) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileIds\":"
+		out.RawString(prefix[1:])
+		if in.ProfileIds == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v99, v100 := range in.ProfileIds {
+				if v99 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v100))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Photos {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				(v102).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PhotoCluster) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PhotoCluster) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PhotoCluster) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PhotoCluster) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.Lexer, out *PendingVerification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "requestId":
+			out.RequestId = int(in.Int())
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "pose":
+			out.Pose = string(in.String())
+		case "instruction":
+			out.Instruction = string(in.String())
+		case "selfieUrl":
+			out.SelfieURL = string(in.String())
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]PhotoURLs, 0, 0)
+					} else {
+						out.Photos = []PhotoURLs{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v103 PhotoURLs
+					(v103).UnmarshalEasyJSON(in)
+					out.Photos = append(out.Photos, v103)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "submittedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.SubmittedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter.Writer, in PendingVerification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"requestId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.RequestId))
+	}
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix)
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"pose\":"
+		out.RawString(prefix)
+		out.String(string(in.Pose))
+	}
+	{
+		const prefix string = ",\"instruction\":"
+		out.RawString(prefix)
+		out.String(string(in.Instruction))
+	}
+	{
+		const prefix string = ",\"selfieUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.SelfieURL))
+	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v104, v105 := range in.Photos {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				(v105).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"submittedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.SubmittedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PendingVerification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PendingVerification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PendingVerification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PendingVerification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(in *jlexer.Lexer, out *PendingPhoto) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photoId":
+			out.PhotoId = int(in.Int())
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "photo":
+			(out.Photo).UnmarshalEasyJSON(in)
+		case "uploadedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UploadedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(out *jwriter.Writer, in PendingPhoto) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photoId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.PhotoId))
+	}
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix)
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		(in.Photo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"uploadedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UploadedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PendingPhoto) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PendingPhoto) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PendingPhoto) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PendingPhoto) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(in *jlexer.Lexer, out *PendingMatchesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "matches":
+			if in.IsNull() {
+				in.Skip()
+				out.Matches = nil
+			} else {
+				in.Delim('[')
+				if out.Matches == nil {
+					if !in.IsDelim(']') {
+						out.Matches = make([]MatchStatus, 0, 1)
+					} else {
+						out.Matches = []MatchStatus{}
+					}
+				} else {
+					out.Matches = (out.Matches)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 MatchStatus
+					(v106).UnmarshalEasyJSON(in)
+					out.Matches = append(out.Matches, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(out *jwriter.Writer, in PendingMatchesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"matches\":"
+		out.RawString(prefix[1:])
+		if in.Matches == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v107, v108 := range in.Matches {
+				if v107 > 0 {
+					out.RawByte(',')
+				}
+				(v108).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PendingMatchesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PendingMatchesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PendingMatchesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PendingMatchesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel59(in *jlexer.Lexer, out *PauseStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "paused":
+			out.Paused = bool(in.Bool())
+		case "resumeAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ResumeAt = nil
+			} else {
+				if out.ResumeAt == nil {
+					out.ResumeAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ResumeAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel59(out *jwriter.Writer, in PauseStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"paused\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Paused))
+	}
+	if in.ResumeAt != nil {
+		const prefix string = ",\"resumeAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.ResumeAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PauseStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PauseStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PauseStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PauseStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel59(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel60(in *jlexer.Lexer, out *PauseAccountRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "resumeAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ResumeAt = nil
+			} else {
+				if out.ResumeAt == nil {
+					out.ResumeAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ResumeAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel60(out *jwriter.Writer, in PauseAccountRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"resumeAt\":"
+		out.RawString(prefix[1:])
+		if in.ResumeAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ResumeAt).MarshalJSON())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PauseAccountRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PauseAccountRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PauseAccountRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PauseAccountRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel60(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel61(in *jlexer.Lexer, out *OutboxEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "EventId":
+			out.EventId = int(in.Int())
+		case "EventType":
+			out.EventType = string(in.String())
+		case "ProfileId":
+			out.ProfileId = int(in.Int())
+		case "Payload":
+			if in.IsNull() {
+				in.Skip()
+				out.Payload = nil
+			} else {
+				out.Payload = in.Bytes()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel61(out *jwriter.Writer, in OutboxEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"EventId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.EventId))
+	}
+	{
+		const prefix string = ",\"EventType\":"
+		out.RawString(prefix)
+		out.String(string(in.EventType))
+	}
+	{
+		const prefix string = ",\"ProfileId\":"
+		out.RawString(prefix)
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"Payload\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Payload)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OutboxEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OutboxEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OutboxEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OutboxEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel61(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel62(in *jlexer.Lexer, out *OutboxEffect) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Notification":
+			if in.IsNull() {
+				in.Skip()
+				out.Notification = nil
+			} else {
+				if out.Notification == nil {
+					out.Notification = new(NotificationSend)
+				}
+				(*out.Notification).UnmarshalEasyJSON(in)
+			}
+		case "Chat":
+			if in.IsNull() {
+				in.Skip()
+				out.Chat = nil
+			} else {
+				if out.Chat == nil {
+					out.Chat = new(ChatChange)
+				}
+				(*out.Chat).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel62(out *jwriter.Writer, in OutboxEffect) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Notification\":"
+		out.RawString(prefix[1:])
+		if in.Notification == nil {
+			out.RawString("null")
+		} else {
+			(*in.Notification).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"Chat\":"
+		out.RawString(prefix)
+		if in.Chat == nil {
+			out.RawString("null")
+		} else {
+			(*in.Chat).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OutboxEffect) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OutboxEffect) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OutboxEffect) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OutboxEffect) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel62(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel63(in *jlexer.Lexer, out *NotificationSend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "notificationID":
+			out.NotificationID = int(in.Int())
+		case "read":
+			out.Read = int(in.Int())
+		case "type":
+			out.NotifType = string(in.String())
+		case "content":
+			out.Content = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel63(out *jwriter.Writer, in NotificationSend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"notificationID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.NotificationID))
+	}
+	{
+		const prefix string = ",\"read\":"
+		out.RawString(prefix)
+		out.Int(int(in.Read))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.NotifType))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationSend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationSend) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationSend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel63(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel64(in *jlexer.Lexer, out *Notification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "payload":
+			if m, ok := out.Payload.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Payload.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Payload = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel64(out *jwriter.Writer, in Notification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"payload\":"
+		out.RawString(prefix)
+		if m, ok := in.Payload.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Payload.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Payload))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel64(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel65(in *jlexer.Lexer, out *ModerationQueueResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]PendingPhoto, 0, 0)
+					} else {
+						out.Photos = []PendingPhoto{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v112 PendingPhoto
+					(v112).UnmarshalEasyJSON(in)
+					out.Photos = append(out.Photos, v112)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel65(out *jwriter.Writer, in ModerationQueueResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix[1:])
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v113, v114 := range in.Photos {
+				if v113 > 0 {
+					out.RawByte(',')
+				}
+				(v114).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationQueueResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationQueueResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationQueueResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationQueueResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel65(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel66(in *jlexer.Lexer, out *ModeratePhotoRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "approve":
+			out.Approve = bool(in.Bool())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel66(out *jwriter.Writer, in ModeratePhotoRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"approve\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Approve))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModeratePhotoRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModeratePhotoRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModeratePhotoRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModeratePhotoRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel66(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel67(in *jlexer.Lexer, out *Message) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "messageid":
+			out.MessageID = int(in.Int())
+		case "senderid":
+			out.SenderID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "status":
+			out.Status = int(in.Int())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel67(out *jwriter.Writer, in Message) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"messageid\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.MessageID))
+	}
+	{
+		const prefix string = ",\"senderid\":"
+		out.RawString(prefix)
+		out.Int(int(in.SenderID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Message) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Message) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel67(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel68(in *jlexer.Lexer, out *MatchStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "state":
+			out.State = string(in.String())
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "extended":
+			out.Extended = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel68(out *jwriter.Writer, in MatchStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"extended\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Extended))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MatchStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MatchStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MatchStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MatchStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel68(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel69(in *jlexer.Lexer, out *MatchComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel69(out *jwriter.Writer, in MatchComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profileId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MatchComment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MatchComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MatchComment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MatchComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel69(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel70(in *jlexer.Lexer, out *LoginResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			out.Message = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel70(out *jwriter.Writer, in LoginResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel70(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel70(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel70(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel70(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel71(in *jlexer.Lexer, out *LoginRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "login":
+			out.Login = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel71(out *jwriter.Writer, in LoginRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel71(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel71(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel71(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel71(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel72(in *jlexer.Lexer, out *LikeResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "LikeId":
+			out.LikeId = int(in.Int())
+		case "Replayed":
+			out.Replayed = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel72(out *jwriter.Writer, in LikeResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"LikeId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.LikeId))
+	}
+	{
+		const prefix string = ",\"Replayed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Replayed))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LikeResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LikeResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LikeResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LikeResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel72(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel73(in *jlexer.Lexer, out *LikeComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "photo":
+			out.Photo = string(in.String())
+		case "promptId":
+			out.PromptId = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel73(out *jwriter.Writer, in LikeComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	if in.Photo != "" {
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo))
+	}
+	if in.PromptId != 0 {
+		const prefix string = ",\"promptId\":"
+		out.RawString(prefix)
+		out.Int(int(in.PromptId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LikeComment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel73(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LikeComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LikeComment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LikeComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel73(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel74(in *jlexer.Lexer, out *IncomingLikesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "likes":
+			if in.IsNull() {
+				in.Skip()
+				out.Likes = nil
+			} else {
+				in.Delim('[')
+				if out.Likes == nil {
+					if !in.IsDelim(']') {
+						out.Likes = make([]IncomingLike, 0, 0)
+					} else {
+						out.Likes = []IncomingLike{}
+					}
+				} else {
+					out.Likes = (out.Likes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v115 IncomingLike
+					(v115).UnmarshalEasyJSON(in)
+					out.Likes = append(out.Likes, v115)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel74(out *jwriter.Writer, in IncomingLikesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix[1:])
+		if in.Likes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v116, v117 := range in.Likes {
+				if v116 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v30))
+				(v117).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"Premium\":"
-		out.RawString(prefix)
-		(in.Premium).MarshalEasyJSON(out)
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomingLikesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomingLikesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel74(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomingLikesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomingLikesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel74(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel75(in *jlexer.Lexer, out *IncomingLike) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
+		case "status":
+			out.Status = int(in.Int())
+		case "likedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LikedAt).UnmarshalJSON(data))
+			}
+		case "blurred":
+			out.Blurred = bool(in.Bool())
+		case "comment":
+			if in.IsNull() {
+				in.Skip()
+				out.Comment = nil
+			} else {
+				if out.Comment == nil {
+					out.Comment = new(LikeComment)
+				}
+				(*out.Comment).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel75(out *jwriter.Writer, in IncomingLike) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"profile\":"
+		out.RawString(prefix[1:])
+		(in.Profile).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"likedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.LikedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"blurred\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Blurred))
+	}
+	if in.Comment != nil {
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		(*in.Comment).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncomingLike) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel75(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncomingLike) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel75(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncomingLike) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel75(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncomingLike) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel75(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel76(in *jlexer.Lexer, out *IncognitoRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "enabled":
+			out.Enabled = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel76(out *jwriter.Writer, in IncognitoRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"enabled\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Enabled))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v IncognitoRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel76(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v IncognitoRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel76(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *IncognitoRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel76(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *IncognitoRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel76(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel77(in *jlexer.Lexer, out *HandleComplaint) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "complaint_id":
+			out.Complaint_id = int(in.Int())
+		case "new_status":
+			out.NewStatus = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel77(out *jwriter.Writer, in HandleComplaint) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"complaint_id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Complaint_id))
+	}
+	{
+		const prefix string = ",\"new_status\":"
+		out.RawString(prefix)
+		out.Int(int(in.NewStatus))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HandleComplaint) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel77(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HandleComplaint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel77(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HandleComplaint) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel77(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HandleComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel77(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel78(in *jlexer.Lexer, out *GetAnswerStatistics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query_name":
+			out.Query_name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel78(out *jwriter.Writer, in GetAnswerStatistics) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"isAdmin\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsAdmin))
+		const prefix string = ",\"query_name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query_name))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileIsAdmin) MarshalJSON() ([]byte, error) {
+func (v GetAnswerStatistics) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileIsAdmin) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel22(w, v)
+func (v GetAnswerStatistics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileIsAdmin) UnmarshalJSON(data []byte) error {
+func (v *GetAnswerStatistics) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileIsAdmin) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel22(l, v)
+func (v *GetAnswerStatistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel78(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.Lexer, out *Profile) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel79(in *jlexer.Lexer, out *FoundProfileResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2369,143 +8071,51 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profileId":
-			out.ProfileId = int(in.Int())
-		case "firstName":
-			out.FirstName = string(in.String())
-		case "lastName":
-			out.LastName = string(in.String())
-		case "isMale":
-			out.IsMale = bool(in.Bool())
-		case "goal":
-			out.Goal = int(in.Int())
-		case "height":
-			out.Height = int(in.Int())
-		case "birthday":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Birthday).UnmarshalJSON(data))
-			}
-		case "description":
-			out.Description = string(in.String())
-		case "location":
-			out.Location = string(in.String())
-		case "interests":
-			if in.IsNull() {
-				in.Skip()
-				out.Interests = nil
-			} else {
-				in.Delim('[')
-				if out.Interests == nil {
-					if !in.IsDelim(']') {
-						out.Interests = make([]string, 0, 4)
-					} else {
-						out.Interests = []string{}
-					}
-				} else {
-					out.Interests = (out.Interests)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v31 string
-					v31 = string(in.String())
-					out.Interests = append(out.Interests, v31)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "likedBy":
-			if in.IsNull() {
-				in.Skip()
-				out.LikedBy = nil
-			} else {
-				in.Delim('[')
-				if out.LikedBy == nil {
-					if !in.IsDelim(']') {
-						out.LikedBy = make([]int, 0, 8)
-					} else {
-						out.LikedBy = []int{}
-					}
-				} else {
-					out.LikedBy = (out.LikedBy)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v32 int
-					v32 = int(in.Int())
-					out.LikedBy = append(out.LikedBy, v32)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "preferences":
+		case "profiles":
 			if in.IsNull() {
 				in.Skip()
-				out.Preferences = nil
+				out.Profiles = nil
 			} else {
 				in.Delim('[')
-				if out.Preferences == nil {
+				if out.Profiles == nil {
 					if !in.IsDelim(']') {
-						out.Preferences = make([]Preference, 0, 2)
+						out.Profiles = make([]FoundProfile, 0, 0)
 					} else {
-						out.Preferences = []Preference{}
+						out.Profiles = []FoundProfile{}
 					}
 				} else {
-					out.Preferences = (out.Preferences)[:0]
+					out.Profiles = (out.Profiles)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v33 Preference
-					(v33).UnmarshalEasyJSON(in)
-					out.Preferences = append(out.Preferences, v33)
+					var v118 FoundProfile
+					(v118).UnmarshalEasyJSON(in)
+					out.Profiles = append(out.Profiles, v118)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "parameters":
+		case "nextCursor":
+			out.NextCursor = string(in.String())
+		case "total":
 			if in.IsNull() {
 				in.Skip()
-				out.Parameters = nil
+				out.Total = nil
 			} else {
-				in.Delim('[')
-				if out.Parameters == nil {
-					if !in.IsDelim(']') {
-						out.Parameters = make([]Preference, 0, 2)
-					} else {
-						out.Parameters = []Preference{}
-					}
-				} else {
-					out.Parameters = (out.Parameters)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v34 Preference
-					(v34).UnmarshalEasyJSON(in)
-					out.Parameters = append(out.Parameters, v34)
-					in.WantComma()
+				if out.Total == nil {
+					out.Total = new(int)
 				}
-				in.Delim(']')
+				*out.Total = int(in.Int())
 			}
-		case "photos":
+		case "facets":
 			if in.IsNull() {
 				in.Skip()
-				out.Photos = nil
+				out.Facets = nil
 			} else {
-				in.Delim('[')
-				if out.Photos == nil {
-					if !in.IsDelim(']') {
-						out.Photos = make([]string, 0, 4)
-					} else {
-						out.Photos = []string{}
-					}
-				} else {
-					out.Photos = (out.Photos)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v35 string
-					v35 = string(in.String())
-					out.Photos = append(out.Photos, v35)
-					in.WantComma()
+				if out.Facets == nil {
+					out.Facets = new(SearchFacets)
 				}
-				in.Delim(']')
+				(*out.Facets).UnmarshalEasyJSON(in)
 			}
-		case "Premium":
-			(out.Premium).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2516,167 +8126,68 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(out *jwriter.Writer, in Profile) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel79(out *jwriter.Writer, in FoundProfileResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profileId\":"
+		const prefix string = ",\"profiles\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ProfileId))
-	}
-	{
-		const prefix string = ",\"firstName\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstName))
-	}
-	{
-		const prefix string = ",\"lastName\":"
-		out.RawString(prefix)
-		out.String(string(in.LastName))
-	}
-	{
-		const prefix string = ",\"isMale\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsMale))
-	}
-	{
-		const prefix string = ",\"goal\":"
-		out.RawString(prefix)
-		out.Int(int(in.Goal))
-	}
-	{
-		const prefix string = ",\"height\":"
-		out.RawString(prefix)
-		out.Int(int(in.Height))
-	}
-	{
-		const prefix string = ",\"birthday\":"
-		out.RawString(prefix)
-		out.Raw((in.Birthday).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"location\":"
-		out.RawString(prefix)
-		out.String(string(in.Location))
-	}
-	{
-		const prefix string = ",\"interests\":"
-		out.RawString(prefix)
-		if in.Interests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v36, v37 := range in.Interests {
-				if v36 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v37))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"likedBy\":"
-		out.RawString(prefix)
-		if in.LikedBy == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v38, v39 := range in.LikedBy {
-				if v38 > 0 {
-					out.RawByte(',')
-				}
-				out.Int(int(v39))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"preferences\":"
-		out.RawString(prefix)
-		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v40, v41 := range in.Preferences {
-				if v40 > 0 {
+			for v119, v120 := range in.Profiles {
+				if v119 > 0 {
 					out.RawByte(',')
 				}
-				(v41).MarshalEasyJSON(out)
+				(v120).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"parameters\":"
+	if in.NextCursor != "" {
+		const prefix string = ",\"nextCursor\":"
 		out.RawString(prefix)
-		if in.Parameters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v42, v43 := range in.Parameters {
-				if v42 > 0 {
-					out.RawByte(',')
-				}
-				(v43).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.NextCursor))
 	}
-	{
-		const prefix string = ",\"photos\":"
+	if in.Total != nil {
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v44, v45 := range in.Photos {
-				if v44 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v45))
-			}
-			out.RawByte(']')
-		}
+		out.Int(int(*in.Total))
 	}
-	{
-		const prefix string = ",\"Premium\":"
+	if in.Facets != nil {
+		const prefix string = ",\"facets\":"
 		out.RawString(prefix)
-		(in.Premium).MarshalEasyJSON(out)
+		(*in.Facets).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Profile) MarshalJSON() ([]byte, error) {
+func (v FoundProfileResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Profile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel23(w, v)
+func (v FoundProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Profile) UnmarshalJSON(data []byte) error {
+func (v *FoundProfileResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Profile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel23(l, v)
+func (v *FoundProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel79(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.Lexer, out *Premium) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel80(in *jlexer.Lexer, out *FoundProfile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2695,10 +8206,22 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "Status":
-			out.Status = bool(in.Bool())
-		case "Border":
-			out.Border = int32(in.Int32())
+		case "idUser":
+			out.IDUser = int(in.Int())
+		case "firstImgSrc":
+			out.FirstImg = string(in.String())
+		case "fullname":
+			out.Fullname = string(in.String())
+		case "age":
+			out.Age = int(in.Int())
+		case "goal":
+			out.Goal = int(in.Int())
+		case "nameHighlight":
+			out.NameHighlight = string(in.String())
+		case "snippet":
+			out.Snippet = string(in.String())
+		case "verified":
+			out.Verified = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -2709,47 +8232,77 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(out *jwriter.Writer, in Premium) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel80(out *jwriter.Writer, in FoundProfile) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Status\":"
+		const prefix string = ",\"idUser\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.Status))
+		out.Int(int(in.IDUser))
 	}
 	{
-		const prefix string = ",\"Border\":"
+		const prefix string = ",\"firstImgSrc\":"
 		out.RawString(prefix)
-		out.Int32(int32(in.Border))
+		out.String(string(in.FirstImg))
+	}
+	{
+		const prefix string = ",\"fullname\":"
+		out.RawString(prefix)
+		out.String(string(in.Fullname))
+	}
+	{
+		const prefix string = ",\"age\":"
+		out.RawString(prefix)
+		out.Int(int(in.Age))
+	}
+	{
+		const prefix string = ",\"goal\":"
+		out.RawString(prefix)
+		out.Int(int(in.Goal))
+	}
+	if in.NameHighlight != "" {
+		const prefix string = ",\"nameHighlight\":"
+		out.RawString(prefix)
+		out.String(string(in.NameHighlight))
+	}
+	if in.Snippet != "" {
+		const prefix string = ",\"snippet\":"
+		out.RawString(prefix)
+		out.String(string(in.Snippet))
+	}
+	{
+		const prefix string = ",\"verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Verified))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Premium) MarshalJSON() ([]byte, error) {
+func (v FoundProfile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel80(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Premium) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel24(w, v)
+func (v FoundProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel80(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Premium) UnmarshalJSON(data []byte) error {
+func (v *FoundProfile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel80(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Premium) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel24(l, v)
+func (v *FoundProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel80(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.Lexer, out *Preference) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel81(in *jlexer.Lexer, out *FlowersPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2768,10 +8321,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "preference_description":
-			out.Description = string(in.String())
-		case "preference_value":
-			out.Value = string(in.String())
+		case "user_id":
+			out.UserID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2782,47 +8333,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(out *jwriter.Writer, in Preference) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel81(out *jwriter.Writer, in FlowersPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"preference_description\":"
+		const prefix string = ",\"user_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"preference_value\":"
-		out.RawString(prefix)
-		out.String(string(in.Value))
+		out.Int(int(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Preference) MarshalJSON() ([]byte, error) {
+func (v FlowersPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Preference) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel25(w, v)
+func (v FlowersPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Preference) UnmarshalJSON(data []byte) error {
+func (v *FlowersPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Preference) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel25(l, v)
+func (v *FlowersPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel81(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.Lexer, out *NotificationSend) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel82(in *jlexer.Lexer, out *FindQueryRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2841,14 +8387,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "notificationID":
-			out.NotificationID = int(in.Int())
-		case "read":
-			out.Read = int(in.Int())
-		case "type":
-			out.NotifType = string(in.String())
-		case "content":
-			out.Content = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "query_id":
+			out.Query_id = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2859,57 +8401,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(out *jwriter.Writer, in NotificationSend) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel82(out *jwriter.Writer, in FindQueryRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notificationID\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.NotificationID))
-	}
-	{
-		const prefix string = ",\"read\":"
-		out.RawString(prefix)
-		out.Int(int(in.Read))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.NotifType))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"query_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Content))
+		out.Int(int(in.Query_id))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NotificationSend) MarshalJSON() ([]byte, error) {
+func (v FindQueryRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NotificationSend) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel26(w, v)
+func (v FindQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NotificationSend) UnmarshalJSON(data []byte) error {
+func (v *FindQueryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NotificationSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel26(l, v)
+func (v *FindQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel82(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.Lexer, out *Notification) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel83(in *jlexer.Lexer, out *FindComplaint) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2928,16 +8460,18 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "payload":
-			if m, ok := out.Payload.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Payload.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Payload = in.Interface()
-			}
+		case "complaint_by":
+			out.Complaint_by = int(in.Int())
+		case "name_by":
+			out.Name_by = string(in.String())
+		case "complaint_on":
+			out.Complaint_on = int(in.Int())
+		case "name_on":
+			out.Name_on = string(in.String())
+		case "complaint_type":
+			out.Complaint_type = string(in.String())
+		case "status":
+			out.Status = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2948,53 +8482,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(out *jwriter.Writer, in Notification) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel83(out *jwriter.Writer, in FindComplaint) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"complaint_by\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Int(int(in.Complaint_by))
 	}
 	{
-		const prefix string = ",\"payload\":"
+		const prefix string = ",\"name_by\":"
 		out.RawString(prefix)
-		if m, ok := in.Payload.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Payload.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Payload))
-		}
+		out.String(string(in.Name_by))
+	}
+	{
+		const prefix string = ",\"complaint_on\":"
+		out.RawString(prefix)
+		out.Int(int(in.Complaint_on))
+	}
+	{
+		const prefix string = ",\"name_on\":"
+		out.RawString(prefix)
+		out.String(string(in.Name_on))
+	}
+	{
+		const prefix string = ",\"complaint_type\":"
+		out.RawString(prefix)
+		out.String(string(in.Complaint_type))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Notification) MarshalJSON() ([]byte, error) {
+func (v FindComplaint) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel27(w, v)
+func (v FindComplaint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Notification) UnmarshalJSON(data []byte) error {
+func (v *FindComplaint) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel27(l, v)
+func (v *FindComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel83(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.Lexer, out *Message) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel84(in *jlexer.Lexer, out *FinalizePhotoUploadResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3013,18 +8561,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "messageid":
-			out.MessageID = int(in.Int())
-		case "senderid":
-			out.SenderID = int(in.Int())
-		case "text":
-			out.Text = string(in.String())
-		case "status":
-			out.Status = int(in.Int())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
+		case "path":
+			out.Path = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3035,62 +8573,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(out *jwriter.Writer, in Message) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel84(out *jwriter.Writer, in FinalizePhotoUploadResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"messageid\":"
+		const prefix string = ",\"path\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.MessageID))
-	}
-	{
-		const prefix string = ",\"senderid\":"
-		out.RawString(prefix)
-		out.Int(int(in.SenderID))
-	}
-	{
-		const prefix string = ",\"text\":"
-		out.RawString(prefix)
-		out.String(string(in.Text))
-	}
-	{
-		const prefix string = ",\"status\":"
-		out.RawString(prefix)
-		out.Int(int(in.Status))
-	}
-	{
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.Path))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Message) MarshalJSON() ([]byte, error) {
+func (v FinalizePhotoUploadResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel28(w, v)
+func (v FinalizePhotoUploadResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Message) UnmarshalJSON(data []byte) error {
+func (v *FinalizePhotoUploadResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel28(l, v)
+func (v *FinalizePhotoUploadResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel84(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.Lexer, out *LoginResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel85(in *jlexer.Lexer, out *FinalizePhotoUploadRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3109,10 +8627,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
-		case "user_id":
-			out.UserID = int(in.Int())
+		case "uploadKey":
+			out.UploadKey = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3123,47 +8639,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(out *jwriter.Writer, in LoginResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel85(out *jwriter.Writer, in FinalizePhotoUploadRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"uploadKey\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
-	}
-	{
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.Int(int(in.UserID))
+		out.String(string(in.UploadKey))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LoginResponse) MarshalJSON() ([]byte, error) {
+func (v FinalizePhotoUploadRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LoginResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel29(w, v)
+func (v FinalizePhotoUploadRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LoginResponse) UnmarshalJSON(data []byte) error {
+func (v *FinalizePhotoUploadRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LoginResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel29(l, v)
+func (v *FinalizePhotoUploadRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel85(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.Lexer, out *LoginRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel86(in *jlexer.Lexer, out *FieldChange) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3180,12 +8691,18 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.L
 			in.Skip()
 			in.WantComma()
 			continue
-		}
-		switch key {
-		case "login":
-			out.Login = string(in.String())
-		case "password":
-			out.Password = string(in.String())
+		}
+		switch key {
+		case "field":
+			out.Field = string(in.String())
+		case "old":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Old).UnmarshalJSON(data))
+			}
+		case "new":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.New).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3196,47 +8713,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(out *jwriter.Writer, in LoginRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel86(out *jwriter.Writer, in FieldChange) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"field\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Login))
+		out.String(string(in.Field))
 	}
 	{
-		const prefix string = ",\"password\":"
+		const prefix string = ",\"old\":"
 		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.Raw((in.Old).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"new\":"
+		out.RawString(prefix)
+		out.Raw((in.New).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v LoginRequest) MarshalJSON() ([]byte, error) {
+func (v FieldChange) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LoginRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel30(w, v)
+func (v FieldChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *LoginRequest) UnmarshalJSON(data []byte) error {
+func (v *FieldChange) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LoginRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel30(l, v)
+func (v *FieldChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel86(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.Lexer, out *HandleComplaint) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel87(in *jlexer.Lexer, out *FacetCount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3255,10 +8777,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaint_id":
-			out.Complaint_id = int(in.Int())
-		case "new_status":
-			out.NewStatus = int(in.Int())
+		case "value":
+			out.Value = string(in.String())
+		case "count":
+			out.Count = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3269,47 +8791,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(out *jwriter.Writer, in HandleComplaint) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel87(out *jwriter.Writer, in FacetCount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"complaint_id\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Complaint_id))
+		out.String(string(in.Value))
 	}
 	{
-		const prefix string = ",\"new_status\":"
+		const prefix string = ",\"count\":"
 		out.RawString(prefix)
-		out.Int(int(in.NewStatus))
+		out.Int(int(in.Count))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v HandleComplaint) MarshalJSON() ([]byte, error) {
+func (v FacetCount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v HandleComplaint) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel31(w, v)
+func (v FacetCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *HandleComplaint) UnmarshalJSON(data []byte) error {
+func (v *FacetCount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *HandleComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel31(l, v)
+func (v *FacetCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel87(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.Lexer, out *GetAnswerStatistics) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel88(in *jlexer.Lexer, out *ErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3328,8 +8850,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "query_name":
-			out.Query_name = string(in.String())
+		case "message":
+			out.Message = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3340,42 +8862,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(out *jwriter.Writer, in GetAnswerStatistics) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel88(out *jwriter.Writer, in ErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"query_name\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Query_name))
+		out.String(string(in.Message))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v GetAnswerStatistics) MarshalJSON() ([]byte, error) {
+func (v ErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetAnswerStatistics) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel32(w, v)
+func (v ErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetAnswerStatistics) UnmarshalJSON(data []byte) error {
+func (v *ErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetAnswerStatistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel32(l, v)
+func (v *ErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel88(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.Lexer, out *FoundProfileResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel89(in *jlexer.Lexer, out *DeleteQueryRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3394,29 +8916,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profiles":
-			if in.IsNull() {
-				in.Skip()
-				out.Profiles = nil
-			} else {
-				in.Delim('[')
-				if out.Profiles == nil {
-					if !in.IsDelim(']') {
-						out.Profiles = make([]FoundProfile, 0, 1)
-					} else {
-						out.Profiles = []FoundProfile{}
-					}
-				} else {
-					out.Profiles = (out.Profiles)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v46 FoundProfile
-					(v46).UnmarshalEasyJSON(in)
-					out.Profiles = append(out.Profiles, v46)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "query_name":
+			out.Query_name = string(in.String())
+		case "user_id":
+			out.User_id = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3427,53 +8930,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(out *jwriter.Writer, in FoundProfileResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel89(out *jwriter.Writer, in DeleteQueryRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profiles\":"
+		const prefix string = ",\"query_name\":"
 		out.RawString(prefix[1:])
-		if in.Profiles == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v47, v48 := range in.Profiles {
-				if v47 > 0 {
-					out.RawByte(',')
-				}
-				(v48).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Query_name))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.User_id))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FoundProfileResponse) MarshalJSON() ([]byte, error) {
+func (v DeleteQueryRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FoundProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel33(w, v)
+func (v DeleteQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FoundProfileResponse) UnmarshalJSON(data []byte) error {
+func (v *DeleteQueryRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FoundProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel33(l, v)
+func (v *DeleteQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel89(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.Lexer, out *FoundProfile) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel90(in *jlexer.Lexer, out *DeletePayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3492,16 +8989,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "idUser":
-			out.IDUser = int(in.Int())
-		case "firstImgSrc":
-			out.FirstImg = string(in.String())
-		case "fullname":
-			out.Fullname = string(in.String())
-		case "age":
-			out.Age = int(in.Int())
-		case "goal":
-			out.Goal = int(in.Int())
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "message_id":
+			out.MessageID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3512,62 +9003,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(out *jwriter.Writer, in FoundProfile) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel90(out *jwriter.Writer, in DeletePayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"idUser\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.IDUser))
-	}
-	{
-		const prefix string = ",\"firstImgSrc\":"
-		out.RawString(prefix)
-		out.String(string(in.FirstImg))
-	}
-	{
-		const prefix string = ",\"fullname\":"
-		out.RawString(prefix)
-		out.String(string(in.Fullname))
-	}
-	{
-		const prefix string = ",\"age\":"
-		out.RawString(prefix)
-		out.Int(int(in.Age))
+		out.Int(int(in.ChatID))
 	}
 	{
-		const prefix string = ",\"goal\":"
+		const prefix string = ",\"message_id\":"
 		out.RawString(prefix)
-		out.Int(int(in.Goal))
+		out.Int(int(in.MessageID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FoundProfile) MarshalJSON() ([]byte, error) {
+func (v DeletePayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FoundProfile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel34(w, v)
+func (v DeletePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FoundProfile) UnmarshalJSON(data []byte) error {
+func (v *DeletePayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FoundProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel34(l, v)
+func (v *DeletePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel90(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.Lexer, out *FlowersPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel91(in *jlexer.Lexer, out *DeleteNotifPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3586,8 +9062,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.UserID = int(in.Int())
+		case "notif_id":
+			out.NotifID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3598,42 +9074,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(out *jwriter.Writer, in FlowersPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel91(out *jwriter.Writer, in DeleteNotifPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"notif_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.UserID))
+		out.Int(int(in.NotifID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FlowersPayload) MarshalJSON() ([]byte, error) {
+func (v DeleteNotifPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FlowersPayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel35(w, v)
+func (v DeleteNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FlowersPayload) UnmarshalJSON(data []byte) error {
+func (v *DeleteNotifPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FlowersPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel35(l, v)
+func (v *DeleteNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel91(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.Lexer, out *FindQueryRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel92(in *jlexer.Lexer, out *DeleteComlaint) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3652,10 +9128,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "query_id":
-			out.Query_id = int(in.Int())
+		case "complaint_id":
+			out.Complaint_id = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3666,47 +9140,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(out *jwriter.Writer, in FindQueryRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel92(out *jwriter.Writer, in DeleteComlaint) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"complaint_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"query_id\":"
-		out.RawString(prefix)
-		out.Int(int(in.Query_id))
+		out.Int(int(in.Complaint_id))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FindQueryRequest) MarshalJSON() ([]byte, error) {
+func (v DeleteComlaint) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FindQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel36(w, v)
+func (v DeleteComlaint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FindQueryRequest) UnmarshalJSON(data []byte) error {
+func (v *DeleteComlaint) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FindQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel36(l, v)
+func (v *DeleteComlaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel92(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(in *jlexer.Lexer, out *FindComplaint) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel93(in *jlexer.Lexer, out *DeleteChatRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3725,89 +9194,61 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaint_by":
-			out.Complaint_by = int(in.Int())
-		case "name_by":
-			out.Name_by = string(in.String())
-		case "complaint_on":
-			out.Complaint_on = int(in.Int())
-		case "name_on":
-			out.Name_on = string(in.String())
-		case "complaint_type":
-			out.Complaint_type = string(in.String())
-		case "status":
-			out.Status = int(in.Int())
+		case "firstID":
+			out.FristID = int(in.Int())
+		case "secondID":
+			out.SecondID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
 		in.WantComma()
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(out *jwriter.Writer, in FindComplaint) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"complaint_by\":"
-		out.RawString(prefix[1:])
-		out.Int(int(in.Complaint_by))
-	}
-	{
-		const prefix string = ",\"name_by\":"
-		out.RawString(prefix)
-		out.String(string(in.Name_by))
-	}
-	{
-		const prefix string = ",\"complaint_on\":"
-		out.RawString(prefix)
-		out.Int(int(in.Complaint_on))
-	}
-	{
-		const prefix string = ",\"name_on\":"
-		out.RawString(prefix)
-		out.String(string(in.Name_on))
-	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel93(out *jwriter.Writer, in DeleteChatRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"complaint_type\":"
-		out.RawString(prefix)
-		out.String(string(in.Complaint_type))
+		const prefix string = ",\"firstID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.FristID))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"secondID\":"
 		out.RawString(prefix)
-		out.Int(int(in.Status))
+		out.Int(int(in.SecondID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FindComplaint) MarshalJSON() ([]byte, error) {
+func (v DeleteChatRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FindComplaint) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel37(w, v)
+func (v DeleteChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FindComplaint) UnmarshalJSON(data []byte) error {
+func (v *DeleteChatRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FindComplaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel37(l, v)
+func (v *DeleteChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel93(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.Lexer, out *ErrorResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel94(in *jlexer.Lexer, out *CreatePayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3826,8 +9267,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
+		case "chat_id":
+			out.ChatID = int(in.Int())
+		case "user_id":
+			out.UserID = int(in.Int())
+		case "content":
+			out.Content = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3838,42 +9283,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(out *jwriter.Writer, in ErrorResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel94(out *jwriter.Writer, in CreatePayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.Int(int(in.ChatID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		out.String(string(in.Content))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ErrorResponse) MarshalJSON() ([]byte, error) {
+func (v CreatePayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel94(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel38(w, v)
+func (v CreatePayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel94(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ErrorResponse) UnmarshalJSON(data []byte) error {
+func (v *CreatePayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel94(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel38(l, v)
+func (v *CreatePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel94(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.Lexer, out *DeleteQueryRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel95(in *jlexer.Lexer, out *CreateComplaintRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3892,10 +9347,12 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "query_name":
-			out.Query_name = string(in.String())
-		case "user_id":
-			out.User_id = int(in.Int())
+		case "firstID":
+			out.Complaint_type = string(in.String())
+		case "complaint_text":
+			out.Complaint_text = string(in.String())
+		case "complaint_on":
+			out.Complaint_on = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3906,47 +9363,52 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(out *jwriter.Writer, in DeleteQueryRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel95(out *jwriter.Writer, in CreateComplaintRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"query_name\":"
+		const prefix string = ",\"firstID\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Query_name))
+		out.String(string(in.Complaint_type))
 	}
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"complaint_text\":"
 		out.RawString(prefix)
-		out.Int(int(in.User_id))
+		out.String(string(in.Complaint_text))
+	}
+	{
+		const prefix string = ",\"complaint_on\":"
+		out.RawString(prefix)
+		out.String(string(in.Complaint_on))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteQueryRequest) MarshalJSON() ([]byte, error) {
+func (v CreateComplaintRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel95(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteQueryRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel39(w, v)
+func (v CreateComplaintRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel95(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteQueryRequest) UnmarshalJSON(data []byte) error {
+func (v *CreateComplaintRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel95(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteQueryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel39(l, v)
+func (v *CreateComplaintRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel95(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.Lexer, out *DeletePayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel96(in *jlexer.Lexer, out *CreateChatRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3965,10 +9427,10 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
-			out.ChatID = int(in.Int())
-		case "message_id":
-			out.MessageID = int(in.Int())
+		case "firstID":
+			out.FristID = int(in.Int())
+		case "secondID":
+			out.SecondID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -3979,47 +9441,47 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(out *jwriter.Writer, in DeletePayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel96(out *jwriter.Writer, in CreateChatRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"firstID\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatID))
+		out.Int(int(in.FristID))
 	}
 	{
-		const prefix string = ",\"message_id\":"
+		const prefix string = ",\"secondID\":"
 		out.RawString(prefix)
-		out.Int(int(in.MessageID))
+		out.Int(int(in.SecondID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeletePayload) MarshalJSON() ([]byte, error) {
+func (v CreateChatRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeletePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel40(w, v)
+func (v CreateChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeletePayload) UnmarshalJSON(data []byte) error {
+func (v *CreateChatRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeletePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel40(l, v)
+func (v *CreateChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel96(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.Lexer, out *DeleteNotifPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel97(in *jlexer.Lexer, out *Cookie) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4038,8 +9500,20 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "notif_id":
-			out.NotifID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "value":
+			out.Value = string(in.String())
+		case "expires":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Expires).UnmarshalJSON(data))
+			}
+		case "httpOnly":
+			out.HttpOnly = bool(in.Bool())
+		case "secure":
+			out.Secure = bool(in.Bool())
+		case "path":
+			out.Path = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4050,42 +9524,67 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(out *jwriter.Writer, in DeleteNotifPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel97(out *jwriter.Writer, in Cookie) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"notif_id\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.NotifID))
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"expires\":"
+		out.RawString(prefix)
+		out.Raw((in.Expires).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"httpOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HttpOnly))
+	}
+	{
+		const prefix string = ",\"secure\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Secure))
+	}
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteNotifPayload) MarshalJSON() ([]byte, error) {
+func (v Cookie) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteNotifPayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel41(w, v)
+func (v Cookie) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteNotifPayload) UnmarshalJSON(data []byte) error {
+func (v *Cookie) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteNotifPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel41(l, v)
+func (v *Cookie) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel97(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.Lexer, out *DeleteComlaint) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel98(in *jlexer.Lexer, out *Completeness) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4104,8 +9603,31 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaint_id":
-			out.Complaint_id = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "missing":
+			if in.IsNull() {
+				in.Skip()
+				out.Missing = nil
+			} else {
+				in.Delim('[')
+				if out.Missing == nil {
+					if !in.IsDelim(']') {
+						out.Missing = make([]string, 0, 4)
+					} else {
+						out.Missing = []string{}
+					}
+				} else {
+					out.Missing = (out.Missing)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v121 string
+					v121 = string(in.String())
+					out.Missing = append(out.Missing, v121)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4116,42 +9638,58 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(out *jwriter.Writer, in DeleteComlaint) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel98(out *jwriter.Writer, in Completeness) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"complaint_id\":"
+		const prefix string = ",\"score\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Complaint_id))
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"missing\":"
+		out.RawString(prefix)
+		if in.Missing == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v122, v123 := range in.Missing {
+				if v122 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v123))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteComlaint) MarshalJSON() ([]byte, error) {
+func (v Completeness) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteComlaint) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel42(w, v)
+func (v Completeness) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteComlaint) UnmarshalJSON(data []byte) error {
+func (v *Completeness) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteComlaint) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel42(l, v)
+func (v *Completeness) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel98(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.Lexer, out *DeleteChatRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel99(in *jlexer.Lexer, out *ComplaintsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4170,10 +9708,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "firstID":
-			out.FristID = int(in.Int())
-		case "secondID":
-			out.SecondID = int(in.Int())
+		case "complaints":
+			if in.IsNull() {
+				in.Skip()
+				out.Complaints = nil
+			} else {
+				in.Delim('[')
+				if out.Complaints == nil {
+					if !in.IsDelim(']') {
+						out.Complaints = make([]ComplaintWithLogins, 0, 0)
+					} else {
+						out.Complaints = []ComplaintWithLogins{}
+					}
+				} else {
+					out.Complaints = (out.Complaints)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v124 ComplaintWithLogins
+					(v124).UnmarshalEasyJSON(in)
+					out.Complaints = append(out.Complaints, v124)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4184,47 +9741,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(out *jwriter.Writer, in DeleteChatRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel99(out *jwriter.Writer, in ComplaintsResponse) {
 	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"firstID\":"
-		out.RawString(prefix[1:])
-		out.Int(int(in.FristID))
-	}
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"secondID\":"
-		out.RawString(prefix)
-		out.Int(int(in.SecondID))
+		const prefix string = ",\"complaints\":"
+		out.RawString(prefix[1:])
+		if in.Complaints == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v125, v126 := range in.Complaints {
+				if v125 > 0 {
+					out.RawByte(',')
+				}
+				(v126).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v DeleteChatRequest) MarshalJSON() ([]byte, error) {
+func (v ComplaintsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v DeleteChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel43(w, v)
+func (v ComplaintsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteChatRequest) UnmarshalJSON(data []byte) error {
+func (v *ComplaintsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel43(l, v)
+func (v *ComplaintsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel99(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.Lexer, out *CreatePayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel100(in *jlexer.Lexer, out *ComplaintWithLogins) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4243,12 +9806,36 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
-			out.ChatID = int(in.Int())
-		case "user_id":
-			out.UserID = int(in.Int())
-		case "content":
-			out.Content = string(in.String())
+		case "complaint_id":
+			out.ComplaintID = int64(in.Int64())
+		case "complaint_by":
+			out.ComplaintBy = string(in.String())
+		case "complaint_on":
+			out.ComplaintOn = string(in.String())
+		case "complaint_type":
+			out.ComplaintType = int64(in.Int64())
+		case "type_description":
+			out.TypeDesc = string(in.String())
+		case "complaint_text":
+			out.Text = string(in.String())
+		case "status":
+			out.Status = int(in.Int())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "closed_at":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4259,52 +9846,86 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(out *jwriter.Writer, in CreatePayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel100(out *jwriter.Writer, in ComplaintWithLogins) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"complaint_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatID))
+		out.Int64(int64(in.ComplaintID))
 	}
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"complaint_by\":"
 		out.RawString(prefix)
-		out.Int(int(in.UserID))
+		out.String(string(in.ComplaintBy))
 	}
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"complaint_on\":"
 		out.RawString(prefix)
-		out.String(string(in.Content))
+		out.String(string(in.ComplaintOn))
+	}
+	{
+		const prefix string = ",\"complaint_type\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ComplaintType))
+	}
+	{
+		const prefix string = ",\"type_description\":"
+		out.RawString(prefix)
+		out.String(string(in.TypeDesc))
+	}
+	{
+		const prefix string = ",\"complaint_text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"closed_at\":"
+		out.RawString(prefix)
+		if in.ClosedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ClosedAt).MarshalJSON())
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreatePayload) MarshalJSON() ([]byte, error) {
+func (v ComplaintWithLogins) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel100(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreatePayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel44(w, v)
+func (v ComplaintWithLogins) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel100(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreatePayload) UnmarshalJSON(data []byte) error {
+func (v *ComplaintWithLogins) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel100(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreatePayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel44(l, v)
+func (v *ComplaintWithLogins) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel100(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.Lexer, out *CreateComplaintRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel101(in *jlexer.Lexer, out *ComplaintStats) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4323,12 +9944,28 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "firstID":
-			out.Complaint_type = string(in.String())
-		case "complaint_text":
-			out.Complaint_text = string(in.String())
-		case "complaint_on":
-			out.Complaint_on = string(in.String())
+		case "total_complaints":
+			out.Total = int(in.Int())
+		case "rejected":
+			out.Rejected = int(in.Int())
+		case "pending":
+			out.Pending = int(in.Int())
+		case "approved":
+			out.Approved = int(in.Int())
+		case "closed":
+			out.Closed = int(in.Int())
+		case "total_complainants":
+			out.TotalBy = int(in.Int())
+		case "total_reported":
+			out.TotalOn = int(in.Int())
+		case "first_complaint":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.FirstComplaint).UnmarshalJSON(data))
+			}
+		case "last_complaint":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.LastComplaint).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4339,52 +9976,82 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(out *jwriter.Writer, in CreateComplaintRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel101(out *jwriter.Writer, in ComplaintStats) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"firstID\":"
+		const prefix string = ",\"total_complaints\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Complaint_type))
+		out.Int(int(in.Total))
 	}
 	{
-		const prefix string = ",\"complaint_text\":"
+		const prefix string = ",\"rejected\":"
 		out.RawString(prefix)
-		out.String(string(in.Complaint_text))
+		out.Int(int(in.Rejected))
 	}
 	{
-		const prefix string = ",\"complaint_on\":"
+		const prefix string = ",\"pending\":"
 		out.RawString(prefix)
-		out.String(string(in.Complaint_on))
+		out.Int(int(in.Pending))
+	}
+	{
+		const prefix string = ",\"approved\":"
+		out.RawString(prefix)
+		out.Int(int(in.Approved))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Closed))
+	}
+	{
+		const prefix string = ",\"total_complainants\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalBy))
+	}
+	{
+		const prefix string = ",\"total_reported\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalOn))
+	}
+	{
+		const prefix string = ",\"first_complaint\":"
+		out.RawString(prefix)
+		out.Raw((in.FirstComplaint).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"last_complaint\":"
+		out.RawString(prefix)
+		out.Raw((in.LastComplaint).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateComplaintRequest) MarshalJSON() ([]byte, error) {
+func (v ComplaintStats) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel101(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateComplaintRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel45(w, v)
+func (v ComplaintStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel101(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateComplaintRequest) UnmarshalJSON(data []byte) error {
+func (v *ComplaintStats) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel101(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateComplaintRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel45(l, v)
+func (v *ComplaintStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel101(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.Lexer, out *CreateChatRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel102(in *jlexer.Lexer, out *ChatsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4403,10 +10070,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "firstID":
-			out.FristID = int(in.Int())
-		case "secondID":
-			out.SecondID = int(in.Int())
+		case "chats":
+			if in.IsNull() {
+				in.Skip()
+				out.Chats = nil
+			} else {
+				in.Delim('[')
+				if out.Chats == nil {
+					if !in.IsDelim(']') {
+						out.Chats = make([]Chat, 0, 0)
+					} else {
+						out.Chats = []Chat{}
+					}
+				} else {
+					out.Chats = (out.Chats)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v127 Chat
+					(v127).UnmarshalEasyJSON(in)
+					out.Chats = append(out.Chats, v127)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4417,47 +10103,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(out *jwriter.Writer, in CreateChatRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel102(out *jwriter.Writer, in ChatsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"firstID\":"
+		const prefix string = ",\"chats\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.FristID))
-	}
-	{
-		const prefix string = ",\"secondID\":"
-		out.RawString(prefix)
-		out.Int(int(in.SecondID))
+		if in.Chats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v128, v129 := range in.Chats {
+				if v128 > 0 {
+					out.RawByte(',')
+				}
+				(v129).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateChatRequest) MarshalJSON() ([]byte, error) {
+func (v ChatsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel102(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateChatRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel46(w, v)
+func (v ChatsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel102(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateChatRequest) UnmarshalJSON(data []byte) error {
+func (v *ChatsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel102(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateChatRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel46(l, v)
+func (v *ChatsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel102(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.Lexer, out *Cookie) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel103(in *jlexer.Lexer, out *ChatNotificationsPayload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4476,20 +10168,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "value":
-			out.Value = string(in.String())
-		case "expires":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Expires).UnmarshalJSON(data))
-			}
-		case "httpOnly":
-			out.HttpOnly = bool(in.Bool())
-		case "secure":
-			out.Secure = bool(in.Bool())
-		case "path":
-			out.Path = string(in.String())
+		case "chat_id":
+			out.ChatID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4500,67 +10180,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(out *jwriter.Writer, in Cookie) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel103(out *jwriter.Writer, in ChatNotificationsPayload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"chat_id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"value\":"
-		out.RawString(prefix)
-		out.String(string(in.Value))
-	}
-	{
-		const prefix string = ",\"expires\":"
-		out.RawString(prefix)
-		out.Raw((in.Expires).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"httpOnly\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.HttpOnly))
-	}
-	{
-		const prefix string = ",\"secure\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Secure))
-	}
-	{
-		const prefix string = ",\"path\":"
-		out.RawString(prefix)
-		out.String(string(in.Path))
+		out.Int(int(in.ChatID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Cookie) MarshalJSON() ([]byte, error) {
+func (v ChatNotificationsPayload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel103(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Cookie) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel47(w, v)
+func (v ChatNotificationsPayload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel103(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Cookie) UnmarshalJSON(data []byte) error {
+func (v *ChatNotificationsPayload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel103(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Cookie) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel47(l, v)
+func (v *ChatNotificationsPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel103(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.Lexer, out *ComplaintsResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel104(in *jlexer.Lexer, out *ChatChange) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4579,25 +10234,31 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaints":
+		case "FirstId":
+			out.FirstId = int(in.Int())
+		case "SecondId":
+			out.SecondId = int(in.Int())
+		case "ReadOnly":
+			out.ReadOnly = bool(in.Bool())
+		case "Seed":
 			if in.IsNull() {
 				in.Skip()
-				out.Complaints = nil
+				out.Seed = nil
 			} else {
 				in.Delim('[')
-				if out.Complaints == nil {
+				if out.Seed == nil {
 					if !in.IsDelim(']') {
-						out.Complaints = make([]ComplaintWithLogins, 0, 0)
+						out.Seed = make([]MatchComment, 0, 2)
 					} else {
-						out.Complaints = []ComplaintWithLogins{}
+						out.Seed = []MatchComment{}
 					}
 				} else {
-					out.Complaints = (out.Complaints)[:0]
+					out.Seed = (out.Seed)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v49 ComplaintWithLogins
-					(v49).UnmarshalEasyJSON(in)
-					out.Complaints = append(out.Complaints, v49)
+					var v130 MatchComment
+					(v130).UnmarshalEasyJSON(in)
+					out.Seed = append(out.Seed, v130)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4612,22 +10273,37 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(out *jwriter.Writer, in ComplaintsResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel104(out *jwriter.Writer, in ChatChange) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"complaints\":"
+		const prefix string = ",\"FirstId\":"
 		out.RawString(prefix[1:])
-		if in.Complaints == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.Int(int(in.FirstId))
+	}
+	{
+		const prefix string = ",\"SecondId\":"
+		out.RawString(prefix)
+		out.Int(int(in.SecondId))
+	}
+	{
+		const prefix string = ",\"ReadOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReadOnly))
+	}
+	{
+		const prefix string = ",\"Seed\":"
+		out.RawString(prefix)
+		if in.Seed == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v50, v51 := range in.Complaints {
-				if v50 > 0 {
+			for v131, v132 := range in.Seed {
+				if v131 > 0 {
 					out.RawByte(',')
 				}
-				(v51).MarshalEasyJSON(out)
+				(v132).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4636,29 +10312,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(out *jwriter
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ComplaintsResponse) MarshalJSON() ([]byte, error) {
+func (v ChatChange) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel104(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ComplaintsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel48(w, v)
+func (v ChatChange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel104(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ComplaintsResponse) UnmarshalJSON(data []byte) error {
+func (v *ChatChange) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel104(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ComplaintsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel48(l, v)
+func (v *ChatChange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel104(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.Lexer, out *ComplaintWithLogins) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel105(in *jlexer.Lexer, out *Chat) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4677,36 +10353,24 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "complaint_id":
-			out.ComplaintID = int64(in.Int64())
-		case "complaint_by":
-			out.ComplaintBy = string(in.String())
-		case "complaint_on":
-			out.ComplaintOn = string(in.String())
-		case "complaint_type":
-			out.ComplaintType = int64(in.Int64())
-		case "type_description":
-			out.TypeDesc = string(in.String())
-		case "complaint_text":
-			out.Text = string(in.String())
-		case "status":
-			out.Status = int(in.Int())
-		case "created_at":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "closed_at":
-			if in.IsNull() {
-				in.Skip()
-				out.ClosedAt = nil
-			} else {
-				if out.ClosedAt == nil {
-					out.ClosedAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
-				}
-			}
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "chatId":
+			out.ChatId = int(in.Int())
+		case "profileName":
+			out.ProfileName = string(in.String())
+		case "profilePicture":
+			out.ProfilePicture = string(in.String())
+		case "profileDescription":
+			out.ProfileDescription = string(in.String())
+		case "lastMessage":
+			out.LastMessage = string(in.String())
+		case "isRead":
+			out.IsRead = bool(in.Bool())
+		case "isSelf":
+			out.IsSelf = bool(in.Bool())
+		case "readOnly":
+			out.ReadOnly = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -4717,86 +10381,82 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(out *jwriter.Writer, in ComplaintWithLogins) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel105(out *jwriter.Writer, in Chat) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"complaint_id\":"
+		const prefix string = ",\"profileId\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ComplaintID))
+		out.Int(int(in.ProfileId))
 	}
 	{
-		const prefix string = ",\"complaint_by\":"
+		const prefix string = ",\"chatId\":"
 		out.RawString(prefix)
-		out.String(string(in.ComplaintBy))
+		out.Int(int(in.ChatId))
 	}
 	{
-		const prefix string = ",\"complaint_on\":"
+		const prefix string = ",\"profileName\":"
 		out.RawString(prefix)
-		out.String(string(in.ComplaintOn))
+		out.String(string(in.ProfileName))
 	}
 	{
-		const prefix string = ",\"complaint_type\":"
+		const prefix string = ",\"profilePicture\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.ComplaintType))
+		out.String(string(in.ProfilePicture))
 	}
 	{
-		const prefix string = ",\"type_description\":"
+		const prefix string = ",\"profileDescription\":"
 		out.RawString(prefix)
-		out.String(string(in.TypeDesc))
+		out.String(string(in.ProfileDescription))
 	}
 	{
-		const prefix string = ",\"complaint_text\":"
+		const prefix string = ",\"lastMessage\":"
 		out.RawString(prefix)
-		out.String(string(in.Text))
+		out.String(string(in.LastMessage))
 	}
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"isRead\":"
 		out.RawString(prefix)
-		out.Int(int(in.Status))
+		out.Bool(bool(in.IsRead))
 	}
 	{
-		const prefix string = ",\"created_at\":"
+		const prefix string = ",\"isSelf\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.Bool(bool(in.IsSelf))
 	}
 	{
-		const prefix string = ",\"closed_at\":"
+		const prefix string = ",\"readOnly\":"
 		out.RawString(prefix)
-		if in.ClosedAt == nil {
-			out.RawString("null")
-		} else {
-			out.Raw((*in.ClosedAt).MarshalJSON())
-		}
+		out.Bool(bool(in.ReadOnly))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ComplaintWithLogins) MarshalJSON() ([]byte, error) {
+func (v Chat) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel105(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ComplaintWithLogins) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel49(w, v)
+func (v Chat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel105(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ComplaintWithLogins) UnmarshalJSON(data []byte) error {
+func (v *Chat) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel105(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ComplaintWithLogins) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel49(l, v)
+func (v *Chat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel105(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.Lexer, out *ComplaintStats) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel106(in *jlexer.Lexer, out *ChangeBorderRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4815,28 +10475,90 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "total_complaints":
-			out.Total = int(in.Int())
-		case "rejected":
-			out.Rejected = int(in.Int())
-		case "pending":
-			out.Pending = int(in.Int())
-		case "approved":
-			out.Approved = int(in.Int())
-		case "closed":
-			out.Closed = int(in.Int())
-		case "total_complainants":
-			out.TotalBy = int(in.Int())
-		case "total_reported":
-			out.TotalOn = int(in.Int())
-		case "first_complaint":
+		case "new_border":
+			out.NewBorder = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel106(out *jwriter.Writer, in ChangeBorderRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"new_border\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.NewBorder))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ChangeBorderRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel106(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ChangeBorderRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel106(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ChangeBorderRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel106(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ChangeBorderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel106(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel107(in *jlexer.Lexer, out *Boost) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "boostId":
+			out.BoostID = int(in.Int())
+		case "source":
+			out.Source = string(in.String())
+		case "startedAt":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.FirstComplaint).UnmarshalJSON(data))
+				in.AddError((out.StartedAt).UnmarshalJSON(data))
 			}
-		case "last_complaint":
+		case "endsAt":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.LastComplaint).UnmarshalJSON(data))
+				in.AddError((out.EndsAt).UnmarshalJSON(data))
 			}
+		case "views":
+			out.Views = int(in.Int())
+		case "likes":
+			out.Likes = int(in.Int())
+		case "matches":
+			out.Matches = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -4847,82 +10569,72 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(out *jwriter.Writer, in ComplaintStats) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel107(out *jwriter.Writer, in Boost) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"total_complaints\":"
+		const prefix string = ",\"boostId\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Total))
-	}
-	{
-		const prefix string = ",\"rejected\":"
-		out.RawString(prefix)
-		out.Int(int(in.Rejected))
-	}
-	{
-		const prefix string = ",\"pending\":"
-		out.RawString(prefix)
-		out.Int(int(in.Pending))
+		out.Int(int(in.BoostID))
 	}
 	{
-		const prefix string = ",\"approved\":"
+		const prefix string = ",\"source\":"
 		out.RawString(prefix)
-		out.Int(int(in.Approved))
+		out.String(string(in.Source))
 	}
 	{
-		const prefix string = ",\"closed\":"
+		const prefix string = ",\"startedAt\":"
 		out.RawString(prefix)
-		out.Int(int(in.Closed))
+		out.Raw((in.StartedAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"total_complainants\":"
+		const prefix string = ",\"endsAt\":"
 		out.RawString(prefix)
-		out.Int(int(in.TotalBy))
+		out.Raw((in.EndsAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"total_reported\":"
+		const prefix string = ",\"views\":"
 		out.RawString(prefix)
-		out.Int(int(in.TotalOn))
+		out.Int(int(in.Views))
 	}
 	{
-		const prefix string = ",\"first_complaint\":"
+		const prefix string = ",\"likes\":"
 		out.RawString(prefix)
-		out.Raw((in.FirstComplaint).MarshalJSON())
+		out.Int(int(in.Likes))
 	}
 	{
-		const prefix string = ",\"last_complaint\":"
+		const prefix string = ",\"matches\":"
 		out.RawString(prefix)
-		out.Raw((in.LastComplaint).MarshalJSON())
+		out.Int(int(in.Matches))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ComplaintStats) MarshalJSON() ([]byte, error) {
+func (v Boost) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel107(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ComplaintStats) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel50(w, v)
+func (v Boost) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel107(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ComplaintStats) UnmarshalJSON(data []byte) error {
+func (v *Boost) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel107(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ComplaintStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel50(l, v)
+func (v *Boost) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel107(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.Lexer, out *ChatsResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel108(in *jlexer.Lexer, out *BlocksResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4941,25 +10653,25 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chats":
+		case "blocks":
 			if in.IsNull() {
 				in.Skip()
-				out.Chats = nil
+				out.Blocks = nil
 			} else {
 				in.Delim('[')
-				if out.Chats == nil {
+				if out.Blocks == nil {
 					if !in.IsDelim(']') {
-						out.Chats = make([]Chat, 0, 0)
+						out.Blocks = make([]BlockedProfile, 0, 1)
 					} else {
-						out.Chats = []Chat{}
+						out.Blocks = []BlockedProfile{}
 					}
 				} else {
-					out.Chats = (out.Chats)[:0]
+					out.Blocks = (out.Blocks)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v52 Chat
-					(v52).UnmarshalEasyJSON(in)
-					out.Chats = append(out.Chats, v52)
+					var v133 BlockedProfile
+					(v133).UnmarshalEasyJSON(in)
+					out.Blocks = append(out.Blocks, v133)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4974,22 +10686,22 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(out *jwriter.Writer, in ChatsResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel108(out *jwriter.Writer, in BlocksResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chats\":"
+		const prefix string = ",\"blocks\":"
 		out.RawString(prefix[1:])
-		if in.Chats == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Blocks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v53, v54 := range in.Chats {
-				if v53 > 0 {
+			for v134, v135 := range in.Blocks {
+				if v134 > 0 {
 					out.RawByte(',')
 				}
-				(v54).MarshalEasyJSON(out)
+				(v135).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4998,29 +10710,29 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(out *jwriter
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChatsResponse) MarshalJSON() ([]byte, error) {
+func (v BlocksResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel108(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChatsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel51(w, v)
+func (v BlocksResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel108(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChatsResponse) UnmarshalJSON(data []byte) error {
+func (v *BlocksResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel108(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChatsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel51(l, v)
+func (v *BlocksResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel108(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.Lexer, out *ChatNotificationsPayload) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel109(in *jlexer.Lexer, out *BlockedProfile) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5039,8 +10751,16 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "chat_id":
-			out.ChatID = int(in.Int())
+		case "profileId":
+			out.ProfileId = int(in.Int())
+		case "firstName":
+			out.FirstName = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "blockedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.BlockedAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5051,42 +10771,57 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(out *jwriter.Writer, in ChatNotificationsPayload) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel109(out *jwriter.Writer, in BlockedProfile) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"chat_id\":"
+		const prefix string = ",\"profileId\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ChatID))
+		out.Int(int(in.ProfileId))
+	}
+	{
+		const prefix string = ",\"firstName\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"blockedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.BlockedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChatNotificationsPayload) MarshalJSON() ([]byte, error) {
+func (v BlockedProfile) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel109(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChatNotificationsPayload) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel52(w, v)
+func (v BlockedProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel109(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChatNotificationsPayload) UnmarshalJSON(data []byte) error {
+func (v *BlockedProfile) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel109(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChatNotificationsPayload) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel52(l, v)
+func (v *BlockedProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel109(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.Lexer, out *Chat) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel110(in *jlexer.Lexer, out *BlockRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5105,22 +10840,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "profileId":
-			out.ProfileId = int(in.Int())
-		case "chatId":
-			out.ChatId = int(in.Int())
-		case "profileName":
-			out.ProfileName = string(in.String())
-		case "profilePicture":
-			out.ProfilePicture = string(in.String())
-		case "profileDescription":
-			out.ProfileDescription = string(in.String())
-		case "lastMessage":
-			out.LastMessage = string(in.String())
-		case "isRead":
-			out.IsRead = bool(in.Bool())
-		case "isSelf":
-			out.IsSelf = bool(in.Bool())
+		case "reason":
+			out.Reason = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5131,77 +10852,42 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(out *jwriter.Writer, in Chat) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel110(out *jwriter.Writer, in BlockRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"profileId\":"
+		const prefix string = ",\"reason\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ProfileId))
-	}
-	{
-		const prefix string = ",\"chatId\":"
-		out.RawString(prefix)
-		out.Int(int(in.ChatId))
-	}
-	{
-		const prefix string = ",\"profileName\":"
-		out.RawString(prefix)
-		out.String(string(in.ProfileName))
-	}
-	{
-		const prefix string = ",\"profilePicture\":"
-		out.RawString(prefix)
-		out.String(string(in.ProfilePicture))
-	}
-	{
-		const prefix string = ",\"profileDescription\":"
-		out.RawString(prefix)
-		out.String(string(in.ProfileDescription))
-	}
-	{
-		const prefix string = ",\"lastMessage\":"
-		out.RawString(prefix)
-		out.String(string(in.LastMessage))
-	}
-	{
-		const prefix string = ",\"isRead\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsRead))
-	}
-	{
-		const prefix string = ",\"isSelf\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsSelf))
+		out.String(string(in.Reason))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Chat) MarshalJSON() ([]byte, error) {
+func (v BlockRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel110(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Chat) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel53(w, v)
+func (v BlockRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel110(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Chat) UnmarshalJSON(data []byte) error {
+func (v *BlockRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel110(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Chat) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel53(l, v)
+func (v *BlockRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel110(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.Lexer, out *ChangeBorderRequest) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel111(in *jlexer.Lexer, out *AutocompleteResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5220,8 +10906,29 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.L
 			continue
 		}
 		switch key {
-		case "new_border":
-			out.NewBorder = int(in.Int())
+		case "suggestions":
+			if in.IsNull() {
+				in.Skip()
+				out.Suggestions = nil
+			} else {
+				in.Delim('[')
+				if out.Suggestions == nil {
+					if !in.IsDelim(']') {
+						out.Suggestions = make([]Suggestion, 0, 1)
+					} else {
+						out.Suggestions = []Suggestion{}
+					}
+				} else {
+					out.Suggestions = (out.Suggestions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v136 Suggestion
+					(v136).UnmarshalEasyJSON(in)
+					out.Suggestions = append(out.Suggestions, v136)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5232,42 +10939,53 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(out *jwriter.Writer, in ChangeBorderRequest) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel111(out *jwriter.Writer, in AutocompleteResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"new_border\":"
+		const prefix string = ",\"suggestions\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.NewBorder))
+		if in.Suggestions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v137, v138 := range in.Suggestions {
+				if v137 > 0 {
+					out.RawByte(',')
+				}
+				(v138).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ChangeBorderRequest) MarshalJSON() ([]byte, error) {
+func (v AutocompleteResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel111(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ChangeBorderRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel54(w, v)
+func (v AutocompleteResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel111(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ChangeBorderRequest) UnmarshalJSON(data []byte) error {
+func (v *AutocompleteResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel111(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ChangeBorderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel54(l, v)
+func (v *AutocompleteResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel111(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.Lexer, out *AnswersResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel112(in *jlexer.Lexer, out *AnswersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5302,9 +11020,9 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.L
 					out.Answers = (out.Answers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v55 UsersForQuery
-					(v55).UnmarshalEasyJSON(in)
-					out.Answers = append(out.Answers, v55)
+					var v139 UsersForQuery
+					(v139).UnmarshalEasyJSON(in)
+					out.Answers = append(out.Answers, v139)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5319,7 +11037,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter.Writer, in AnswersResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel112(out *jwriter.Writer, in AnswersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5330,11 +11048,11 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v56, v57 := range in.Answers {
-				if v56 > 0 {
+			for v140, v141 := range in.Answers {
+				if v140 > 0 {
 					out.RawByte(',')
 				}
-				(v57).MarshalEasyJSON(out)
+				(v141).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5345,27 +11063,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AnswersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel112(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AnswersResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel55(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel112(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AnswersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel112(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AnswersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel55(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel112(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.Lexer, out *AnswersForResponse) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel113(in *jlexer.Lexer, out *AnswersForResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5400,9 +11118,9 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.L
 					out.Answers = (out.Answers)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 AnswersForQuery
-					(v58).UnmarshalEasyJSON(in)
-					out.Answers = append(out.Answers, v58)
+					var v142 AnswersForQuery
+					(v142).UnmarshalEasyJSON(in)
+					out.Answers = append(out.Answers, v142)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5417,7 +11135,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter.Writer, in AnswersForResponse) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel113(out *jwriter.Writer, in AnswersForResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5428,11 +11146,11 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v59, v60 := range in.Answers {
-				if v59 > 0 {
+			for v143, v144 := range in.Answers {
+				if v143 > 0 {
 					out.RawByte(',')
 				}
-				(v60).MarshalEasyJSON(out)
+				(v144).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5443,27 +11161,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AnswersForResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel113(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AnswersForResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel56(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel113(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AnswersForResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel113(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AnswersForResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel56(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel113(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(in *jlexer.Lexer, out *AnswersForQuery) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel114(in *jlexer.Lexer, out *AnswersForQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5508,7 +11226,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(out *jwriter.Writer, in AnswersForQuery) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel114(out *jwriter.Writer, in AnswersForQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5558,27 +11276,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AnswersForQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel114(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AnswersForQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel57(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel114(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AnswersForQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel114(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AnswersForQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel57(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel114(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(in *jlexer.Lexer, out *AddSubRequet) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel115(in *jlexer.Lexer, out *AddSubRequet) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5609,7 +11327,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(in *jlexer.L
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(out *jwriter.Writer, in AddSubRequet) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel115(out *jwriter.Writer, in AddSubRequet) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5624,23 +11342,96 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(out *jwriter
 // MarshalJSON supports json.Marshaler interface
 func (v AddSubRequet) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel115(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddSubRequet) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel58(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel115(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddSubRequet) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel115(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddSubRequet) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel58(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel115(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel116(in *jlexer.Lexer, out *ActivateBoostResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "boost":
+			(out.Boost).UnmarshalEasyJSON(in)
+		case "remaining":
+			out.Remaining = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel116(out *jwriter.Writer, in ActivateBoostResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"boost\":"
+		out.RawString(prefix[1:])
+		(in.Boost).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"remaining\":"
+		out.RawString(prefix)
+		out.Int(int(in.Remaining))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActivateBoostResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel116(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActivateBoostResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20251ProVVebModel116(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActivateBoostResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel116(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActivateBoostResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20251ProVVebModel116(l, v)
 }
@@ -0,0 +1,38 @@
+package profiles
+
+import "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+
+// FilterToProto and FilterFromProto map search filter trees to and from
+// the wire format. The gateway and the service both use them, so a new
+// filter field only has to be added here.
+func FilterToProto(expr *model.FilterExpr) *FilterExpr {
+	if expr == nil {
+		return nil
+	}
+
+	res := &FilterExpr{
+		Op:          expr.Op,
+		Description: expr.Description,
+		Value:       expr.Value,
+	}
+	for i := range expr.Children {
+		res.Children = append(res.Children, FilterToProto(&expr.Children[i]))
+	}
+	return res
+}
+
+func FilterFromProto(expr *FilterExpr) *model.FilterExpr {
+	if expr == nil {
+		return nil
+	}
+
+	res := &model.FilterExpr{
+		Op:          expr.GetOp(),
+		Description: expr.GetDescription(),
+		Value:       expr.GetValue(),
+	}
+	for _, child := range expr.GetChildren() {
+		res.Children = append(res.Children, *FilterFromProto(child))
+	}
+	return res
+}
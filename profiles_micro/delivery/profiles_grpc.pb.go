@@ -25,13 +25,47 @@ type ProfilesServiceClient interface {
 	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProfiles(ctx context.Context, in *GetProfilesRequest, opts ...grpc.CallOption) (*GetProfilesResponse, error)
 	GetProfileImages(ctx context.Context, in *GetProfileImagesRequest, opts ...grpc.CallOption) (*GetProfileImagesResponse, error)
-	UploadProfileImage(ctx context.Context, in *UploadProfileImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UploadProfileImage(ctx context.Context, in *UploadProfileImageRequest, opts ...grpc.CallOption) (*UploadProfileImageResponse, error)
 	DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreatePhotoUpload(ctx context.Context, in *CreatePhotoUploadRequest, opts ...grpc.CallOption) (*PhotoUpload, error)
+	FinalizePhotoUpload(ctx context.Context, in *FinalizePhotoUploadRequest, opts ...grpc.CallOption) (*UploadProfileImageResponse, error)
+	GetPhotoURLs(ctx context.Context, in *GetProfileImagesRequest, opts ...grpc.CallOption) (*GetPhotoURLsResponse, error)
+	ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetPrimaryPhoto(ctx context.Context, in *SetPrimaryPhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*GetModerationQueueResponse, error)
+	ModeratePhoto(ctx context.Context, in *ModeratePhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPhotoClusters(ctx context.Context, in *GetPhotoClustersRequest, opts ...grpc.CallOption) (*GetPhotoClustersResponse, error)
+	StartVerification(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationChallenge, error)
+	SubmitVerification(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationStatus, error)
+	GetVerification(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationStatus, error)
+	GetVerificationQueue(ctx context.Context, in *GetVerificationQueueRequest, opts ...grpc.CallOption) (*GetVerificationQueueResponse, error)
+	ReviewVerification(ctx context.Context, in *ReviewVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPrompts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPromptsResponse, error)
+	GetProfileHistory(ctx context.Context, in *GetProfileHistoryRequest, opts ...grpc.CallOption) (*GetProfileHistoryResponse, error)
+	RevertProfile(ctx context.Context, in *RevertProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Unmatch(ctx context.Context, in *UnmatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BlockProfile(ctx context.Context, in *BlockProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnblockProfile(ctx context.Context, in *UnblockProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (*GetBlocksResponse, error)
+	CollectMatchExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectMatchExpiryResponse, error)
+	ExtendMatch(ctx context.Context, in *ExtendMatchRequest, opts ...grpc.CallOption) (*MatchExpiry, error)
+	GetPendingMatches(ctx context.Context, in *GetPendingMatchesRequest, opts ...grpc.CallOption) (*GetPendingMatchesResponse, error)
+	GetSecondLook(ctx context.Context, in *GetSecondLookRequest, opts ...grpc.CallOption) (*SecondLook, error)
+	SetSecondLook(ctx context.Context, in *SetSecondLookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetProfileMatches(ctx context.Context, in *GetProfileMatchesRequest, opts ...grpc.CallOption) (*GetProfileMatchesResponse, error)
 	SetProfileLike(ctx context.Context, in *SetProfileLikeRequest, opts ...grpc.CallOption) (*SetProfileLikeResponse, error)
+	RewindLike(ctx context.Context, in *RewindLikeRequest, opts ...grpc.CallOption) (*RewindLikeResponse, error)
 	SearchProfile(ctx context.Context, in *SearchProfileRequest, opts ...grpc.CallOption) (*SearchProfileResponse, error)
 	GetProfileStats(ctx context.Context, in *GetProfileStatsRequest, opts ...grpc.CallOption) (*GetProfileStatsResponse, error)
 	GetRecommendations(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
+	GetTopPicks(ctx context.Context, in *GetTopPicksRequest, opts ...grpc.CallOption) (*GetTopPicksResponse, error)
+	GetIncomingLikes(ctx context.Context, in *GetIncomingLikesRequest, opts ...grpc.CallOption) (*GetIncomingLikesResponse, error)
+	ActivateBoost(ctx context.Context, in *ActivateBoostRequest, opts ...grpc.CallOption) (*ActivateBoostResponse, error)
+	SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	GetSavedSearches(ctx context.Context, in *GetSavedSearchesRequest, opts ...grpc.CallOption) (*GetSavedSearchesResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CollectSearchAlerts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectSearchAlertsResponse, error)
+	Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error)
 }
 
 type profilesServiceClient struct {
@@ -96,8 +130,8 @@ func (c *profilesServiceClient) GetProfileImages(ctx context.Context, in *GetPro
 	return out, nil
 }
 
-func (c *profilesServiceClient) UploadProfileImage(ctx context.Context, in *UploadProfileImageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *profilesServiceClient) UploadProfileImage(ctx context.Context, in *UploadProfileImageRequest, opts ...grpc.CallOption) (*UploadProfileImageResponse, error) {
+	out := new(UploadProfileImageResponse)
 	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/UploadProfileImage", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -114,6 +148,231 @@ func (c *profilesServiceClient) DeleteImage(ctx context.Context, in *DeleteImage
 	return out, nil
 }
 
+func (c *profilesServiceClient) CreatePhotoUpload(ctx context.Context, in *CreatePhotoUploadRequest, opts ...grpc.CallOption) (*PhotoUpload, error) {
+	out := new(PhotoUpload)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/CreatePhotoUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) FinalizePhotoUpload(ctx context.Context, in *FinalizePhotoUploadRequest, opts ...grpc.CallOption) (*UploadProfileImageResponse, error) {
+	out := new(UploadProfileImageResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/FinalizePhotoUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetPhotoURLs(ctx context.Context, in *GetProfileImagesRequest, opts ...grpc.CallOption) (*GetPhotoURLsResponse, error) {
+	out := new(GetPhotoURLsResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetPhotoURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/ReorderPhotos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) SetPrimaryPhoto(ctx context.Context, in *SetPrimaryPhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SetPrimaryPhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*GetModerationQueueResponse, error) {
+	out := new(GetModerationQueueResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetModerationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) ModeratePhoto(ctx context.Context, in *ModeratePhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/ModeratePhoto", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetPhotoClusters(ctx context.Context, in *GetPhotoClustersRequest, opts ...grpc.CallOption) (*GetPhotoClustersResponse, error) {
+	out := new(GetPhotoClustersResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetPhotoClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) StartVerification(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationChallenge, error) {
+	out := new(VerificationChallenge)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/StartVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) SubmitVerification(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationStatus, error) {
+	out := new(VerificationStatus)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SubmitVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetVerification(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationStatus, error) {
+	out := new(VerificationStatus)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetVerificationQueue(ctx context.Context, in *GetVerificationQueueRequest, opts ...grpc.CallOption) (*GetVerificationQueueResponse, error) {
+	out := new(GetVerificationQueueResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetVerificationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) ReviewVerification(ctx context.Context, in *ReviewVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/ReviewVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetPrompts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPromptsResponse, error) {
+	out := new(GetPromptsResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetPrompts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetProfileHistory(ctx context.Context, in *GetProfileHistoryRequest, opts ...grpc.CallOption) (*GetProfileHistoryResponse, error) {
+	out := new(GetProfileHistoryResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetProfileHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) RevertProfile(ctx context.Context, in *RevertProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/RevertProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) Unmatch(ctx context.Context, in *UnmatchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/Unmatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) BlockProfile(ctx context.Context, in *BlockProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/BlockProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) UnblockProfile(ctx context.Context, in *UnblockProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/UnblockProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (*GetBlocksResponse, error) {
+	out := new(GetBlocksResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) CollectMatchExpiry(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectMatchExpiryResponse, error) {
+	out := new(CollectMatchExpiryResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/CollectMatchExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) ExtendMatch(ctx context.Context, in *ExtendMatchRequest, opts ...grpc.CallOption) (*MatchExpiry, error) {
+	out := new(MatchExpiry)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/ExtendMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetPendingMatches(ctx context.Context, in *GetPendingMatchesRequest, opts ...grpc.CallOption) (*GetPendingMatchesResponse, error) {
+	out := new(GetPendingMatchesResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetPendingMatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetSecondLook(ctx context.Context, in *GetSecondLookRequest, opts ...grpc.CallOption) (*SecondLook, error) {
+	out := new(SecondLook)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetSecondLook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) SetSecondLook(ctx context.Context, in *SetSecondLookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SetSecondLook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profilesServiceClient) GetProfileMatches(ctx context.Context, in *GetProfileMatchesRequest, opts ...grpc.CallOption) (*GetProfileMatchesResponse, error) {
 	out := new(GetProfileMatchesResponse)
 	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetProfileMatches", in, out, opts...)
@@ -132,6 +391,15 @@ func (c *profilesServiceClient) SetProfileLike(ctx context.Context, in *SetProfi
 	return out, nil
 }
 
+func (c *profilesServiceClient) RewindLike(ctx context.Context, in *RewindLikeRequest, opts ...grpc.CallOption) (*RewindLikeResponse, error) {
+	out := new(RewindLikeResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/RewindLike", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profilesServiceClient) SearchProfile(ctx context.Context, in *SearchProfileRequest, opts ...grpc.CallOption) (*SearchProfileResponse, error) {
 	out := new(SearchProfileResponse)
 	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SearchProfile", in, out, opts...)
@@ -159,6 +427,78 @@ func (c *profilesServiceClient) GetRecommendations(ctx context.Context, in *GetP
 	return out, nil
 }
 
+func (c *profilesServiceClient) GetTopPicks(ctx context.Context, in *GetTopPicksRequest, opts ...grpc.CallOption) (*GetTopPicksResponse, error) {
+	out := new(GetTopPicksResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetTopPicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetIncomingLikes(ctx context.Context, in *GetIncomingLikesRequest, opts ...grpc.CallOption) (*GetIncomingLikesResponse, error) {
+	out := new(GetIncomingLikesResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetIncomingLikes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) ActivateBoost(ctx context.Context, in *ActivateBoostRequest, opts ...grpc.CallOption) (*ActivateBoostResponse, error) {
+	out := new(ActivateBoostResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/ActivateBoost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/SaveSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) GetSavedSearches(ctx context.Context, in *GetSavedSearchesRequest, opts ...grpc.CallOption) (*GetSavedSearchesResponse, error) {
+	out := new(GetSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/GetSavedSearches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/DeleteSavedSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) CollectSearchAlerts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectSearchAlertsResponse, error) {
+	out := new(CollectSearchAlertsResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/CollectSearchAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profilesServiceClient) Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error) {
+	out := new(AutocompleteResponse)
+	err := c.cc.Invoke(ctx, "/profiles.ProfilesService/Autocomplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfilesServiceServer is the server API for ProfilesService service.
 // All implementations must embed UnimplementedProfilesServiceServer
 // for forward compatibility
@@ -169,13 +509,47 @@ type ProfilesServiceServer interface {
 	DeleteProfile(context.Context, *DeleteProfileRequest) (*emptypb.Empty, error)
 	GetProfiles(context.Context, *GetProfilesRequest) (*GetProfilesResponse, error)
 	GetProfileImages(context.Context, *GetProfileImagesRequest) (*GetProfileImagesResponse, error)
-	UploadProfileImage(context.Context, *UploadProfileImageRequest) (*emptypb.Empty, error)
+	UploadProfileImage(context.Context, *UploadProfileImageRequest) (*UploadProfileImageResponse, error)
 	DeleteImage(context.Context, *DeleteImageRequest) (*emptypb.Empty, error)
+	CreatePhotoUpload(context.Context, *CreatePhotoUploadRequest) (*PhotoUpload, error)
+	FinalizePhotoUpload(context.Context, *FinalizePhotoUploadRequest) (*UploadProfileImageResponse, error)
+	GetPhotoURLs(context.Context, *GetProfileImagesRequest) (*GetPhotoURLsResponse, error)
+	ReorderPhotos(context.Context, *ReorderPhotosRequest) (*emptypb.Empty, error)
+	SetPrimaryPhoto(context.Context, *SetPrimaryPhotoRequest) (*emptypb.Empty, error)
+	GetModerationQueue(context.Context, *GetModerationQueueRequest) (*GetModerationQueueResponse, error)
+	ModeratePhoto(context.Context, *ModeratePhotoRequest) (*emptypb.Empty, error)
+	GetPhotoClusters(context.Context, *GetPhotoClustersRequest) (*GetPhotoClustersResponse, error)
+	StartVerification(context.Context, *VerificationRequest) (*VerificationChallenge, error)
+	SubmitVerification(context.Context, *VerificationRequest) (*VerificationStatus, error)
+	GetVerification(context.Context, *VerificationRequest) (*VerificationStatus, error)
+	GetVerificationQueue(context.Context, *GetVerificationQueueRequest) (*GetVerificationQueueResponse, error)
+	ReviewVerification(context.Context, *ReviewVerificationRequest) (*emptypb.Empty, error)
+	GetPrompts(context.Context, *emptypb.Empty) (*GetPromptsResponse, error)
+	GetProfileHistory(context.Context, *GetProfileHistoryRequest) (*GetProfileHistoryResponse, error)
+	RevertProfile(context.Context, *RevertProfileRequest) (*emptypb.Empty, error)
+	Unmatch(context.Context, *UnmatchRequest) (*emptypb.Empty, error)
+	BlockProfile(context.Context, *BlockProfileRequest) (*emptypb.Empty, error)
+	UnblockProfile(context.Context, *UnblockProfileRequest) (*emptypb.Empty, error)
+	GetBlocks(context.Context, *GetBlocksRequest) (*GetBlocksResponse, error)
+	CollectMatchExpiry(context.Context, *emptypb.Empty) (*CollectMatchExpiryResponse, error)
+	ExtendMatch(context.Context, *ExtendMatchRequest) (*MatchExpiry, error)
+	GetPendingMatches(context.Context, *GetPendingMatchesRequest) (*GetPendingMatchesResponse, error)
+	GetSecondLook(context.Context, *GetSecondLookRequest) (*SecondLook, error)
+	SetSecondLook(context.Context, *SetSecondLookRequest) (*emptypb.Empty, error)
 	GetProfileMatches(context.Context, *GetProfileMatchesRequest) (*GetProfileMatchesResponse, error)
 	SetProfileLike(context.Context, *SetProfileLikeRequest) (*SetProfileLikeResponse, error)
+	RewindLike(context.Context, *RewindLikeRequest) (*RewindLikeResponse, error)
 	SearchProfile(context.Context, *SearchProfileRequest) (*SearchProfileResponse, error)
 	GetProfileStats(context.Context, *GetProfileStatsRequest) (*GetProfileStatsResponse, error)
 	GetRecommendations(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	GetTopPicks(context.Context, *GetTopPicksRequest) (*GetTopPicksResponse, error)
+	GetIncomingLikes(context.Context, *GetIncomingLikesRequest) (*GetIncomingLikesResponse, error)
+	ActivateBoost(context.Context, *ActivateBoostRequest) (*ActivateBoostResponse, error)
+	SaveSearch(context.Context, *SaveSearchRequest) (*SavedSearch, error)
+	GetSavedSearches(context.Context, *GetSavedSearchesRequest) (*GetSavedSearchesResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error)
+	CollectSearchAlerts(context.Context, *emptypb.Empty) (*CollectSearchAlertsResponse, error)
+	Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error)
 	mustEmbedUnimplementedProfilesServiceServer()
 }
 
@@ -201,18 +575,96 @@ func (UnimplementedProfilesServiceServer) GetProfiles(context.Context, *GetProfi
 func (UnimplementedProfilesServiceServer) GetProfileImages(context.Context, *GetProfileImagesRequest) (*GetProfileImagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfileImages not implemented")
 }
-func (UnimplementedProfilesServiceServer) UploadProfileImage(context.Context, *UploadProfileImageRequest) (*emptypb.Empty, error) {
+func (UnimplementedProfilesServiceServer) UploadProfileImage(context.Context, *UploadProfileImageRequest) (*UploadProfileImageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadProfileImage not implemented")
 }
 func (UnimplementedProfilesServiceServer) DeleteImage(context.Context, *DeleteImageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
 }
+func (UnimplementedProfilesServiceServer) CreatePhotoUpload(context.Context, *CreatePhotoUploadRequest) (*PhotoUpload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePhotoUpload not implemented")
+}
+func (UnimplementedProfilesServiceServer) FinalizePhotoUpload(context.Context, *FinalizePhotoUploadRequest) (*UploadProfileImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePhotoUpload not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetPhotoURLs(context.Context, *GetProfileImagesRequest) (*GetPhotoURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPhotoURLs not implemented")
+}
+func (UnimplementedProfilesServiceServer) ReorderPhotos(context.Context, *ReorderPhotosRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPhotos not implemented")
+}
+func (UnimplementedProfilesServiceServer) SetPrimaryPhoto(context.Context, *SetPrimaryPhotoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryPhoto not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetModerationQueue(context.Context, *GetModerationQueueRequest) (*GetModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationQueue not implemented")
+}
+func (UnimplementedProfilesServiceServer) ModeratePhoto(context.Context, *ModeratePhotoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratePhoto not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetPhotoClusters(context.Context, *GetPhotoClustersRequest) (*GetPhotoClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPhotoClusters not implemented")
+}
+func (UnimplementedProfilesServiceServer) StartVerification(context.Context, *VerificationRequest) (*VerificationChallenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVerification not implemented")
+}
+func (UnimplementedProfilesServiceServer) SubmitVerification(context.Context, *VerificationRequest) (*VerificationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVerification not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetVerification(context.Context, *VerificationRequest) (*VerificationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerification not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetVerificationQueue(context.Context, *GetVerificationQueueRequest) (*GetVerificationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationQueue not implemented")
+}
+func (UnimplementedProfilesServiceServer) ReviewVerification(context.Context, *ReviewVerificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewVerification not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetPrompts(context.Context, *emptypb.Empty) (*GetPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrompts not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetProfileHistory(context.Context, *GetProfileHistoryRequest) (*GetProfileHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileHistory not implemented")
+}
+func (UnimplementedProfilesServiceServer) RevertProfile(context.Context, *RevertProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertProfile not implemented")
+}
+func (UnimplementedProfilesServiceServer) Unmatch(context.Context, *UnmatchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmatch not implemented")
+}
+func (UnimplementedProfilesServiceServer) BlockProfile(context.Context, *BlockProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProfile not implemented")
+}
+func (UnimplementedProfilesServiceServer) UnblockProfile(context.Context, *UnblockProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockProfile not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetBlocks(context.Context, *GetBlocksRequest) (*GetBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlocks not implemented")
+}
+func (UnimplementedProfilesServiceServer) CollectMatchExpiry(context.Context, *emptypb.Empty) (*CollectMatchExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectMatchExpiry not implemented")
+}
+func (UnimplementedProfilesServiceServer) ExtendMatch(context.Context, *ExtendMatchRequest) (*MatchExpiry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendMatch not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetPendingMatches(context.Context, *GetPendingMatchesRequest) (*GetPendingMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingMatches not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetSecondLook(context.Context, *GetSecondLookRequest) (*SecondLook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecondLook not implemented")
+}
+func (UnimplementedProfilesServiceServer) SetSecondLook(context.Context, *SetSecondLookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecondLook not implemented")
+}
 func (UnimplementedProfilesServiceServer) GetProfileMatches(context.Context, *GetProfileMatchesRequest) (*GetProfileMatchesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfileMatches not implemented")
 }
 func (UnimplementedProfilesServiceServer) SetProfileLike(context.Context, *SetProfileLikeRequest) (*SetProfileLikeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProfileLike not implemented")
 }
+func (UnimplementedProfilesServiceServer) RewindLike(context.Context, *RewindLikeRequest) (*RewindLikeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewindLike not implemented")
+}
 func (UnimplementedProfilesServiceServer) SearchProfile(context.Context, *SearchProfileRequest) (*SearchProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchProfile not implemented")
 }
@@ -222,6 +674,30 @@ func (UnimplementedProfilesServiceServer) GetProfileStats(context.Context, *GetP
 func (UnimplementedProfilesServiceServer) GetRecommendations(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
 }
+func (UnimplementedProfilesServiceServer) GetTopPicks(context.Context, *GetTopPicksRequest) (*GetTopPicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopPicks not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetIncomingLikes(context.Context, *GetIncomingLikesRequest) (*GetIncomingLikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomingLikes not implemented")
+}
+func (UnimplementedProfilesServiceServer) ActivateBoost(context.Context, *ActivateBoostRequest) (*ActivateBoostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateBoost not implemented")
+}
+func (UnimplementedProfilesServiceServer) SaveSearch(context.Context, *SaveSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSearch not implemented")
+}
+func (UnimplementedProfilesServiceServer) GetSavedSearches(context.Context, *GetSavedSearchesRequest) (*GetSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearches not implemented")
+}
+func (UnimplementedProfilesServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedProfilesServiceServer) CollectSearchAlerts(context.Context, *emptypb.Empty) (*CollectSearchAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectSearchAlerts not implemented")
+}
+func (UnimplementedProfilesServiceServer) Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Autocomplete not implemented")
+}
 func (UnimplementedProfilesServiceServer) mustEmbedUnimplementedProfilesServiceServer() {}
 
 // UnsafeProfilesServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -379,26 +855,476 @@ func _ProfilesService_DeleteImage_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfilesService_GetProfileMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileMatchesRequest)
+func _ProfilesService_CreatePhotoUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePhotoUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProfilesServiceServer).GetProfileMatches(ctx, in)
+		return srv.(ProfilesServiceServer).CreatePhotoUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/profiles.ProfilesService/GetProfileMatches",
+		FullMethod: "/profiles.ProfilesService/CreatePhotoUpload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProfilesServiceServer).GetProfileMatches(ctx, req.(*GetProfileMatchesRequest))
+		return srv.(ProfilesServiceServer).CreatePhotoUpload(ctx, req.(*CreatePhotoUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProfilesService_SetProfileLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetProfileLikeRequest)
+func _ProfilesService_FinalizePhotoUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePhotoUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).FinalizePhotoUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/FinalizePhotoUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).FinalizePhotoUpload(ctx, req.(*FinalizePhotoUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetPhotoURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetPhotoURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetPhotoURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetPhotoURLs(ctx, req.(*GetProfileImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_ReorderPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).ReorderPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/ReorderPhotos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).ReorderPhotos(ctx, req.(*ReorderPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_SetPrimaryPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).SetPrimaryPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/SetPrimaryPhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).SetPrimaryPhoto(ctx, req.(*SetPrimaryPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetModerationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetModerationQueue(ctx, req.(*GetModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_ModeratePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModeratePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).ModeratePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/ModeratePhoto",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).ModeratePhoto(ctx, req.(*ModeratePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetPhotoClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPhotoClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetPhotoClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetPhotoClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetPhotoClusters(ctx, req.(*GetPhotoClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_StartVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).StartVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/StartVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).StartVerification(ctx, req.(*VerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_SubmitVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).SubmitVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/SubmitVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).SubmitVerification(ctx, req.(*VerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetVerification(ctx, req.(*VerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetVerificationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerificationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetVerificationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetVerificationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetVerificationQueue(ctx, req.(*GetVerificationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_ReviewVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).ReviewVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/ReviewVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).ReviewVerification(ctx, req.(*ReviewVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetPrompts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetPrompts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetProfileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetProfileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetProfileHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetProfileHistory(ctx, req.(*GetProfileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_RevertProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).RevertProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/RevertProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).RevertProfile(ctx, req.(*RevertProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_Unmatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).Unmatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/Unmatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).Unmatch(ctx, req.(*UnmatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_BlockProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).BlockProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/BlockProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).BlockProfile(ctx, req.(*BlockProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_UnblockProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).UnblockProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/UnblockProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).UnblockProfile(ctx, req.(*UnblockProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetBlocks(ctx, req.(*GetBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_CollectMatchExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).CollectMatchExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/CollectMatchExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).CollectMatchExpiry(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_ExtendMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).ExtendMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/ExtendMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).ExtendMatch(ctx, req.(*ExtendMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetPendingMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetPendingMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetPendingMatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetPendingMatches(ctx, req.(*GetPendingMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetSecondLook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecondLookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetSecondLook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetSecondLook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetSecondLook(ctx, req.(*GetSecondLookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_SetSecondLook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecondLookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).SetSecondLook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/SetSecondLook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).SetSecondLook(ctx, req.(*SetSecondLookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetProfileMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetProfileMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetProfileMatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetProfileMatches(ctx, req.(*GetProfileMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_SetProfileLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileLikeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -415,6 +1341,24 @@ func _ProfilesService_SetProfileLike_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfilesService_RewindLike_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewindLikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).RewindLike(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/RewindLike",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).RewindLike(ctx, req.(*RewindLikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProfilesService_SearchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchProfileRequest)
 	if err := dec(in); err != nil {
@@ -469,6 +1413,150 @@ func _ProfilesService_GetRecommendations_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfilesService_GetTopPicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopPicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetTopPicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetTopPicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetTopPicks(ctx, req.(*GetTopPicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetIncomingLikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncomingLikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetIncomingLikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetIncomingLikes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetIncomingLikes(ctx, req.(*GetIncomingLikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_ActivateBoost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateBoostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).ActivateBoost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/ActivateBoost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).ActivateBoost(ctx, req.(*ActivateBoostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_SaveSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).SaveSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/SaveSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).SaveSearch(ctx, req.(*SaveSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_GetSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).GetSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/GetSavedSearches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).GetSavedSearches(ctx, req.(*GetSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/DeleteSavedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_CollectSearchAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).CollectSearchAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/CollectSearchAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).CollectSearchAlerts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfilesService_Autocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServiceServer).Autocomplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profiles.ProfilesService/Autocomplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServiceServer).Autocomplete(ctx, req.(*AutocompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfilesService_ServiceDesc is the grpc.ServiceDesc for ProfilesService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -508,6 +1596,106 @@ var ProfilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteImage",
 			Handler:    _ProfilesService_DeleteImage_Handler,
 		},
+		{
+			MethodName: "CreatePhotoUpload",
+			Handler:    _ProfilesService_CreatePhotoUpload_Handler,
+		},
+		{
+			MethodName: "FinalizePhotoUpload",
+			Handler:    _ProfilesService_FinalizePhotoUpload_Handler,
+		},
+		{
+			MethodName: "GetPhotoURLs",
+			Handler:    _ProfilesService_GetPhotoURLs_Handler,
+		},
+		{
+			MethodName: "ReorderPhotos",
+			Handler:    _ProfilesService_ReorderPhotos_Handler,
+		},
+		{
+			MethodName: "SetPrimaryPhoto",
+			Handler:    _ProfilesService_SetPrimaryPhoto_Handler,
+		},
+		{
+			MethodName: "GetModerationQueue",
+			Handler:    _ProfilesService_GetModerationQueue_Handler,
+		},
+		{
+			MethodName: "ModeratePhoto",
+			Handler:    _ProfilesService_ModeratePhoto_Handler,
+		},
+		{
+			MethodName: "GetPhotoClusters",
+			Handler:    _ProfilesService_GetPhotoClusters_Handler,
+		},
+		{
+			MethodName: "StartVerification",
+			Handler:    _ProfilesService_StartVerification_Handler,
+		},
+		{
+			MethodName: "SubmitVerification",
+			Handler:    _ProfilesService_SubmitVerification_Handler,
+		},
+		{
+			MethodName: "GetVerification",
+			Handler:    _ProfilesService_GetVerification_Handler,
+		},
+		{
+			MethodName: "GetVerificationQueue",
+			Handler:    _ProfilesService_GetVerificationQueue_Handler,
+		},
+		{
+			MethodName: "ReviewVerification",
+			Handler:    _ProfilesService_ReviewVerification_Handler,
+		},
+		{
+			MethodName: "GetPrompts",
+			Handler:    _ProfilesService_GetPrompts_Handler,
+		},
+		{
+			MethodName: "GetProfileHistory",
+			Handler:    _ProfilesService_GetProfileHistory_Handler,
+		},
+		{
+			MethodName: "RevertProfile",
+			Handler:    _ProfilesService_RevertProfile_Handler,
+		},
+		{
+			MethodName: "Unmatch",
+			Handler:    _ProfilesService_Unmatch_Handler,
+		},
+		{
+			MethodName: "BlockProfile",
+			Handler:    _ProfilesService_BlockProfile_Handler,
+		},
+		{
+			MethodName: "UnblockProfile",
+			Handler:    _ProfilesService_UnblockProfile_Handler,
+		},
+		{
+			MethodName: "GetBlocks",
+			Handler:    _ProfilesService_GetBlocks_Handler,
+		},
+		{
+			MethodName: "CollectMatchExpiry",
+			Handler:    _ProfilesService_CollectMatchExpiry_Handler,
+		},
+		{
+			MethodName: "ExtendMatch",
+			Handler:    _ProfilesService_ExtendMatch_Handler,
+		},
+		{
+			MethodName: "GetPendingMatches",
+			Handler:    _ProfilesService_GetPendingMatches_Handler,
+		},
+		{
+			MethodName: "GetSecondLook",
+			Handler:    _ProfilesService_GetSecondLook_Handler,
+		},
+		{
+			MethodName: "SetSecondLook",
+			Handler:    _ProfilesService_SetSecondLook_Handler,
+		},
 		{
 			MethodName: "GetProfileMatches",
 			Handler:    _ProfilesService_GetProfileMatches_Handler,
@@ -516,6 +1704,10 @@ var ProfilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProfileLike",
 			Handler:    _ProfilesService_SetProfileLike_Handler,
 		},
+		{
+			MethodName: "RewindLike",
+			Handler:    _ProfilesService_RewindLike_Handler,
+		},
 		{
 			MethodName: "SearchProfile",
 			Handler:    _ProfilesService_SearchProfile_Handler,
@@ -528,6 +1720,38 @@ var ProfilesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecommendations",
 			Handler:    _ProfilesService_GetRecommendations_Handler,
 		},
+		{
+			MethodName: "GetTopPicks",
+			Handler:    _ProfilesService_GetTopPicks_Handler,
+		},
+		{
+			MethodName: "GetIncomingLikes",
+			Handler:    _ProfilesService_GetIncomingLikes_Handler,
+		},
+		{
+			MethodName: "ActivateBoost",
+			Handler:    _ProfilesService_ActivateBoost_Handler,
+		},
+		{
+			MethodName: "SaveSearch",
+			Handler:    _ProfilesService_SaveSearch_Handler,
+		},
+		{
+			MethodName: "GetSavedSearches",
+			Handler:    _ProfilesService_GetSavedSearches_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _ProfilesService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "CollectSearchAlerts",
+			Handler:    _ProfilesService_CollectSearchAlerts_Handler,
+		},
+		{
+			MethodName: "Autocomplete",
+			Handler:    _ProfilesService_Autocomplete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "profiles.proto",
@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
 	impl "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/usecase"
 	"google.golang.org/grpc"
@@ -18,6 +23,16 @@ func main() {
 		return
 	}
 
+	if limit, err := strconv.Atoi(os.Getenv("MAX_PROFILE_PHOTOS")); err == nil && limit > 0 {
+		model.MaxPhotosPerProfile = limit
+	}
+	if ttl, err := time.ParseDuration(os.Getenv("MATCH_TTL")); err == nil && ttl > 0 {
+		model.MatchTTL = ttl
+	}
+	if ttl, err := time.ParseDuration(os.Getenv("DISLIKE_TTL")); err == nil && ttl > 0 {
+		model.DislikeTTL = ttl
+	}
+
 	postgresClient, err := profiles.NewUserRepo()
 	if err != nil {
 		fmt.Println(fmt.Errorf("not able to work with postgresClient: %v", err))
@@ -47,6 +62,12 @@ func main() {
 
 	profilespb.RegisterProfilesServiceServer(server, profilesService)
 
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+	go profilesService.RunTopPicksJob(jobCtx, time.Hour)
+	go profilesService.RunPhotoHashJob(jobCtx, time.Hour)
+	go profilesService.RunLikeRequestsCleanupJob(jobCtx, time.Hour)
+
 	fmt.Println("starting server at :8083")
 	server.Serve(listener)
 }
@@ -1,12 +1,48 @@
 package model
 
 import (
+	"encoding/json"
 	"errors"
 	"time"
 )
 
 var PageSize = 10
 var SearchLimit = 20
+var MaxSearchPageSize = 50
+var SearchFacetLimit = 10
+var MaxFilterDepth = 6
+var MaxFilterNodes = 32
+var MaxSavedSearches = 10
+var SearchAlertHitsLimit = 20
+var SearchAlertBatch = 100
+var TopPicksLimit = 10
+var AutocompleteLimit = 10
+var MaxPhotoPixels = 40_000_000
+var MaxPhotoUploadSize int64 = 10 << 20
+var MaxPhotosPerProfile = 6
+var MinPhotoSide = 320
+var BlankPhotoStdDev = 6.0
+var DuplicatePhotoDistance = 6
+var ModerationQueueLimit = 50
+var StolenPhotoDistance = 4
+var PhotoClusterPairsLimit = 500
+var PhotoHashBatch = 100
+var PhotoUploadURLTTL = 15 * time.Minute
+var PhotoDownloadURLTTL = time.Hour
+var VerificationChallengeTTL = 10 * time.Minute
+var VerificationQueueLimit = 50
+var MaxAutocompleteLimit = 25
+var MaxProfilePrompts = 3
+var MaxPromptAnswerLength = 300
+var ProfileHistoryLimit = 50
+
+var BoostDuration = 30 * time.Minute
+var BoostsPerDeck = 3
+
+// A dislike expires DislikeTTL after it was made. Expired dislikes fill the
+// deck once it runs out of fresh profiles, unless the user opted out.
+var DislikeTTL = 30 * 24 * time.Hour
+var BoostStatsLimit = 20
 
 type Preference struct {
 	Description string `yaml:"preference_description" json:"preference_description"`
@@ -32,6 +68,23 @@ type Profile struct {
 		Status bool `yaml:"Status" json:"Status"`
 		Border int  `yaml:"Border" json:"Border"`
 	}
+	Verified bool            `yaml:"verified" json:"verified"`
+	Prompts  []ProfilePrompt `yaml:"prompts" json:"prompts"`
+	Timezone string          `yaml:"timezone" json:"timezone"`
+}
+
+// Prompt is a question from the catalog profiles pick their prompts from.
+type Prompt struct {
+	PromptId int    `json:"promptId"`
+	Question string `json:"question"`
+}
+
+// ProfilePrompt is a prompt answered on a profile. Prompts are shown in the
+// order they are stored.
+type ProfilePrompt struct {
+	PromptId int    `yaml:"promptId" json:"promptId"`
+	Question string `yaml:"question" json:"question"`
+	Answer   string `yaml:"answer" json:"answer"`
 }
 
 type SearchProfileRequest struct {
@@ -45,14 +98,393 @@ type SearchProfileRequest struct {
 	Preferences []Preference `yaml:"preferences" json:"preferences"`
 	Country     string       `json:"country"`
 	City        string       `json:"city"`
+	Filter      *FilterExpr  `json:"filter"`
+}
+
+const (
+	FilterAnd        = "and"
+	FilterOr         = "or"
+	FilterNot        = "not"
+	FilterInterest   = "interest"
+	FilterParameter  = "parameter"
+	FilterPreference = "preference"
+	FilterGoal       = "goal"
+	FilterVerified   = "verified"
+)
+
+// FilterExpr is a boolean expression over profile attributes. Inner nodes
+// (and, or, not) combine Children; leaves match a single interest,
+// parameter, preference or goal by Description and Value, or verified
+// profiles.
+type FilterExpr struct {
+	Op          string       `json:"op"`
+	Children    []FilterExpr `json:"children,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Value       string       `json:"value,omitempty"`
 }
 
 type FoundProfile struct {
-	IDUser   int    `json:"idUser"`
-	FirstImg string `json:"firstImgSrc"`
-	Fullname string `json:"fullname"`
-	Age      int    `json:"age"`
-	Goal     int    `yaml:"goal" json:"goal"`
+	IDUser    int     `json:"idUser"`
+	FirstImg  string  `json:"firstImgSrc"`
+	Fullname  string  `json:"fullname"`
+	Age       int     `json:"age"`
+	Goal      int     `yaml:"goal" json:"goal"`
+	SortValue float64 `json:"-"`
+	// NameHighlight and Snippet carry HTML-escaped text with matched words
+	// wrapped in <mark> tags. Both are empty when the query has no input.
+	NameHighlight string `json:"nameHighlight"`
+	Snippet       string `json:"snippet"`
+	Verified      bool   `json:"verified"`
+}
+
+const (
+	SearchSortRelevance = "relevance"
+	SearchSortAge       = "age"
+	SearchSortDistance  = "distance"
+	SearchSortRecent    = "recent"
+)
+
+// SearchPage selects one keyset page of search results. Rows are ordered by
+// (SortValue, IDUser) ascending; AfterValue is nil for the first page.
+type SearchPage struct {
+	SortBy     string
+	AfterValue *float64
+	AfterID    int
+	Limit      int
+}
+
+type SavedSearch struct {
+	SearchID  int                  `json:"searchId"`
+	ProfileID int                  `json:"profileId"`
+	Name      string               `json:"name"`
+	Params    SearchProfileRequest `json:"params"`
+	Alerts    bool                 `json:"alerts"`
+	Watermark time.Time            `json:"watermark"`
+	CreatedAt time.Time            `json:"createdAt"`
+}
+
+type SearchAlert struct {
+	ProfileID int    `json:"profileId"`
+	SearchID  int    `json:"searchId"`
+	Name      string `json:"name"`
+	Hits      []int  `json:"hits"`
+}
+
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type SearchFacets struct {
+	Total      int          `json:"total"`
+	Goals      []FacetCount `json:"goals"`
+	AgeBuckets []FacetCount `json:"ageBuckets"`
+	Cities     []FacetCount `json:"cities"`
+	Interests  []FacetCount `json:"interests"`
+}
+
+const (
+	PhotoThumb = "thumb"
+	PhotoCard  = "card"
+	PhotoFull  = "full"
+)
+
+// PhotoVariant is one stored size of an uploaded photo. MaxSide bounds the
+// longer edge; smaller images are never upscaled.
+type PhotoVariant struct {
+	Name    string
+	MaxSide int
+}
+
+var PhotoVariants = []PhotoVariant{
+	{Name: PhotoThumb, MaxSide: 160},
+	{Name: PhotoCard, MaxSide: 640},
+	{Name: PhotoFull, MaxSide: 1600},
+}
+
+const (
+	PhotoPending  = "pending"
+	PhotoApproved = "approved"
+	PhotoRejected = "rejected"
+)
+
+// ProcessedPhoto is an upload after the automated checks: the encoded size
+// variants and a 64-bit difference hash of the image.
+type ProcessedPhoto struct {
+	Variants map[string][]byte
+	Hash     uint64
+}
+
+// Photo is a stored photo as its owner sees it, moderation state included.
+type Photo struct {
+	Path    string `json:"path"`
+	Primary bool   `json:"primary"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+}
+
+type PendingPhoto struct {
+	PhotoId    int       `json:"photoId"`
+	ProfileId  int       `json:"profileId"`
+	FirstName  string    `json:"firstName"`
+	Path       string    `json:"path"`
+	UploadedAt time.Time `json:"uploadedAt"`
+}
+
+// StolenPhotoComplaint is the complaint type raised automatically when an
+// upload matches a photo of another account.
+const StolenPhotoComplaint = "Чужие фотографии"
+
+// PhotoMatch is a stored photo whose hash is close to the one looked up.
+type PhotoMatch struct {
+	ProfileId int
+	Path      string
+	Distance  int
+}
+
+// PhotoPair links two photos of different profiles that look the same.
+type PhotoPair struct {
+	First  PhotoMatch
+	Second PhotoMatch
+}
+
+// PhotoCluster groups profiles connected through shared photos.
+type PhotoCluster struct {
+	ProfileIds []int    `json:"profileIds"`
+	Paths      []string `json:"paths"`
+}
+
+type UnhashedPhoto struct {
+	PhotoId int
+	Path    string
+}
+
+const (
+	VerificationNone       = "none"
+	VerificationChallenged = "challenged"
+	VerificationSubmitted  = "submitted"
+	VerificationApproved   = "approved"
+	VerificationRejected   = "rejected"
+	VerificationExpired    = "expired"
+)
+
+// VerificationPose is a challenge the selfie has to show, so a reviewer can
+// tell a fresh photo from one found elsewhere.
+type VerificationPose struct {
+	Name        string
+	Instruction string
+}
+
+var VerificationPoses = []VerificationPose{
+	{Name: "thumbs_up", Instruction: "Show a thumbs up next to your face"},
+	{Name: "peace_sign", Instruction: "Make a peace sign with one hand"},
+	{Name: "touch_nose", Instruction: "Touch your nose with your index finger"},
+	{Name: "hand_on_head", Instruction: "Put your hand on top of your head"},
+	{Name: "three_fingers", Instruction: "Hold up three fingers next to your cheek"},
+	{Name: "look_left", Instruction: "Turn your head to the left and look at the camera"},
+}
+
+// Verification is the latest verification request of a profile. Status is
+// VerificationNone when the profile never asked for one.
+type Verification struct {
+	RequestId int
+	ProfileId int
+	Verified  bool
+	Pose      string
+	SelfieKey string
+	Status    string
+	Reason    string
+	ExpiresAt time.Time
+}
+
+type PendingVerification struct {
+	RequestId   int
+	ProfileId   int
+	FirstName   string
+	Pose        string
+	SelfieKey   string
+	Photos      []string
+	SubmittedAt time.Time
+}
+
+const (
+	DictionaryCities      = "cities"
+	DictionaryInterests   = "interests"
+	DictionaryPreferences = "preferences"
+	DictionaryParameters  = "parameters"
+)
+
+// Suggestion is one autocomplete entry. Context narrows the value down: the
+// country for a city, the description for a preference or parameter value.
+type Suggestion struct {
+	Value      string `json:"value"`
+	Context    string `json:"context"`
+	Popularity int    `json:"popularity"`
+}
+
+// ProfileTimezone is a profile's stored IANA timezone.
+type ProfileTimezone struct {
+	ProfileId int    `json:"profileId"`
+	Timezone  string `json:"timezone"`
+}
+
+type TopPicks struct {
+	Profiles  []Profile `json:"profiles"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type IncomingLike struct {
+	Profile Profile   `json:"profile"`
+	Status  int       `json:"status"`
+	LikedAt time.Time `json:"likedAt"`
+	// Comment is nil when the like came without one.
+	Comment *LikeComment `json:"comment"`
+}
+
+// A match expires MatchTTL after it was made unless somebody writes first.
+// It is expiring for the last MatchExpiringWindow, reminders go out
+// MatchReminders before the expiry, and a premium user may push the expiry
+// back by MatchExtension once.
+var MatchTTL = 72 * time.Hour
+var MatchExpiringWindow = 24 * time.Hour
+var MatchReminders = []time.Duration{48 * time.Hour, 24 * time.Hour, 3 * time.Hour}
+var MatchExtension = 24 * time.Hour
+
+const (
+	MatchCreated  = "created"
+	MatchActive   = "active"
+	MatchExpiring = "expiring"
+	MatchExpired  = "expired"
+)
+
+// MatchExpiry is a match as seen from ProfileId.
+type MatchExpiry struct {
+	ProfileId int
+	MatchedId int
+	State     string
+	ExpiresAt time.Time
+	Extended  bool
+}
+
+// MaxLikeCommentLength bounds the comment sent with a like.
+const MaxLikeCommentLength = 150
+
+// MaxIdempotencyKeyLength bounds the key a client sends to make a like safe
+// to retry. A key is remembered for LikeRequestTTL.
+const MaxIdempotencyKeyLength = 64
+
+var LikeRequestTTL = 24 * time.Hour
+
+// OutboxMatch is the outbox event queued for each side of a new or revived
+// match, with the comments sent with the likes to seed the chat, and OutboxMatchExpired the one queued for each side of a match that
+// expired. OutboxMatchReminder is queued for each side of a match due a
+// reminder, with its expiry. OutboxChatClosed is queued once when an
+// unmatch or a block ends the pair's chat. The gateway reopens or closes the
+// chat as it relays them.
+const (
+	OutboxMatch         = "match"
+	OutboxMatchExpired  = "match_expired"
+	OutboxMatchReminder = "match_reminder"
+	OutboxChatClosed    = "chat_closed"
+)
+
+// LikeComment is a short message sent with a like by ProfileId. It may point
+// at one of the liked profile's photos, by path, or at one of its answered
+// prompts, but not at both.
+type LikeComment struct {
+	ProfileId int
+	Text      string
+	Photo     string
+	PromptId  int
+}
+
+type Boost struct {
+	BoostID   int       `json:"boostId"`
+	Source    string    `json:"source"`
+	StartedAt time.Time `json:"startedAt"`
+	EndsAt    time.Time `json:"endsAt"`
+	Views     int       `json:"views"`
+	Likes     int       `json:"likes"`
+	Matches   int       `json:"matches"`
+}
+
+// Checklist items reported by profile_completeness_items() in the
+// database, which also holds their weights.
+const (
+	CompletenessPhoto       = "photo"
+	CompletenessMorePhotos  = "more_photos"
+	CompletenessDescription = "description"
+	CompletenessInterests   = "interests"
+	CompletenessPreferences = "preferences"
+	CompletenessParameters  = "parameters"
+	CompletenessPrompts     = "prompts"
+)
+
+// Completeness is a profile's checklist score out of 100 and the checklist
+// items it still misses, heaviest first.
+type Completeness struct {
+	Score   int      `json:"score"`
+	Missing []string `json:"missing"`
+}
+
+const (
+	VersionCreate     = "create"
+	VersionUpdate     = "update"
+	VersionPhotos     = "photos"
+	VersionModeration = "moderation"
+	VersionRevert     = "revert"
+)
+
+// ProfileSnapshot is everything a profile shows to others at one point in
+// time. Interests, preferences and parameters are kept sorted so that two
+// snapshots of the same profile compare equal; photos and prompts keep
+// their display order.
+type ProfileSnapshot struct {
+	FirstName   string          `json:"firstName"`
+	LastName    string          `json:"lastName"`
+	IsMale      bool            `json:"isMale"`
+	Goal        int             `json:"goal"`
+	Height      int             `json:"height"`
+	Birthday    time.Time       `json:"birthday"`
+	Description string          `json:"description"`
+	Location    string          `json:"location"`
+	Interests   []string        `json:"interests"`
+	Preferences []Preference    `json:"preferences"`
+	Parameters  []Preference    `json:"parameters"`
+	Photos      []string        `json:"photos"`
+	Prompts     []ProfilePrompt `json:"prompts"`
+}
+
+// FieldChange records one snapshot field that changed, with its JSON
+// encoded value before and after.
+type FieldChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
+
+// ProfileVersion is one entry of a profile's history. ActorId is zero when
+// nobody is known to have made the change.
+type ProfileVersion struct {
+	VersionId int
+	ProfileId int
+	ActorId   int
+	Action    string
+	Snapshot  ProfileSnapshot
+	Changes   []FieldChange
+	CreatedAt time.Time
+}
+
+// MaxBlockReasonLength bounds the optional reason given for a block or an
+// unmatch.
+const MaxBlockReasonLength = 255
+
+// BlockedProfile is a profile the user has blocked.
+type BlockedProfile struct {
+	ProfileId int
+	FirstName string
+	Avatar    string
+	BlockedAt time.Time
 }
 
 type ProfileStats struct {
@@ -63,6 +495,16 @@ type ProfileStats struct {
 	ComplaintsReceived int `json:"complaintsReceived"`
 	MessagesSent       int `json:"messagesSent"`
 	ChatCount          int `json:"chatCount"`
+	Recycled           int `json:"recycled"`
+}
+
+// SecondLook is whether a user sees disliked profiles again once their
+// dislike expires, and how many of those they have recycled. A profile is
+// recycled when the user swipes it again, not when it is served: one they
+// scroll past keeps coming back until they swipe it.
+type SecondLook struct {
+	Enabled  bool
+	Recycled int
 }
 
 var (
@@ -70,4 +512,36 @@ var (
 	ErrProfileNotFound       = errors.New("profile not found")
 	ErrInvalidProfile        = errors.New("invalid profile")
 	ErrDeleteProfile         = errors.New("failed to delete profile")
+	ErrBoostAlreadyActive    = errors.New("boost is already active")
+	ErrNoBoostsLeft          = errors.New("no boosts left")
+	ErrNothingToRewind       = errors.New("nothing to rewind")
+	ErrInvalidSearchCursor   = errors.New("invalid search cursor")
+	ErrInvalidSearchFilter   = errors.New("invalid search filter")
+	ErrSavedSearchNotFound   = errors.New("saved search not found")
+	ErrTooManySavedSearches  = errors.New("too many saved searches")
+	ErrUnknownDictionary     = errors.New("unknown dictionary")
+	ErrUnsupportedImage      = errors.New("unsupported image format")
+	ErrImageTooLarge         = errors.New("image dimensions are too large")
+	ErrInvalidUploadKey      = errors.New("invalid upload key")
+	ErrUploadNotFound        = errors.New("upload not found")
+	ErrUploadTooLarge        = errors.New("upload is too large")
+	ErrTooManyPhotos         = errors.New("too many photos")
+	ErrInvalidPhotoOrder     = errors.New("photo order must list every photo once")
+	ErrPhotoNotFound         = errors.New("photo not found")
+	ErrImageTooSmall         = errors.New("image resolution is too low")
+	ErrBlankImage            = errors.New("image is blank or a solid color")
+	ErrDuplicatePhoto        = errors.New("photo duplicates one already on the profile")
+	ErrAlreadyVerified       = errors.New("profile is already verified")
+	ErrVerificationPending   = errors.New("verification is waiting for review")
+	ErrVerificationNotFound  = errors.New("verification not found")
+	ErrInvalidPrompts        = errors.New("invalid profile prompts")
+	ErrVersionNotFound       = errors.New("profile version not found")
+	ErrMatchNotFound         = errors.New("match not found")
+	ErrBlockNotFound         = errors.New("block not found")
+	ErrProfileBlocked        = errors.New("profile is blocked")
+	ErrInvalidLikeComment    = errors.New("invalid like comment")
+	ErrMatchNotExtendable    = errors.New("match cannot be extended")
+	ErrMatchAlreadyExtended  = errors.New("match is already extended")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was used for another like")
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
 )
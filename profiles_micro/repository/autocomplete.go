@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+// Autocomplete queries take the raw input as $1, a LIKE prefix pattern as $2
+// and the limit as $3. Prefix matches come first, then the most popular
+// entries; typos are caught by the trigram similarity operator.
+const (
+	AutocompleteCitiesQuery = `
+SELECT l.city, l.country, COUNT(p.profile_id)::int AS popularity
+FROM locations l
+LEFT JOIN profiles p ON p.location_id = l.location_id
+WHERE LOWER(l.city) LIKE $2 OR LOWER(l.city) % LOWER($1)
+GROUP BY l.city, l.country
+ORDER BY BOOL_OR(LOWER(l.city) LIKE $2) DESC,
+         popularity DESC,
+         MAX(similarity(LOWER(l.city), LOWER($1))) DESC,
+         l.city
+LIMIT $3;
+`
+
+	AutocompleteInterestsQuery = `
+SELECT i.description, '' AS context, COUNT(pi.profile_id)::int AS popularity
+FROM interests i
+LEFT JOIN profile_interests pi ON pi.interest_id = i.interest_id
+WHERE LOWER(i.description) LIKE $2 OR LOWER(i.description) % LOWER($1)
+GROUP BY i.description
+ORDER BY BOOL_OR(LOWER(i.description) LIKE $2) DESC,
+         popularity DESC,
+         MAX(similarity(LOWER(i.description), LOWER($1))) DESC,
+         i.description
+LIMIT $3;
+`
+
+	AutocompletePreferencesQuery = `
+SELECT pr.preference_value, pr.preference_description, COUNT(pp.profile_id)::int AS popularity
+FROM preferences pr
+LEFT JOIN profile_preferences pp ON pp.preference_id = pr.preference_id
+WHERE ($4 = '' OR pr.preference_description = $4)
+  AND (LOWER(pr.preference_value) LIKE $2 OR LOWER(pr.preference_value) % LOWER($1))
+GROUP BY pr.preference_value, pr.preference_description
+ORDER BY BOOL_OR(LOWER(pr.preference_value) LIKE $2) DESC,
+         popularity DESC,
+         MAX(similarity(LOWER(pr.preference_value), LOWER($1))) DESC,
+         pr.preference_value
+LIMIT $3;
+`
+
+	AutocompleteParametersQuery = `
+SELECT pa.parameter_value, pa.parameter_description, COUNT(pp.profile_id)::int AS popularity
+FROM parameters pa
+LEFT JOIN profile_parameter pp ON pp.parameter_id = pa.parameter_id
+WHERE ($4 = '' OR pa.parameter_description = $4)
+  AND (LOWER(pa.parameter_value) LIKE $2 OR LOWER(pa.parameter_value) % LOWER($1))
+GROUP BY pa.parameter_value, pa.parameter_description
+ORDER BY BOOL_OR(LOWER(pa.parameter_value) LIKE $2) DESC,
+         popularity DESC,
+         MAX(similarity(LOWER(pa.parameter_value), LOWER($1))) DESC,
+         pa.parameter_value
+LIMIT $3;
+`
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// NormalizeDictionaryValue trims a user supplied dictionary value and
+// collapses inner whitespace, so "  rock   climbing " and "rock climbing"
+// resolve to the same entry.
+func NormalizeDictionaryValue(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// Autocomplete suggests dictionary entries for the typed query. Description
+// only applies to preferences and parameters, where it restricts values to
+// a single description such as "Height".
+func (pr *ProfileRepo) Autocomplete(dictionary, query, description string, limit int) ([]model.Suggestion, error) {
+	var sqlQuery string
+	switch dictionary {
+	case model.DictionaryCities:
+		sqlQuery = AutocompleteCitiesQuery
+	case model.DictionaryInterests:
+		sqlQuery = AutocompleteInterestsQuery
+	case model.DictionaryPreferences:
+		sqlQuery = AutocompletePreferencesQuery
+	case model.DictionaryParameters:
+		sqlQuery = AutocompleteParametersQuery
+	default:
+		return nil, model.ErrUnknownDictionary
+	}
+
+	query = NormalizeDictionaryValue(query)
+	args := []interface{}{query, likeEscaper.Replace(strings.ToLower(query)) + "%", limit}
+	if dictionary == model.DictionaryPreferences || dictionary == model.DictionaryParameters {
+		args = append(args, description)
+	}
+
+	rows, err := pr.DB.Query(context.Background(), sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	suggestions := []model.Suggestion{}
+	for rows.Next() {
+		var s model.Suggestion
+		if err := rows.Scan(&s.Value, &s.Context, &s.Popularity); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// An unmatched profile never comes back for a second look.
+	DislikeMatchQuery = `
+UPDATE likes SET status = 2, expires_at = NULL
+WHERE profile_id = $1 AND liked_profile_id = $2;
+`
+
+	InsertUnmatchQuery = `
+INSERT INTO unmatches (profile_id, unmatched_profile_id, reason)
+VALUES ($1, $2, NULLIF($3, ''));
+`
+
+	InsertBlockQuery = `
+INSERT INTO user_blocks (blocker_id, blocked_id, reason)
+VALUES ($1, $2, NULLIF($3, ''))
+ON CONFLICT (blocker_id, blocked_id) DO NOTHING;
+`
+
+	DeleteBlockQuery = `
+DELETE FROM user_blocks WHERE blocker_id = $1 AND blocked_id = $2;
+`
+
+	GetBlocksQuery = `
+SELECT ub.blocked_id, p.firstname, COALESCE(profile_avatar(p.profile_id), ''), ub.created_at
+FROM user_blocks ub
+JOIN profiles p ON p.profile_id = ub.blocked_id
+WHERE ub.blocker_id = $1
+ORDER BY ub.created_at DESC;
+`
+
+	IsBlockedQuery = `
+SELECT profiles_blocked($1, $2);
+`
+)
+
+// Unmatch ends a match the way a dislike does and keeps the reason. The
+// other side's like stays, so the pair does not come back into each other's
+// feeds. Their chat is closed through the outbox.
+func (pr *ProfileRepo) Unmatch(profileId int, matchedId int, reason string) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, DeleteMatchQuery, profileId, matchedId)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrMatchNotFound
+	}
+
+	if _, err := tx.Exec(ctx, DislikeMatchQuery, profileId, matchedId); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, InsertUnmatchQuery, profileId, matchedId, reason); err != nil {
+		return err
+	}
+	if err := queueOutboxEvent(ctx, tx, model.OutboxChatClosed, profileId, matchedId); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// BlockProfile blocks blockedId for profileId and ends their match, if any.
+// Blocking twice keeps the first block. Their chat, if any, is closed
+// through the outbox.
+func (pr *ProfileRepo) BlockProfile(profileId int, blockedId int, reason string) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var lockedId int
+	if err := tx.QueryRow(ctx, LockProfileQuery, blockedId).Scan(&lockedId); err != nil {
+		if err == pgx.ErrNoRows {
+			return model.ErrProfileNotFound
+		}
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, InsertBlockQuery, profileId, blockedId, reason); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, DeleteMatchQuery, profileId, blockedId); err != nil {
+		return err
+	}
+	if err := queueOutboxEvent(ctx, tx, model.OutboxChatClosed, profileId, blockedId); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (pr *ProfileRepo) UnblockProfile(profileId int, blockedId int) error {
+	tag, err := pr.DB.Exec(context.Background(), DeleteBlockQuery, profileId, blockedId)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrBlockNotFound
+	}
+	return nil
+}
+
+// GetBlocks lists the profiles profileId has blocked, latest first. Blocks
+// made by others are not listed.
+func (pr *ProfileRepo) GetBlocks(profileId int) ([]model.BlockedProfile, error) {
+	rows, err := pr.DB.Query(context.Background(), GetBlocksQuery, profileId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blocks := []model.BlockedProfile{}
+	for rows.Next() {
+		var (
+			b         model.BlockedProfile
+			blockedAt sql.NullTime
+		)
+		if err := rows.Scan(&b.ProfileId, &b.FirstName, &b.Avatar, &blockedAt); err != nil {
+			return nil, err
+		}
+		b.BlockedAt = blockedAt.Time
+		blocks = append(blocks, b)
+	}
+
+	return blocks, rows.Err()
+}
+
+// IsBlocked reports whether either profile has blocked the other.
+func (pr *ProfileRepo) IsBlocked(profileId int, otherId int) (bool, error) {
+	var blocked bool
+	err := pr.DB.QueryRow(context.Background(), IsBlockedQuery, profileId, otherId).Scan(&blocked)
+	return blocked, err
+}
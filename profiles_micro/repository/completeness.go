@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+// GetCompletenessQuery lists the checklist items of a profile, heaviest
+// first. The checklist itself lives in profile_completeness_items(); ranking
+// reads its score from profiles.completeness, which triggers keep current.
+const GetCompletenessQuery = `
+SELECT item, weight, done
+FROM profile_completeness_items($1)
+ORDER BY weight DESC, position
+`
+
+// GetCompleteness scores a profile against the checklist. Only approved
+// photos count, as those are the only ones the profile shows.
+func (pr *ProfileRepo) GetCompleteness(profileID int) (model.Completeness, error) {
+	completeness := model.Completeness{Missing: []string{}}
+
+	rows, err := pr.DB.Query(context.Background(), GetCompletenessQuery, profileID)
+	if err != nil {
+		return completeness, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			item   string
+			weight int
+			done   bool
+		)
+		if err := rows.Scan(&item, &weight, &done); err != nil {
+			return completeness, err
+		}
+		if done {
+			completeness.Score += weight
+		} else {
+			completeness.Missing = append(completeness.Missing, item)
+		}
+	}
+
+	return completeness, rows.Err()
+}
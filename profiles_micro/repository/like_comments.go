@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	LikeCommentTargetQuery = `
+SELECT ($2 = '' OR EXISTS (
+           SELECT 1 FROM static s
+           WHERE s.profile_id = $1 AND s.path = $2 AND s.moderation_status = 'approved'
+       ))
+   AND ($3 = 0 OR EXISTS (
+           SELECT 1 FROM profile_prompts pp
+           WHERE pp.profile_id = $1 AND pp.prompt_id = $3
+       ));
+`
+
+	GetMatchCommentsQuery = `
+SELECT profile_id, comment, COALESCE(comment_photo, ''), COALESCE(comment_prompt_id, 0)
+FROM likes
+WHERE ((profile_id = $1 AND liked_profile_id = $2) OR (profile_id = $2 AND liked_profile_id = $1))
+  AND status IN (1, 3)
+  AND comment IS NOT NULL
+ORDER BY created_at, like_id;
+`
+)
+
+// LikeCommentTargetExists reports whether profileId has the approved photo
+// and the answered prompt a comment points at. Empty targets always exist.
+func (pr *ProfileRepo) LikeCommentTargetExists(profileId int, photo string, promptId int) (bool, error) {
+	var exists bool
+	err := pr.DB.QueryRow(context.Background(), LikeCommentTargetQuery, profileId, photo, promptId).Scan(&exists)
+	return exists, err
+}
+
+// getMatchComments returns the comments the two profiles sent with their
+// likes to each other, oldest first.
+func getMatchComments(ctx context.Context, tx pgx.Tx, firstId int, secondId int) ([]model.LikeComment, error) {
+	rows, err := tx.Query(ctx, GetMatchCommentsQuery, firstId, secondId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := []model.LikeComment{}
+	for rows.Next() {
+		var c model.LikeComment
+		if err := rows.Scan(&c.ProfileId, &c.Text, &c.Photo, &c.PromptId); err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// A key older than $5 seconds is taken over by the new request.
+	ClaimLikeRequestQuery = `
+INSERT INTO like_requests (profile_id, idempotency_key, liked_profile_id, status)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (profile_id, idempotency_key) DO UPDATE SET
+    liked_profile_id = EXCLUDED.liked_profile_id,
+    status = EXCLUDED.status,
+    like_id = NULL,
+    created_at = NOW()
+WHERE like_requests.created_at < NOW() - $5 * INTERVAL '1 second';
+`
+
+	GetLikeRequestQuery = `
+SELECT liked_profile_id, status, COALESCE(like_id, 0)
+FROM like_requests
+WHERE profile_id = $1 AND idempotency_key = $2;
+`
+
+	StoreLikeResultQuery = `
+UPDATE like_requests
+SET like_id = $3
+WHERE profile_id = $1 AND idempotency_key = $2;
+`
+
+	DeleteStaleLikeRequestsQuery = `
+DELETE FROM like_requests
+WHERE created_at < NOW() - $1 * INTERVAL '1 second';
+`
+
+	// Likes between the same two profiles, in either direction, take turns.
+	LockLikePairQuery = `SELECT pg_advisory_xact_lock(LEAST($1::int, $2::int), GREATEST($1::int, $2::int));`
+
+	InsertOutboxEventQuery = `
+INSERT INTO outbox_events (event_type, profile_id, payload)
+VALUES ($1, $2, $3);
+`
+)
+
+// claimLikeRequest records that the request with idempotencyKey is being
+// served. When the key was already served it returns the stored like id and
+// true, or ErrIdempotencyKeyReused if the key came with another like.
+func claimLikeRequest(ctx context.Context, tx pgx.Tx, from int, to int, status int, idempotencyKey string) (int, bool, error) {
+	tag, err := tx.Exec(ctx, ClaimLikeRequestQuery, from, idempotencyKey, to, status, int64(model.LikeRequestTTL/time.Second))
+	if err != nil {
+		return 0, false, err
+	}
+	if tag.RowsAffected() == 1 {
+		return 0, false, nil
+	}
+
+	var storedTo, storedStatus, likeID int
+	err = tx.QueryRow(ctx, GetLikeRequestQuery, from, idempotencyKey).Scan(&storedTo, &storedStatus, &likeID)
+	if err != nil {
+		return 0, false, err
+	}
+	if storedTo != to || storedStatus != status {
+		return 0, false, model.ErrIdempotencyKeyReused
+	}
+	return likeID, true, nil
+}
+
+type matchComment struct {
+	ProfileId int    `json:"profileId"`
+	Text      string `json:"text"`
+}
+
+type matchPayload struct {
+	MatchedId int            `json:"matchedId"`
+	Comments  []matchComment `json:"comments,omitempty"`
+}
+
+// createMatch matches the two profiles unless they already are. An expired
+// match is revived rather than duplicated. It reports whether a match was
+// made, in which case each side gets a match event in the outbox carrying
+// the comments that open the chat.
+func createMatch(ctx context.Context, tx pgx.Tx, from int, to int) (bool, error) {
+	var made int
+	if err := tx.QueryRow(ctx, CreateMatchQuery, from, to, time.Now().Add(model.MatchTTL)).Scan(&made); err != nil {
+		return false, err
+	}
+	if made == 0 {
+		return false, nil
+	}
+
+	comments, err := getMatchComments(ctx, tx, from, to)
+	if err != nil {
+		return false, err
+	}
+	var seed []matchComment
+	for _, c := range comments {
+		seed = append(seed, matchComment{ProfileId: c.ProfileId, Text: c.Text})
+	}
+
+	for _, side := range [][2]int{{to, from}, {from, to}} {
+		payload := matchPayload{MatchedId: side[1], Comments: seed}
+		if err := queueOutboxPayload(ctx, tx, model.OutboxMatch, side[0], payload); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// queueOutboxEvent queues an event about the pair for profileId in tx, so
+// it is relayed only if tx commits.
+func queueOutboxEvent(ctx context.Context, tx pgx.Tx, eventType string, profileId int, matchedId int) error {
+	return queueOutboxPayload(ctx, tx, eventType, profileId, map[string]int{"matchedId": matchedId})
+}
+
+// queueOutboxPayload is queueOutboxEvent for events that carry more than
+// the other side of the pair.
+func queueOutboxPayload(ctx context.Context, tx pgx.Tx, eventType string, profileId int, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, InsertOutboxEventQuery, eventType, profileId, string(data))
+	return err
+}
+
+// DeleteStaleLikeRequests forgets the idempotency keys older than ttl.
+func (pr *ProfileRepo) DeleteStaleLikeRequests(ttl time.Duration) (int, error) {
+	tag, err := pr.DB.Exec(context.Background(), DeleteStaleLikeRequestsQuery, int64(ttl/time.Second))
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
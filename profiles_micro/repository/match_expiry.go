@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	ExpireMatchesQuery = `
+UPDATE matches
+SET state = 'expired', archived_at = NOW()
+WHERE state IN ('created', 'expiring') AND expires_at <= NOW()
+RETURNING profile_id, matched_profile_id, expires_at;
+`
+
+	MarkExpiringMatchesQuery = `
+UPDATE matches
+SET state = 'expiring'
+WHERE state = 'created' AND expires_at <= NOW() + $1 * INTERVAL '1 second';
+`
+
+	// A match gets at most one reminder per run, however many it missed.
+	RemindMatchesQuery = `
+WITH due AS (
+    SELECT m.match_id, (
+        SELECT COUNT(*) FROM unnest($1::bigint[]) AS r(secs)
+        WHERE m.expires_at - r.secs * INTERVAL '1 second' <= NOW()
+    ) AS reached
+    FROM matches m
+    WHERE m.state IN ('created', 'expiring')
+)
+UPDATE matches m
+SET reminders_sent = due.reached
+FROM due
+WHERE m.match_id = due.match_id AND due.reached > m.reminders_sent
+RETURNING m.profile_id, m.matched_profile_id, m.state, m.expires_at;
+`
+
+	LockMatchQuery = `
+SELECT state, extended
+FROM matches
+WHERE (profile_id = $1 AND matched_profile_id = $2) OR (profile_id = $2 AND matched_profile_id = $1)
+FOR UPDATE;
+`
+
+	// Reminders already due at the new expiry are counted as sent, so
+	// extending does not trigger one right away.
+	ExtendMatchQuery = `
+UPDATE matches
+SET expires_at = expires_at + $3 * INTERVAL '1 second',
+    state = 'created',
+    extended = TRUE,
+    reminders_sent = (
+        SELECT COUNT(*) FROM unnest($4::bigint[]) AS r(secs)
+        WHERE matches.expires_at + $3 * INTERVAL '1 second' - r.secs * INTERVAL '1 second' <= NOW()
+    )
+WHERE (profile_id = $1 AND matched_profile_id = $2) OR (profile_id = $2 AND matched_profile_id = $1)
+RETURNING expires_at;
+`
+
+	GetPendingMatchesQuery = `
+SELECT CASE WHEN profile_id = $1 THEN matched_profile_id ELSE profile_id END,
+       state, expires_at, extended
+FROM matches
+WHERE (profile_id = $1 OR matched_profile_id = $1)
+  AND state IN ('created', 'expiring')
+ORDER BY expires_at;
+`
+)
+
+type matchReminderPayload struct {
+	MatchedId int       `json:"matchedId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func seconds(ds []time.Duration) []int64 {
+	secs := make([]int64, 0, len(ds))
+	for _, d := range ds {
+		secs = append(secs, int64(d/time.Second))
+	}
+	return secs
+}
+
+func scanMatchExpiries(rows pgx.Rows, withState bool) ([]model.MatchExpiry, error) {
+	defer rows.Close()
+
+	matches := []model.MatchExpiry{}
+	for rows.Next() {
+		var (
+			m         model.MatchExpiry
+			expiresAt sql.NullTime
+		)
+		dest := []any{&m.ProfileId, &m.MatchedId}
+		if withState {
+			dest = append(dest, &m.State)
+		}
+		dest = append(dest, &expiresAt)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		m.ExpiresAt = expiresAt.Time
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
+
+// ExpireMatches archives the matches whose expiry has passed with nobody
+// writing and returns them. Each side gets a match_expired event in the
+// outbox in the same tx, so the pair is told and the chat closed even if
+// the caller never sees the result.
+func (pr *ProfileRepo) ExpireMatches() ([]model.MatchExpiry, error) {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, ExpireMatchesQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := scanMatchExpiries(rows, false)
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range matches {
+		matches[i].State = model.MatchExpired
+		for _, side := range [][2]int{{m.ProfileId, m.MatchedId}, {m.MatchedId, m.ProfileId}} {
+			if err := queueOutboxEvent(ctx, tx, model.OutboxMatchExpired, side[0], side[1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return matches, tx.Commit(ctx)
+}
+
+// MarkExpiringMatches moves the matches expiring within window to the
+// expiring state.
+func (pr *ProfileRepo) MarkExpiringMatches(window time.Duration) error {
+	_, err := pr.DB.Exec(context.Background(), MarkExpiringMatchesQuery, int64(window/time.Second))
+	return err
+}
+
+// RemindMatches counts a reminder as sent for the pending matches that are
+// due one and returns them. Each side gets a match_reminder event in the
+// outbox in the same tx, so a reminder counted as sent is never lost.
+func (pr *ProfileRepo) RemindMatches(reminders []time.Duration) ([]model.MatchExpiry, error) {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, RemindMatchesQuery, seconds(reminders))
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := scanMatchExpiries(rows, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		for _, side := range [][2]int{{m.ProfileId, m.MatchedId}, {m.MatchedId, m.ProfileId}} {
+			payload := matchReminderPayload{MatchedId: side[1], ExpiresAt: m.ExpiresAt}
+			if err := queueOutboxPayload(ctx, tx, model.OutboxMatchReminder, side[0], payload); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return matches, tx.Commit(ctx)
+}
+
+// ExtendMatch pushes the expiry of a pending match back by extension. A
+// match can be extended once.
+func (pr *ProfileRepo) ExtendMatch(profileId int, matchedId int, extension time.Duration, reminders []time.Duration) (model.MatchExpiry, error) {
+	ctx := context.Background()
+	match := model.MatchExpiry{ProfileId: profileId, MatchedId: matchedId}
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return match, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, LockMatchQuery, profileId, matchedId).Scan(&match.State, &match.Extended)
+	if err == pgx.ErrNoRows {
+		return match, model.ErrMatchNotFound
+	}
+	if err != nil {
+		return match, err
+	}
+	if match.State != model.MatchCreated && match.State != model.MatchExpiring {
+		return match, model.ErrMatchNotExtendable
+	}
+	if match.Extended {
+		return match, model.ErrMatchAlreadyExtended
+	}
+
+	err = tx.QueryRow(ctx, ExtendMatchQuery, profileId, matchedId, int64(extension/time.Second), seconds(reminders)).Scan(&match.ExpiresAt)
+	if err != nil {
+		return match, err
+	}
+	match.State = model.MatchCreated
+	match.Extended = true
+
+	return match, tx.Commit(ctx)
+}
+
+// GetPendingMatches lists the matches of profileId nobody has written in
+// yet, the soonest to expire first.
+func (pr *ProfileRepo) GetPendingMatches(profileId int) ([]model.MatchExpiry, error) {
+	rows, err := pr.DB.Query(context.Background(), GetPendingMatchesQuery, profileId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matches := []model.MatchExpiry{}
+	for rows.Next() {
+		var (
+			m         model.MatchExpiry
+			expiresAt sql.NullTime
+		)
+		if err := rows.Scan(&m.MatchedId, &m.State, &expiresAt, &m.Extended); err != nil {
+			return nil, err
+		}
+		m.ProfileId = profileId
+		m.ExpiresAt = expiresAt.Time
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
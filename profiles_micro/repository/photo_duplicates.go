@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+// Lookups across profiles go through the phash_bands GIN index first; the
+// band overlap only holds for distances below 8 bits.
+const (
+	FindPhotoMatchesQuery = `
+SELECT DISTINCT ON (s.profile_id)
+    s.profile_id, s.path, bit_count((s.phash # $2)::bit(64))::int AS distance
+FROM static s
+WHERE s.phash_bands && phash_bands($2)
+  AND s.profile_id <> $1
+  AND s.moderation_status <> 'rejected'
+  AND bit_count((s.phash # $2)::bit(64)) <= $3
+ORDER BY s.profile_id, distance, s.id;
+`
+
+	// FlagStolenPhotoQuery files the complaint on behalf of the profile
+	// whose photo was reused, once per open complaint.
+	FlagStolenPhotoQuery = `
+INSERT INTO complaints (complaint_by, complaint_on, complaint_type, complaint_text, status, created_at)
+SELECT owner.user_id, uploader.user_id, t.comp_type, $4, 1, CURRENT_TIMESTAMP
+FROM users owner, users uploader, complaint_types t
+WHERE owner.profile_id = $2
+  AND uploader.profile_id = $1
+  AND t.type_description = $3
+  AND NOT EXISTS (
+      SELECT 1 FROM complaints c
+      WHERE c.complaint_by = owner.user_id
+        AND c.complaint_on = uploader.user_id
+        AND c.complaint_type = t.comp_type
+        AND c.status = 1
+  );
+`
+
+	GetUnhashedPhotosQuery = `
+SELECT id, path FROM static
+WHERE phash IS NULL AND id > $1
+ORDER BY id
+LIMIT $2;
+`
+
+	SetPhotoHashQuery = `
+UPDATE static SET phash = $2 WHERE id = $1;
+`
+
+	GetPhotoMatchPairsQuery = `
+SELECT a.profile_id, a.path, b.profile_id, b.path,
+       bit_count((a.phash # b.phash)::bit(64))::int AS distance
+FROM static a
+JOIN static b ON b.phash_bands && a.phash_bands AND b.profile_id > a.profile_id
+WHERE a.moderation_status <> 'rejected'
+  AND b.moderation_status <> 'rejected'
+  AND bit_count((a.phash # b.phash)::bit(64)) <= $1
+ORDER BY distance, a.id, b.id
+LIMIT $2;
+`
+)
+
+func (pr *ProfileRepo) FindPhotoMatches(profileId int, hash uint64, maxDistance int) ([]model.PhotoMatch, error) {
+	rows, err := pr.DB.Query(context.Background(), FindPhotoMatchesQuery, profileId, int64(hash), maxDistance)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matches := []model.PhotoMatch{}
+	for rows.Next() {
+		var match model.PhotoMatch
+		if err := rows.Scan(&match.ProfileId, &match.Path, &match.Distance); err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, rows.Err()
+}
+
+func (pr *ProfileRepo) FlagStolenPhoto(profileId int, path string, match model.PhotoMatch) error {
+	text := fmt.Sprintf("Automatic check: photo %s matches photo %s of profile %d (%d bits apart)",
+		path, match.Path, match.ProfileId, match.Distance)
+
+	_, err := pr.DB.Exec(context.Background(), FlagStolenPhotoQuery, profileId, match.ProfileId, model.StolenPhotoComplaint, text)
+	return err
+}
+
+func (pr *ProfileRepo) GetUnhashedPhotos(afterId int, limit int) ([]model.UnhashedPhoto, error) {
+	rows, err := pr.DB.Query(context.Background(), GetUnhashedPhotosQuery, afterId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := []model.UnhashedPhoto{}
+	for rows.Next() {
+		var photo model.UnhashedPhoto
+		if err := rows.Scan(&photo.PhotoId, &photo.Path); err != nil {
+			return nil, err
+		}
+		photos = append(photos, photo)
+	}
+
+	return photos, rows.Err()
+}
+
+func (pr *ProfileRepo) SetPhotoHash(photoId int, hash uint64) error {
+	_, err := pr.DB.Exec(context.Background(), SetPhotoHashQuery, photoId, int64(hash))
+	return err
+}
+
+func (pr *ProfileRepo) GetPhotoClusters(maxDistance int, pairsLimit int) ([]model.PhotoCluster, error) {
+	rows, err := pr.DB.Query(context.Background(), GetPhotoMatchPairsQuery, maxDistance, pairsLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pairs []model.PhotoPair
+	for rows.Next() {
+		var pair model.PhotoPair
+		if err := rows.Scan(
+			&pair.First.ProfileId, &pair.First.Path,
+			&pair.Second.ProfileId, &pair.Second.Path,
+			&pair.First.Distance,
+		); err != nil {
+			return nil, err
+		}
+		pair.Second.Distance = pair.First.Distance
+		pairs = append(pairs, pair)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return ClusterPhotoPairs(pairs), nil
+}
+
+// ClusterPhotoPairs merges matching pairs into groups of profiles that are
+// connected through any chain of shared photos. Larger clusters come first.
+func ClusterPhotoPairs(pairs []model.PhotoPair) []model.PhotoCluster {
+	parent := map[int]int{}
+	var find func(int) int
+	find = func(id int) int {
+		if _, ok := parent[id]; !ok {
+			parent[id] = id
+		}
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+
+	for _, pair := range pairs {
+		a, b := find(pair.First.ProfileId), find(pair.Second.ProfileId)
+		if a != b {
+			parent[max(a, b)] = min(a, b)
+		}
+	}
+
+	byRoot := map[int]*model.PhotoCluster{}
+	seenPath := map[string]bool{}
+	for _, pair := range pairs {
+		root := find(pair.First.ProfileId)
+		cluster, ok := byRoot[root]
+		if !ok {
+			cluster = &model.PhotoCluster{}
+			byRoot[root] = cluster
+		}
+		for _, photo := range []model.PhotoMatch{pair.First, pair.Second} {
+			if !seenPath[photo.Path] {
+				seenPath[photo.Path] = true
+				cluster.Paths = append(cluster.Paths, photo.Path)
+			}
+		}
+	}
+	for id := range parent {
+		cluster := byRoot[find(id)]
+		cluster.ProfileIds = append(cluster.ProfileIds, id)
+	}
+
+	clusters := make([]model.PhotoCluster, 0, len(byRoot))
+	for _, cluster := range byRoot {
+		sort.Ints(cluster.ProfileIds)
+		clusters = append(clusters, *cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i].ProfileIds) != len(clusters[j].ProfileIds) {
+			return len(clusters[i].ProfileIds) > len(clusters[j].ProfileIds)
+		}
+		return clusters[i].ProfileIds[0] < clusters[j].ProfileIds[0]
+	})
+
+	return clusters
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// HasSimilarPhotoQuery compares difference hashes by their Hamming
+	// distance. Rejected photos and photos stored before hashing are ignored.
+	HasSimilarPhotoQuery = `
+SELECT EXISTS (
+    SELECT 1 FROM static
+    WHERE profile_id = $1
+      AND phash IS NOT NULL
+      AND moderation_status <> 'rejected'
+      AND bit_count((phash # $2)::bit(64)) <= $3
+);
+`
+
+	GetOwnPhotosQuery = `
+SELECT path, is_primary, moderation_status, COALESCE(moderation_reason, '')
+FROM static
+WHERE profile_id = (
+	SELECT profile_id FROM users WHERE user_id = $1
+)
+ORDER BY is_primary DESC, position, id;
+`
+
+	GetModerationQueueQuery = `
+SELECT s.id, s.profile_id, p.firstname, s.path, s.created_at
+FROM static s
+JOIN profiles p ON p.profile_id = s.profile_id
+WHERE s.moderation_status = 'pending'
+ORDER BY s.created_at, s.id
+LIMIT $1;
+`
+
+	ModeratePhotoQuery = `
+UPDATE static
+SET moderation_status = $2,
+    moderation_reason = NULLIF($3, ''),
+    moderated_by = $4,
+    moderated_at = NOW()
+WHERE id = $1 AND moderation_status = 'pending'
+RETURNING profile_id;
+`
+)
+
+func (pr *ProfileRepo) HasSimilarPhoto(profileId int, hash uint64, maxDistance int) (bool, error) {
+	var found bool
+	err := pr.DB.QueryRow(context.Background(), HasSimilarPhotoQuery, profileId, int64(hash), maxDistance).Scan(&found)
+	return found, err
+}
+
+func (pr *ProfileRepo) GetOwnPhotos(userId int) ([]model.Photo, error) {
+	rows, err := pr.DB.Query(context.Background(), GetOwnPhotosQuery, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := []model.Photo{}
+	for rows.Next() {
+		var photo model.Photo
+		if err := rows.Scan(&photo.Path, &photo.Primary, &photo.Status, &photo.Reason); err != nil {
+			return nil, err
+		}
+		photos = append(photos, photo)
+	}
+
+	return photos, rows.Err()
+}
+
+func (pr *ProfileRepo) GetModerationQueue(limit int) ([]model.PendingPhoto, error) {
+	rows, err := pr.DB.Query(context.Background(), GetModerationQueueQuery, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := []model.PendingPhoto{}
+	for rows.Next() {
+		var photo model.PendingPhoto
+		var uploadedAt sql.NullTime
+		if err := rows.Scan(&photo.PhotoId, &photo.ProfileId, &photo.FirstName, &photo.Path, &uploadedAt); err != nil {
+			return nil, err
+		}
+		photo.UploadedAt = uploadedAt.Time
+		photos = append(photos, photo)
+	}
+
+	return photos, rows.Err()
+}
+
+// ModeratePhoto settles a pending photo and records the result as a
+// moderation by moderatorId. Photos that were already reviewed count as not
+// found, so two admins cannot overrule each other. It returns the profile
+// the photo belongs to.
+func (pr *ProfileRepo) ModeratePhoto(photoId int, moderatorId int, approve bool, reason string) (int, error) {
+	decision := model.PhotoRejected
+	if approve {
+		decision = model.PhotoApproved
+	}
+
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var profileId int
+	err = tx.QueryRow(ctx, ModeratePhotoQuery, photoId, decision, reason, moderatorId).Scan(&profileId)
+	if err == pgx.ErrNoRows {
+		return 0, model.ErrPhotoNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err := recordVersion(ctx, tx, profileId, moderatorId, model.VersionModeration); err != nil {
+		return 0, err
+	}
+
+	return profileId, tx.Commit(ctx)
+}
@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+	"math/bits"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const photoVariantExt = ".webp"
+
+var allowedPhotoTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// DetectImageType sniffs the real format from the magic bytes, ignoring
+// whatever Content-Type the client claimed.
+func DetectImageType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !allowedPhotoTypes[contentType] {
+		return "", model.ErrUnsupportedImage
+	}
+	return contentType, nil
+}
+
+// PhotoVariantKey derives the object key of a size variant from the
+// uploaded filename, e.g. "/1_2_me.jpg" becomes "/1_2_me_card.webp".
+func PhotoVariantKey(filename, variant string) string {
+	base := strings.TrimSuffix(filename, path.Ext(filename))
+	return base + "_" + variant + photoVariantExt
+}
+
+// PhotoVariantKeys maps every variant name to its object key for a stored
+// photo path. Photos uploaded before variants existed only have the
+// original object, which then stands in for every size.
+func PhotoVariantKeys(photoPath string) map[string]string {
+	keys := make(map[string]string, len(model.PhotoVariants))
+
+	fullSuffix := "_" + model.PhotoFull + photoVariantExt
+	base, ok := strings.CutSuffix(photoPath, fullSuffix)
+	for _, variant := range model.PhotoVariants {
+		if ok {
+			keys[variant.Name] = base + "_" + variant.Name + photoVariantExt
+		} else {
+			keys[variant.Name] = photoPath
+		}
+	}
+
+	return keys
+}
+
+// ProcessPhoto decodes an uploaded photo, runs the automated pre-checks and
+// re-encodes every size variant as WebP. Re-encoding from raw pixels drops
+// EXIF, XMP and other metadata; the EXIF orientation is applied first so
+// photos keep their rotation.
+func ProcessPhoto(data []byte) (model.ProcessedPhoto, error) {
+	contentType, err := DetectImageType(data)
+	if err != nil {
+		return model.ProcessedPhoto{}, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return model.ProcessedPhoto{}, model.ErrUnsupportedImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > model.MaxPhotoPixels {
+		return model.ProcessedPhoto{}, model.ErrImageTooLarge
+	}
+	if min(cfg.Width, cfg.Height) < model.MinPhotoSide {
+		return model.ProcessedPhoto{}, model.ErrImageTooSmall
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return model.ProcessedPhoto{}, model.ErrUnsupportedImage
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	variants := make([]model.PhotoVariant, len(model.PhotoVariants))
+	copy(variants, model.PhotoVariants)
+	sort.Slice(variants, func(i, j int) bool { return variants[i].MaxSide > variants[j].MaxSide })
+
+	// Each variant is scaled down from the previous, larger one, which keeps
+	// the work proportional to the original size only once.
+	scaled := make([]image.Image, len(variants))
+	src := img
+	for i, variant := range variants {
+		src = fitImage(src, variant.MaxSide)
+		scaled[i] = src
+	}
+
+	// The smallest variant is plenty for the checks and the hash.
+	sample := orientImage(scaled[len(scaled)-1], orientation)
+	if isBlank(sample) {
+		return model.ProcessedPhoto{}, model.ErrBlankImage
+	}
+
+	result := model.ProcessedPhoto{
+		Variants: make(map[string][]byte, len(variants)),
+		Hash:     DifferenceHash(sample),
+	}
+	for i, variant := range variants {
+		var buf bytes.Buffer
+		if err := nativewebp.Encode(&buf, orientImage(scaled[i], orientation), nil); err != nil {
+			return model.ProcessedPhoto{}, err
+		}
+		result.Variants[variant.Name] = buf.Bytes()
+	}
+
+	return result, nil
+}
+
+// HashPhoto computes the difference hash of a stored image the same way
+// ProcessPhoto does for uploads, so photos stored before hashing existed
+// can be compared with new ones.
+func HashPhoto(data []byte) (uint64, error) {
+	contentType, err := DetectImageType(data)
+	if err != nil {
+		return 0, err
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, model.ErrUnsupportedImage
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	sampleSide := model.PhotoVariants[0].MaxSide
+	for _, variant := range model.PhotoVariants {
+		sampleSide = min(sampleSide, variant.MaxSide)
+	}
+
+	return DifferenceHash(orientImage(fitImage(img, sampleSide), orientation)), nil
+}
+
+// DifferenceHash computes a 64-bit dHash: the image is shrunk to 9x8 gray
+// pixels and each bit records whether a pixel is brighter than its right
+// neighbour. Resized or re-encoded copies of a photo differ in a few bits.
+func DifferenceHash(img image.Image) uint64 {
+	small := image.NewGray(image.Rect(0, 0, 9, 8))
+	draw.BiLinear.Scale(small, small.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			hash <<= 1
+			if small.GrayAt(x, y).Y > small.GrayAt(x+1, y).Y {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// HashDistance is the number of differing bits between two hashes.
+func HashDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// isBlank reports near-solid images: the brightness barely varies across
+// the whole picture.
+func isBlank(img image.Image) bool {
+	gray := image.NewGray(img.Bounds())
+	draw.Draw(gray, gray.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	var sum, sumSq float64
+	for _, v := range gray.Pix {
+		sum += float64(v)
+		sumSq += float64(v) * float64(v)
+	}
+	n := float64(len(gray.Pix))
+	mean := sum / n
+	return math.Sqrt(max(0, sumSq/n-mean*mean)) < model.BlankPhotoStdDev
+}
+
+// fitImage scales img so that its longer side is at most maxSide.
+func fitImage(img image.Image, maxSide int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > maxSide || h > maxSide {
+		if w >= h {
+			h = max(1, h*maxSide/w)
+			w = maxSide
+		} else {
+			w = max(1, w*maxSide/h)
+			h = maxSide
+		}
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	if w == b.Dx() && h == b.Dy() {
+		draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	} else {
+		draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	}
+	return dst
+}
+
+// orientImage applies an EXIF orientation (1-8) to img.
+func orientImage(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			dst.Set(x, y, img.At(b.Min.X+sx, b.Min.Y+sy))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the orientation tag from the EXIF block of a JPEG.
+// It returns 1 (no transform) when the tag is missing or malformed.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xD8 || marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			i += 2
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+size]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + size
+	}
+
+	return 1
+}
+
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+
+	entries := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < entries; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	LockProfileQuery = `
+SELECT profile_id FROM profiles WHERE profile_id = $1 FOR UPDATE;
+`
+
+	GetLatestSnapshotQuery = `
+SELECT snapshot::text
+FROM profile_versions
+WHERE profile_id = $1
+ORDER BY version_id DESC
+LIMIT 1;
+`
+
+	InsertProfileVersionQuery = `
+INSERT INTO profile_versions (profile_id, actor_id, action, snapshot, changes)
+VALUES ($1, NULLIF($2, 0), $3, $4::jsonb, $5::jsonb);
+`
+
+	GetProfileVersionsQuery = `
+SELECT version_id, profile_id, COALESCE(actor_id, 0), action, snapshot::text, changes::text, created_at
+FROM profile_versions
+WHERE profile_id = $1
+ORDER BY version_id DESC
+LIMIT $2;
+`
+
+	GetProfileVersionQuery = `
+SELECT version_id, profile_id, COALESCE(actor_id, 0), action, snapshot::text, changes::text, created_at
+FROM profile_versions
+WHERE profile_id = $1 AND version_id = $2;
+`
+)
+
+func comparePreferences(a, b model.Preference) int {
+	return cmp.Or(cmp.Compare(a.Description, b.Description), cmp.Compare(a.Value, b.Value))
+}
+
+// SnapshotOf takes the snapshot of a profile loaded by GetProfileById.
+func SnapshotOf(profile model.Profile) model.ProfileSnapshot {
+	snapshot := model.ProfileSnapshot{
+		FirstName:   profile.FirstName,
+		LastName:    profile.LastName,
+		IsMale:      profile.IsMale,
+		Goal:        profile.Goal,
+		Height:      profile.Height,
+		Birthday:    profile.Birthday.UTC(),
+		Description: profile.Description,
+		Location:    profile.Location,
+		Interests:   append([]string{}, profile.Interests...),
+		Preferences: append([]model.Preference{}, profile.Preferences...),
+		Parameters:  append([]model.Preference{}, profile.Parameters...),
+		Photos:      append([]string{}, profile.Photos...),
+		Prompts:     append([]model.ProfilePrompt{}, profile.Prompts...),
+	}
+
+	slices.Sort(snapshot.Interests)
+	slices.SortFunc(snapshot.Preferences, comparePreferences)
+	slices.SortFunc(snapshot.Parameters, comparePreferences)
+
+	return snapshot
+}
+
+// DiffSnapshots lists the fields that differ between two snapshots, in
+// snapshot field order.
+func DiffSnapshots(prev, next model.ProfileSnapshot) ([]model.FieldChange, error) {
+	fields := []struct {
+		name       string
+		prev, next any
+	}{
+		{"firstName", prev.FirstName, next.FirstName},
+		{"lastName", prev.LastName, next.LastName},
+		{"isMale", prev.IsMale, next.IsMale},
+		{"goal", prev.Goal, next.Goal},
+		{"height", prev.Height, next.Height},
+		{"birthday", prev.Birthday, next.Birthday},
+		{"description", prev.Description, next.Description},
+		{"location", prev.Location, next.Location},
+		{"interests", prev.Interests, next.Interests},
+		{"preferences", prev.Preferences, next.Preferences},
+		{"parameters", prev.Parameters, next.Parameters},
+		{"photos", prev.Photos, next.Photos},
+		{"prompts", prev.Prompts, next.Prompts},
+	}
+
+	changes := []model.FieldChange{}
+	for _, f := range fields {
+		oldValue, err := json.Marshal(f.prev)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := json.Marshal(f.next)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(oldValue, newValue) {
+			changes = append(changes, model.FieldChange{Field: f.name, Old: oldValue, New: newValue})
+		}
+	}
+
+	return changes, nil
+}
+
+// emptySnapshot is what the first version of a profile is compared with.
+func emptySnapshot() model.ProfileSnapshot {
+	return model.ProfileSnapshot{
+		Interests:   []string{},
+		Preferences: []model.Preference{},
+		Parameters:  []model.Preference{},
+		Photos:      []string{},
+		Prompts:     []model.ProfilePrompt{},
+	}
+}
+
+// recordVersion snapshots the profile as tx sees it and stores it as a new
+// version if anything changed since the latest one. It runs in the tx of
+// the change it records, so a change never commits without its version.
+// The profile row stays locked until then, so concurrent changes do not
+// diff against the same previous version.
+func recordVersion(ctx context.Context, tx pgx.Tx, profileId int, actorId int, action string) error {
+	var lockedId int
+	if err := tx.QueryRow(ctx, LockProfileQuery, profileId).Scan(&lockedId); err != nil {
+		if err == pgx.ErrNoRows {
+			return model.ErrProfileNotFound
+		}
+		return err
+	}
+
+	prev := emptySnapshot()
+	var raw string
+	err := tx.QueryRow(ctx, GetLatestSnapshotQuery, profileId).Scan(&raw)
+	if err != nil && err != pgx.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		if err := json.Unmarshal([]byte(raw), &prev); err != nil {
+			return err
+		}
+	}
+
+	profile, err := getProfileById(ctx, tx, profileId)
+	if err != nil {
+		return err
+	}
+	next := SnapshotOf(profile)
+
+	changes, err := DiffSnapshots(prev, next)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	snapshotJSON, err := json.Marshal(next)
+	if err != nil {
+		return err
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, InsertProfileVersionQuery, profileId, actorId, action, string(snapshotJSON), string(changesJSON))
+	return err
+}
+
+func scanProfileVersion(rows pgx.Rows) (model.ProfileVersion, error) {
+	var (
+		v         model.ProfileVersion
+		snapshot  string
+		changes   string
+		createdAt sql.NullTime
+	)
+	if err := rows.Scan(&v.VersionId, &v.ProfileId, &v.ActorId, &v.Action, &snapshot, &changes, &createdAt); err != nil {
+		return v, err
+	}
+	v.CreatedAt = createdAt.Time
+	if err := json.Unmarshal([]byte(snapshot), &v.Snapshot); err != nil {
+		return v, err
+	}
+	if err := json.Unmarshal([]byte(changes), &v.Changes); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+// GetProfileVersions returns the latest versions of a profile, newest
+// first.
+func (pr *ProfileRepo) GetProfileVersions(profileId int, limit int) ([]model.ProfileVersion, error) {
+	rows, err := pr.DB.Query(context.Background(), GetProfileVersionsQuery, profileId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := []model.ProfileVersion{}
+	for rows.Next() {
+		v, err := scanProfileVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, rows.Err()
+}
+
+func (pr *ProfileRepo) GetProfileVersion(profileId int, versionId int) (model.ProfileVersion, error) {
+	rows, err := pr.DB.Query(context.Background(), GetProfileVersionQuery, profileId, versionId)
+	if err != nil {
+		return model.ProfileVersion{}, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return model.ProfileVersion{}, err
+		}
+		return model.ProfileVersion{}, model.ErrVersionNotFound
+	}
+
+	v, err := scanProfileVersion(rows)
+	if err != nil {
+		return v, err
+	}
+	return v, rows.Err()
+}
+
+// RevertProfile puts the fields, interests, preferences, parameters and
+// prompts of a snapshot back on the profile and records the result as a
+// revert by actorId. Photos are left alone: the files of photos removed
+// since may no longer exist.
+func (pr *ProfileRepo) RevertProfile(profileId int, actorId int, snapshot model.ProfileSnapshot) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	profile := model.Profile{
+		FirstName:   snapshot.FirstName,
+		LastName:    snapshot.LastName,
+		IsMale:      snapshot.IsMale,
+		Goal:        snapshot.Goal,
+		Height:      snapshot.Height,
+		Birthday:    snapshot.Birthday,
+		Description: snapshot.Description,
+		Location:    snapshot.Location,
+	}
+	if err := updateProfileFields(ctx, tx, profileId, profile); err != nil {
+		return err
+	}
+	if err := replaceInterests(ctx, tx, profileId, snapshot.Interests); err != nil {
+		return err
+	}
+	if err := replacePreferences(ctx, tx, profileId, snapshot.Preferences); err != nil {
+		return err
+	}
+	if err := replaceParameters(ctx, tx, profileId, snapshot.Parameters); err != nil {
+		return err
+	}
+	if err := replacePrompts(ctx, tx, profileId, snapshot.Prompts); err != nil {
+		return err
+	}
+	if err := recordVersion(ctx, tx, profileId, actorId, model.VersionRevert); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	GetPromptsQuery = `
+SELECT prompt_id, question
+FROM prompts
+WHERE is_active
+ORDER BY prompt_id;
+`
+
+	DeleteProfilePrompts = `
+DELETE FROM profile_prompts WHERE profile_id = $1
+`
+
+	InsertProfilePrompt = `
+INSERT INTO profile_prompts (profile_id, prompt_id, answer, position)
+VALUES ($1, $2, $3, $4)
+`
+
+	// profilePromptsColumn aggregates the answered prompts of profile
+	// bp.profile_id into a JSON array in display order.
+	profilePromptsColumn = `
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+                   'promptId', ppr.prompt_id,
+                   'question', q.question,
+                   'answer', ppr.answer
+               ) ORDER BY ppr.position)
+        FROM profile_prompts ppr
+        JOIN prompts q ON q.prompt_id = ppr.prompt_id
+        WHERE ppr.profile_id = bp.profile_id
+    ), '[]')::text AS prompts`
+)
+
+func (pr *ProfileRepo) GetPrompts() ([]model.Prompt, error) {
+	rows, err := pr.DB.Query(context.Background(), GetPromptsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prompts := []model.Prompt{}
+	for rows.Next() {
+		var p model.Prompt
+		if err := rows.Scan(&p.PromptId, &p.Question); err != nil {
+			return nil, err
+		}
+		prompts = append(prompts, p)
+	}
+
+	return prompts, rows.Err()
+}
+
+// replacePrompts replaces the answered prompts of a profile. Prompts with a
+// blank answer are left out, so a list of blank answers clears them all.
+func replacePrompts(ctx context.Context, tx pgx.Tx, profileID int, prompts []model.ProfilePrompt) error {
+	if _, err := tx.Exec(ctx, DeleteProfilePrompts, profileID); err != nil {
+		return fmt.Errorf("failed to delete old prompts: %w", err)
+	}
+
+	position := 0
+	for _, p := range prompts {
+		if p.Answer == "" {
+			continue
+		}
+		if _, err := tx.Exec(ctx, InsertProfilePrompt, profileID, p.PromptId, p.Answer, position); err != nil {
+			return fmt.Errorf("failed to insert prompt %d: %w", p.PromptId, err)
+		}
+		position++
+	}
+
+	return nil
+}
+
+func parsePrompts(raw string) ([]model.ProfilePrompt, error) {
+	var prompts []model.ProfilePrompt
+	if raw == "" {
+		return prompts, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &prompts); err != nil {
+		return nil, err
+	}
+	return prompts, nil
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+// Only a dislike can be taken back: a like may already have made a match.
+const RewindLikeQuery = `
+DELETE FROM likes
+WHERE like_id = (
+    SELECT like_id FROM likes
+    WHERE profile_id = $1
+    ORDER BY created_at DESC, like_id DESC
+    LIMIT 1
+    FOR UPDATE
+)
+  AND status = 2
+RETURNING liked_profile_id;
+`
+
+// RewindLike takes back the last swipe of profileId when it was a dislike,
+// so the profile can come back in the deck, and returns who it was on.
+func (pr *ProfileRepo) RewindLike(profileId int) (int, error) {
+	var dislikedId int
+	err := pr.DB.QueryRow(context.Background(), RewindLikeQuery, profileId).Scan(&dislikedId)
+	if err == pgx.ErrNoRows {
+		return 0, model.ErrNothingToRewind
+	}
+	return dislikedId, err
+}
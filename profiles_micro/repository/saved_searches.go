@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+const (
+	// CountSavedSearchesQuery runs after LockProfileQuery, so concurrent
+	// saves for one profile cannot both slip under the limit.
+	CountSavedSearchesQuery = `
+SELECT COUNT(*) FROM saved_searches WHERE profile_id = $1 AND name != $2;
+`
+
+	SaveSearchQuery = `
+INSERT INTO saved_searches (profile_id, name, params, alerts)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (profile_id, name) DO UPDATE
+SET params = EXCLUDED.params,
+    alerts = EXCLUDED.alerts,
+    watermark = NOW()
+RETURNING search_id, watermark, created_at;
+`
+
+	GetSavedSearchesQuery = `
+SELECT search_id, name, params::text, alerts, watermark, created_at
+FROM saved_searches
+WHERE profile_id = $1
+ORDER BY created_at DESC, search_id DESC;
+`
+
+	DeleteSavedSearchQuery = `
+DELETE FROM saved_searches WHERE search_id = $1 AND profile_id = $2;
+`
+
+	GetAlertSearchesQuery = `
+SELECT search_id, profile_id, name, params::text, watermark
+FROM saved_searches
+WHERE alerts
+ORDER BY watermark
+LIMIT $1;
+`
+
+	RecordSearchHitsQuery = `
+WITH hits AS (
+    INSERT INTO saved_search_hits (search_id, profile_id)
+    SELECT $1, UNNEST($2::bigint[])
+    ON CONFLICT DO NOTHING
+)
+UPDATE saved_searches SET watermark = $3 WHERE search_id = $1;
+`
+)
+
+// FindNewSearchHitsQuery re-runs a saved search for its owner, limited to
+// profiles created or edited since the watermark ($12) that were not already
+// reported for this search ($13). It always yields at least one row so that
+// the statement time can be used as the next watermark.
+const FindNewSearchHitsQuery = searchFilterCTE + `
+SELECT run.run_at, hit.profile_id, hit.updated_at
+FROM (SELECT NOW() AS run_at) run
+LEFT JOIN LATERAL (
+    SELECT fp.profile_id, fp.updated_at
+    FROM filtered_profiles fp
+    WHERE fp.updated_at >= $12
+      AND NOT EXISTS (
+          SELECT 1 FROM saved_search_hits h
+          WHERE h.search_id = $13 AND h.profile_id = fp.profile_id
+      )
+    ORDER BY fp.updated_at, fp.profile_id
+    LIMIT $14
+) hit ON TRUE;
+`
+
+func (pr *ProfileRepo) SaveSearch(search model.SavedSearch) (model.SavedSearch, error) {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return search, err
+	}
+	defer tx.Rollback(ctx)
+
+	var lockedID int
+	if err := tx.QueryRow(ctx, LockProfileQuery, search.ProfileID).Scan(&lockedID); err != nil {
+		return search, fmt.Errorf("error locking profile: %w", err)
+	}
+
+	var count int
+	if err := tx.QueryRow(ctx, CountSavedSearchesQuery, search.ProfileID, search.Name).Scan(&count); err != nil {
+		return search, fmt.Errorf("error counting saved searches: %w", err)
+	}
+	if count >= model.MaxSavedSearches {
+		return search, model.ErrTooManySavedSearches
+	}
+
+	var watermark, createdAt sql.NullTime
+	err = tx.QueryRow(ctx, SaveSearchQuery,
+		search.ProfileID,
+		search.Name,
+		search.Params,
+		search.Alerts,
+	).Scan(&search.SearchID, &watermark, &createdAt)
+	if err != nil {
+		return search, fmt.Errorf("error saving search: %w", err)
+	}
+	search.Watermark = watermark.Time
+	search.CreatedAt = createdAt.Time
+
+	if err := tx.Commit(ctx); err != nil {
+		return search, err
+	}
+
+	return search, nil
+}
+
+func (pr *ProfileRepo) GetSavedSearches(profileId int) ([]model.SavedSearch, error) {
+	rows, err := pr.DB.Query(context.Background(), GetSavedSearchesQuery, profileId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	searches := []model.SavedSearch{}
+	for rows.Next() {
+		search := model.SavedSearch{ProfileID: profileId}
+		var params string
+		var watermark, createdAt sql.NullTime
+		if err := rows.Scan(&search.SearchID, &search.Name, &params, &search.Alerts, &watermark, &createdAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(params), &search.Params); err != nil {
+			return nil, fmt.Errorf("error decoding saved search %d: %w", search.SearchID, err)
+		}
+		search.Watermark = watermark.Time
+		search.CreatedAt = createdAt.Time
+		searches = append(searches, search)
+	}
+
+	return searches, rows.Err()
+}
+
+func (pr *ProfileRepo) DeleteSavedSearch(profileId int, searchId int) error {
+	cmdTag, err := pr.DB.Exec(context.Background(), DeleteSavedSearchQuery, searchId, profileId)
+	if err != nil {
+		return err
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return model.ErrSavedSearchNotFound
+	}
+	return nil
+}
+
+func (pr *ProfileRepo) GetAlertSearches(limit int) ([]model.SavedSearch, error) {
+	rows, err := pr.DB.Query(context.Background(), GetAlertSearchesQuery, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var searches []model.SavedSearch
+	for rows.Next() {
+		search := model.SavedSearch{Alerts: true}
+		var params string
+		var watermark sql.NullTime
+		if err := rows.Scan(&search.SearchID, &search.ProfileID, &search.Name, &params, &watermark); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(params), &search.Params); err != nil {
+			return nil, fmt.Errorf("error decoding saved search %d: %w", search.SearchID, err)
+		}
+		search.Watermark = watermark.Time
+		searches = append(searches, search)
+	}
+
+	return searches, rows.Err()
+}
+
+// FindNewSearchHits returns profiles that started matching search since its
+// watermark, together with the watermark to store once they are reported.
+// When the page is full the watermark stops at the last hit so the rest is
+// picked up by the next run.
+func (pr *ProfileRepo) FindNewSearchHits(search model.SavedSearch, limit int) ([]int, time.Time, error) {
+	params := search.Params
+	args := []interface{}{
+		search.ProfileID,
+		params.IsMale,
+		params.AgeMin,
+		params.AgeMax,
+		params.HeightMin,
+		params.HeightMax,
+		params.Goal,
+		params.Country,
+		params.City,
+		params.Preferences,
+		params.Input,
+		search.Watermark,
+		search.SearchID,
+		limit,
+	}
+
+	predicate, filterArgs, err := CompileSearchFilter(params.Filter, len(args))
+	if err != nil {
+		return nil, search.Watermark, err
+	}
+
+	rows, err := pr.DB.Query(context.Background(), withSearchFilter(FindNewSearchHitsQuery, predicate), append(args, filterArgs...)...)
+	if err != nil {
+		return nil, search.Watermark, err
+	}
+	defer rows.Close()
+
+	var hits []int
+	watermark := search.Watermark
+	var lastUpdated time.Time
+	for rows.Next() {
+		var runAt, updatedAt sql.NullTime
+		var profileID sql.NullInt64
+		if err := rows.Scan(&runAt, &profileID, &updatedAt); err != nil {
+			return nil, search.Watermark, err
+		}
+		if runAt.Valid {
+			watermark = runAt.Time
+		}
+		if profileID.Valid {
+			hits = append(hits, int(profileID.Int64))
+			lastUpdated = updatedAt.Time
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, search.Watermark, err
+	}
+
+	if len(hits) == limit {
+		watermark = lastUpdated
+	}
+
+	return hits, watermark, nil
+}
+
+func (pr *ProfileRepo) RecordSearchHits(searchId int, hits []int, watermark time.Time) error {
+	if hits == nil {
+		hits = []int{}
+	}
+	_, err := pr.DB.Exec(context.Background(), RecordSearchHitsQuery, searchId, hits, watermark)
+	return err
+}
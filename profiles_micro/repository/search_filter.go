@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+// searchFilterMarker is replaced with the compiled filter expression inside
+// searchFilterCTE. Without a filter the comment is left as is.
+const searchFilterMarker = "/* filter */"
+
+// filterCompiler turns a model.FilterExpr into a SQL predicate over
+// p.profile_id. User input never reaches the SQL text: every description
+// and value is passed as a bind parameter numbered after the query's own.
+type filterCompiler struct {
+	args  []interface{}
+	first int
+	nodes int
+}
+
+// CompileSearchFilter returns the predicate for expr together with its
+// arguments, whose placeholders start at $(argOffset+1).
+func CompileSearchFilter(expr *model.FilterExpr, argOffset int) (string, []interface{}, error) {
+	if expr == nil {
+		return "", nil, nil
+	}
+
+	fc := &filterCompiler{first: argOffset + 1}
+	sql, err := fc.compile(*expr, 1)
+	if err != nil {
+		return "", nil, err
+	}
+	return sql, fc.args, nil
+}
+
+func (fc *filterCompiler) bind(v interface{}) string {
+	fc.args = append(fc.args, v)
+	return "$" + strconv.Itoa(fc.first+len(fc.args)-1)
+}
+
+func (fc *filterCompiler) compile(expr model.FilterExpr, depth int) (string, error) {
+	fc.nodes++
+	if depth > model.MaxFilterDepth || fc.nodes > model.MaxFilterNodes {
+		return "", fmt.Errorf("%w: expression is too large", model.ErrInvalidSearchFilter)
+	}
+
+	switch expr.Op {
+	case model.FilterAnd, model.FilterOr:
+		if len(expr.Children) == 0 {
+			return "", fmt.Errorf("%w: %q needs operands", model.ErrInvalidSearchFilter, expr.Op)
+		}
+		parts := make([]string, 0, len(expr.Children))
+		for _, child := range expr.Children {
+			part, err := fc.compile(child, depth+1)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		return "(" + strings.Join(parts, " "+strings.ToUpper(expr.Op)+" ") + ")", nil
+
+	case model.FilterNot:
+		if len(expr.Children) != 1 {
+			return "", fmt.Errorf("%w: \"not\" takes exactly one operand", model.ErrInvalidSearchFilter)
+		}
+		part, err := fc.compile(expr.Children[0], depth+1)
+		if err != nil {
+			return "", err
+		}
+		return "(NOT " + part + ")", nil
+
+	case model.FilterInterest:
+		if expr.Description == "" {
+			return "", fmt.Errorf("%w: interest needs a description", model.ErrInvalidSearchFilter)
+		}
+		return fmt.Sprintf(`EXISTS (
+            SELECT 1 FROM profile_interests fpi
+            JOIN interests fi ON fi.interest_id = fpi.interest_id
+            WHERE fpi.profile_id = p.profile_id
+              AND LOWER(fi.description) = LOWER(%s)
+        )`, fc.bind(expr.Description)), nil
+
+	case model.FilterParameter:
+		if expr.Description == "" {
+			return "", fmt.Errorf("%w: parameter needs a description", model.ErrInvalidSearchFilter)
+		}
+		desc, value := fc.bind(expr.Description), fc.bind(expr.Value)
+		return fmt.Sprintf(`EXISTS (
+            SELECT 1 FROM profile_parameter fpp
+            JOIN parameters fpr ON fpr.parameter_id = fpp.parameter_id
+            WHERE fpp.profile_id = p.profile_id
+              AND fpr.parameter_description = %s
+              AND (%s = '' OR fpr.parameter_value = %s)
+        )`, desc, value, value), nil
+
+	case model.FilterPreference:
+		if expr.Description == "" {
+			return "", fmt.Errorf("%w: preference needs a description", model.ErrInvalidSearchFilter)
+		}
+		desc, value := fc.bind(expr.Description), fc.bind(expr.Value)
+		return fmt.Sprintf(`EXISTS (
+            SELECT 1 FROM profile_preferences fpp
+            JOIN preferences fpr ON fpr.preference_id = fpp.preference_id
+            WHERE fpp.profile_id = p.profile_id
+              AND fpr.preference_description = %s
+              AND (%s = '' OR fpr.preference_value = %s)
+        )`, desc, value, value), nil
+
+	case model.FilterGoal:
+		goal, err := strconv.Atoi(expr.Value)
+		if err != nil {
+			return "", fmt.Errorf("%w: goal must be a number", model.ErrInvalidSearchFilter)
+		}
+		return fmt.Sprintf("p.goal = %s", fc.bind(goal)), nil
+
+	case model.FilterVerified:
+		return "p.is_verified", nil
+	}
+
+	return "", fmt.Errorf("%w: unknown operator %q", model.ErrInvalidSearchFilter, expr.Op)
+}
+
+// withSearchFilter splices a compiled predicate into a query built on
+// searchFilterCTE.
+func withSearchFilter(query string, predicate string) string {
+	if predicate == "" {
+		return query
+	}
+	return strings.Replace(query, searchFilterMarker, "AND "+predicate, 1)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// The swipe itself marks the like recycled_at, see CreateLikeQuery.
+	// SetLike runs it in the same tx, so a second look is counted with
+	// the swipe.
+	RecordSecondLookQuery = `
+UPDATE profiles
+SET recycled_count = recycled_count + 1
+WHERE profile_id = $1
+  AND EXISTS (
+      SELECT 1 FROM likes
+      WHERE profile_id = $1 AND liked_profile_id = $2 AND recycled_at IS NOT NULL
+  );
+`
+
+	GetSecondLookQuery = `
+SELECT second_look, recycled_count
+FROM profiles
+WHERE profile_id = $1;
+`
+
+	SetSecondLookQuery = `
+UPDATE profiles SET second_look = $2 WHERE profile_id = $1;
+`
+)
+
+func (pr *ProfileRepo) GetSecondLook(profileId int) (model.SecondLook, error) {
+	var settings model.SecondLook
+	err := pr.DB.QueryRow(context.Background(), GetSecondLookQuery, profileId).Scan(&settings.Enabled, &settings.Recycled)
+	if err == pgx.ErrNoRows {
+		return settings, model.ErrProfileNotFound
+	}
+	return settings, err
+}
+
+// SetSecondLook turns on or off whether disliked profiles come back in the
+// deck of profileId once their dislike expires.
+func (pr *ProfileRepo) SetSecondLook(profileId int, enabled bool) error {
+	tag, err := pr.DB.Exec(context.Background(), SetSecondLookQuery, profileId, enabled)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrProfileNotFound
+	}
+	return nil
+}
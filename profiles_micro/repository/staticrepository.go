@@ -8,7 +8,11 @@ import (
 	"image/jpeg"
 	"image/png"
 	"io"
+	"net/url"
 	"os"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -20,11 +24,55 @@ type StaticRepository interface {
 	UploadImage(fileBytes []byte, filename, contentType string) error
 	DeleteImage(user_id int, filename string) error
 	GenerateImage(contentType string, ismale bool) ([]byte, error)
+	PresignUpload(key string, ttl time.Duration) (string, error)
+	PresignDownload(key string, ttl time.Duration) (string, error)
+	StatImage(key string) (int64, error)
 }
 
 type StaticRepo struct {
 	Client     *minio.Client
 	BucketName string
+	// Presigner signs URLs handed out to clients. It points at the public
+	// MinIO endpoint, since signatures cover the host.
+	Presigner *minio.Client
+}
+
+// PresignUpload returns a URL the client can PUT the object to directly.
+func (sr *StaticRepo) PresignUpload(key string, ttl time.Duration) (string, error) {
+	u, err := sr.presigner().PresignedPutObject(context.Background(), sr.BucketName, key, ttl)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign upload %s: %w", key, err)
+	}
+	return u.String(), nil
+}
+
+// PresignDownload returns a URL the client can GET the object from directly.
+func (sr *StaticRepo) PresignDownload(key string, ttl time.Duration) (string, error) {
+	u, err := sr.presigner().PresignedGetObject(context.Background(), sr.BucketName, key, ttl, url.Values{})
+	if err != nil {
+		return "", fmt.Errorf("failed to presign download %s: %w", key, err)
+	}
+	return u.String(), nil
+}
+
+// StatImage returns the size of a stored object, or model.ErrUploadNotFound
+// if there is none.
+func (sr *StaticRepo) StatImage(key string) (int64, error) {
+	info, err := sr.Client.StatObject(context.Background(), sr.BucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return 0, model.ErrUploadNotFound
+		}
+		return 0, fmt.Errorf("failed to stat object %s: %w", key, err)
+	}
+	return info.Size, nil
+}
+
+func (sr *StaticRepo) presigner() *minio.Client {
+	if sr.Presigner != nil {
+		return sr.Presigner
+	}
+	return sr.Client
 }
 
 func (sr *StaticRepo) UploadImage(fileBytes []byte, filename, contentType string) error {
@@ -76,6 +124,20 @@ func NewStaticRepo() (*StaticRepo, error) {
 		return &StaticRepo{}, err
 	}
 
+	// Presigning is done offline, so the region is fixed to keep the client
+	// from looking the bucket location up through the public endpoint.
+	presigner := minioClient
+	if publicEndpoint := os.Getenv("MINIO_PUBLIC_ENDPOINT"); publicEndpoint != "" {
+		presigner, err = minio.New(publicEndpoint, &minio.Options{
+			Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
+			Secure: os.Getenv("MINIO_PUBLIC_SSL") == "true",
+			Region: "us-east-1",
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	bucketName := "profile-photos"
 	ctx := context.Background()
 	exists, err := minioClient.BucketExists(ctx, bucketName)
@@ -92,6 +154,7 @@ func NewStaticRepo() (*StaticRepo, error) {
 	return &StaticRepo{
 		Client:     minioClient,
 		BucketName: bucketName,
+		Presigner:  presigner,
 	}, nil
 }
 
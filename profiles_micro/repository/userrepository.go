@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"slices"
-	"strconv"
 	"strings"
 	"time"
 
@@ -21,19 +20,68 @@ import (
 type ProfileRepository interface {
 	GetProfileById(userId int) (model.Profile, error)
 	StoreProfile(model.Profile) (int, error)
-	GetProfilesByUserId(forUserId int) ([]model.Profile, error)
+	GetProfilesByUserId(forUserId int, verifiedOnly bool) ([]model.Profile, error)
 	GetMatches(forUserId int) ([]model.Profile, error)
 	UpdateProfile(int, model.Profile) error
 	GetPhotos(userId int) ([]string, error)
 	DeletePhoto(userId int, url string) error
-	StorePhoto(userId int, url string) error
+	StorePhoto(userId int, url string, hash uint64) error
+	CountPhotos(userId int) (int, error)
+	HasSimilarPhoto(profileId int, hash uint64, maxDistance int) (bool, error)
+	GetOwnPhotos(userId int) ([]model.Photo, error)
+	GetModerationQueue(limit int) ([]model.PendingPhoto, error)
+	ModeratePhoto(photoId int, moderatorId int, approve bool, reason string) (int, error)
+	FindPhotoMatches(profileId int, hash uint64, maxDistance int) ([]model.PhotoMatch, error)
+	FlagStolenPhoto(profileId int, path string, match model.PhotoMatch) error
+	GetUnhashedPhotos(afterId int, limit int) ([]model.UnhashedPhoto, error)
+	SetPhotoHash(photoId int, hash uint64) error
+	GetPhotoClusters(maxDistance int, pairsLimit int) ([]model.PhotoCluster, error)
+	GetVerification(userId int) (model.Verification, error)
+	CreateVerification(profileId int, pose, selfieKey string, expiresAt time.Time) (int, error)
+	SubmitVerification(requestId int) error
+	GetVerificationQueue(limit int) ([]model.PendingVerification, error)
+	ReviewVerification(requestId int, reviewerId int, approve bool, reason string) (string, error)
+	GetPrompts() ([]model.Prompt, error)
+	GetCompleteness(profileId int) (model.Completeness, error)
+	GetProfileVersions(profileId int, limit int) ([]model.ProfileVersion, error)
+	GetProfileVersion(profileId int, versionId int) (model.ProfileVersion, error)
+	RevertProfile(profileId int, actorId int, snapshot model.ProfileSnapshot) error
+	Unmatch(profileId int, matchedId int, reason string) error
+	BlockProfile(profileId int, blockedId int, reason string) error
+	UnblockProfile(profileId int, blockedId int) error
+	GetBlocks(profileId int) ([]model.BlockedProfile, error)
+	IsBlocked(profileId int, otherId int) (bool, error)
+	ReorderPhotos(profileId int, paths []string) error
+	SetPrimaryPhoto(profileId int, path string) error
 	DeleteProfile(userId int) error
-	StorePhotos(profileId int, paths []string) error
-	StoreInterests(profileId int, interests []string) error
-	SetLike(from int, to int, status int) (int, error)
-	SearchProfiles(cur_user int, params model.SearchProfileRequest) ([]model.FoundProfile, error)
+	Autocomplete(dictionary, query, description string, limit int) ([]model.Suggestion, error)
+	SetLike(from int, to int, status int, comment model.LikeComment, idempotencyKey string) (int, bool, error)
+	DeleteStaleLikeRequests(ttl time.Duration) (int, error)
+	GetSecondLook(profileId int) (model.SecondLook, error)
+	SetSecondLook(profileId int, enabled bool) error
+	RewindLike(profileId int) (int, error)
+	LikeCommentTargetExists(profileId int, photo string, promptId int) (bool, error)
+	ExpireMatches() ([]model.MatchExpiry, error)
+	MarkExpiringMatches(window time.Duration) error
+	RemindMatches(reminders []time.Duration) ([]model.MatchExpiry, error)
+	ExtendMatch(profileId int, matchedId int, extension time.Duration, reminders []time.Duration) (model.MatchExpiry, error)
+	GetPendingMatches(profileId int) ([]model.MatchExpiry, error)
+	SearchProfiles(cur_user int, params model.SearchProfileRequest, page model.SearchPage) ([]model.FoundProfile, error)
+	SearchFacets(cur_user int, params model.SearchProfileRequest) (model.SearchFacets, error)
+	SaveSearch(search model.SavedSearch) (model.SavedSearch, error)
+	GetSavedSearches(profileId int) ([]model.SavedSearch, error)
+	DeleteSavedSearch(profileId int, searchId int) error
+	GetAlertSearches(limit int) ([]model.SavedSearch, error)
+	FindNewSearchHits(search model.SavedSearch, limit int) ([]int, time.Time, error)
+	RecordSearchHits(searchId int, hits []int, watermark time.Time) error
 	GetProfileStats(profileID int) (model.ProfileStats, error)
 	GetRecomendations(profileId int) (model.Profile, error)
+	GenerateTopPicks(limit int) (int, error)
+	GetInvalidTimezones() ([]model.ProfileTimezone, error)
+	GetTopPicks(profileId int) (model.TopPicks, error)
+	GetIncomingLikes(profileId int) ([]model.IncomingLike, error)
+	ActivateBoost(profileId int, duration time.Duration, useAllowance bool) (model.Boost, int, error)
+	GetBoostStats(profileId int) ([]model.Boost, error)
 	CloseRepo()
 }
 
@@ -159,7 +207,7 @@ const GetProfileByIdQuery = `
 WITH base_profile AS (
     SELECT 
         profile_id, firstname, lastname, is_male,
-        height, birthday, description, goal, location_id
+        height, birthday, description, goal, location_id, is_verified, timezone
     FROM profiles
     WHERE profile_id = $1
 )
@@ -183,10 +231,12 @@ SELECT
     param.parameter_description,
     param.parameter_value,
 	CASE WHEN sbs.sub_id IS NOT NULL THEN TRUE ELSE FALSE END AS premium_status,
-    sbs.border
+    sbs.border,
+    bp.is_verified,
+    bp.timezone,` + profilePromptsColumn + `
 FROM base_profile bp
 LEFT JOIN locations l ON bp.location_id = l.location_id
-LEFT JOIN "static" s ON bp.profile_id = s.profile_id
+LEFT JOIN "static" s ON bp.profile_id = s.profile_id AND s.moderation_status = 'approved'
 LEFT JOIN profile_interests pi ON pi.profile_id = bp.profile_id
 LEFT JOIN interests i ON pi.interest_id = i.interest_id
 LEFT JOIN profile_preferences pp ON pp.profile_id = bp.profile_id
@@ -194,11 +244,18 @@ LEFT JOIN preferences pr ON pp.preference_id = pr.preference_id
 LEFT JOIN profile_parameter pp2 ON pp2.profile_id = bp.profile_id
 LEFT JOIN parameters param ON pp2.parameter_id = param.parameter_id
 LEFT JOIN likes liked ON liked.liked_profile_id = bp.profile_id
-LEFT JOIN subscriptions sbs ON sbs.user_id = bp.profile_id AND sbs.expires_at > NOW();
+LEFT JOIN subscriptions sbs ON sbs.user_id = bp.profile_id AND sbs.expires_at > NOW()
+ORDER BY s.is_primary DESC NULLS LAST, s.position, s.id;
 
 `
 
 func (pr *ProfileRepo) GetProfileById(profileId int) (model.Profile, error) {
+	return getProfileById(context.Background(), pr.DB, profileId)
+}
+
+// getProfileById loads a profile through db, which may be a tx that has
+// just changed it.
+func getProfileById(ctx context.Context, db DBQuerier, profileId int) (model.Profile, error) {
 	var profile model.Profile
 	var birth sql.NullTime
 	var interest sql.NullString
@@ -213,9 +270,9 @@ func (pr *ProfileRepo) GetProfileById(profileId int) (model.Profile, error) {
 
 	var premiumStatus sql.NullBool
 	var premiumBorder sql.NullInt64
+	var prompts string
 
-	ctx := context.Background()
-	rows, err := pr.DB.Query(ctx, GetProfileByIdQuery, profileId)
+	rows, err := db.Query(ctx, GetProfileByIdQuery, profileId)
 
 	if err != nil {
 		return profile, err
@@ -244,10 +301,19 @@ func (pr *ProfileRepo) GetProfileById(profileId int) (model.Profile, error) {
 			&paramValue,
 			&premiumStatus,
 			&premiumBorder,
+			&profile.Verified,
+			&profile.Timezone,
+			&prompts,
 		); err != nil {
 			return profile, err
 		}
 
+		if profile.Prompts == nil {
+			if profile.Prompts, err = parsePrompts(prompts); err != nil {
+				return profile, err
+			}
+		}
+
 		if birth.Valid {
 			profile.Birthday = birth.Time
 		}
@@ -312,9 +378,17 @@ VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 RETURNING profile_id;
 `
 
+// StoreProfile creates a profile with its photos, interests and prompts and
+// records it as created, all in one tx.
 func (pr *ProfileRepo) StoreProfile(profile model.Profile) (profileId int, err error) {
 	ctx := context.Background()
 
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
 	var locationID *int
 	if profile.Location != "" {
 		parts := strings.Split(profile.Location, "@")
@@ -326,9 +400,9 @@ func (pr *ProfileRepo) StoreProfile(profile model.Profile) (profileId int, err e
 		district := strings.TrimSpace(parts[2])
 
 		var id int
-		err := pr.DB.QueryRow(ctx, GetLocationID, country, city, district).Scan(&id)
+		err := tx.QueryRow(ctx, GetLocationID, country, city, district).Scan(&id)
 		if err != nil {
-			err = pr.DB.QueryRow(ctx, InsertLocation, country, city, district).Scan(&id)
+			err = tx.QueryRow(ctx, InsertLocation, country, city, district).Scan(&id)
 			if err != nil {
 				return 0, fmt.Errorf("failed to insert/get location: %w", err)
 			}
@@ -336,7 +410,7 @@ func (pr *ProfileRepo) StoreProfile(profile model.Profile) (profileId int, err e
 		locationID = &id
 	}
 
-	err = pr.DB.QueryRow(
+	err = tx.QueryRow(
 		ctx,
 		CreateProfileQuery,
 		profile.FirstName,
@@ -348,13 +422,50 @@ func (pr *ProfileRepo) StoreProfile(profile model.Profile) (profileId int, err e
 		locationID,
 		profile.Goal,
 	).Scan(&profileId)
+	if err != nil {
+		return 0, err
+	}
 
-	return
+	if err := insertPhotos(ctx, tx, profileId, profile.Photos); err != nil {
+		return 0, err
+	}
+	if err := replaceInterests(ctx, tx, profileId, profile.Interests); err != nil {
+		return 0, err
+	}
+	if err := replacePrompts(ctx, tx, profileId, profile.Prompts); err != nil {
+		return 0, err
+	}
+	if err := recordVersion(ctx, tx, profileId, 0, model.VersionCreate); err != nil {
+		return 0, err
+	}
+
+	return profileId, tx.Commit(ctx)
 }
 
 const GetProfilesQuery = `
-WITH filtered_profiles AS (
-    SELECT p.profile_id
+WITH boosted_profiles AS (
+    SELECT DISTINCT b.profile_id, bp.completeness
+    FROM boosts b
+    JOIN users u ON u.profile_id = b.profile_id
+    JOIN profiles bp ON bp.profile_id = b.profile_id
+    LEFT JOIN likes liked
+        ON liked.liked_profile_id = b.profile_id AND liked.profile_id = $1
+    WHERE b.ends_at > NOW()
+      AND b.profile_id != $1
+      AND NOT EXISTS (
+          SELECT 1 FROM boost_views bv
+          WHERE bv.boost_id = b.boost_id AND bv.viewer_profile_id = $1
+      )
+      AND liked.profile_id IS NULL
+      AND u.user_id NOT IN (SELECT user_id FROM blacklist)
+      AND NOT profile_hidden_from(b.profile_id, $1)
+      AND ($5 = FALSE OR bp.is_verified)
+    LIMIT $4
+),
+-- Fresh profiles are ranked by their stored completeness before the page is
+-- cut, and the cursor ($6, $2) is the score and ID of the last one served.
+filtered_profiles AS (
+    SELECT p.profile_id, p.completeness
     FROM profiles p
     LEFT JOIN likes liked 
         ON liked.liked_profile_id = p.profile_id AND liked.profile_id = $1
@@ -362,9 +473,47 @@ WITH filtered_profiles AS (
     WHERE p.profile_id != $1
       AND liked.profile_id IS NULL
       AND u.user_id NOT IN (SELECT user_id FROM blacklist)
-      AND ($2 = 0 OR p.profile_id > $2)
-    ORDER BY p.profile_id
+      AND NOT profile_hidden_from(p.profile_id, $1)
+      -- Boosted profiles come through boosted_profiles only, once per boost.
+      AND NOT EXISTS (
+          SELECT 1 FROM boosts b
+          WHERE b.profile_id = p.profile_id AND b.ends_at > NOW()
+      )
+      AND ($5 = FALSE OR p.is_verified)
+),
+ranked_profiles AS (
+    SELECT profile_id, completeness
+    FROM filtered_profiles
+    WHERE $2 = 0 OR completeness < $6 OR (completeness = $6 AND profile_id > $2)
+    ORDER BY completeness DESC, profile_id
     LIMIT $3
+),
+-- Profiles whose dislike has expired fill what is left of the page.
+second_look_profiles AS (
+    SELECT p.profile_id, p.completeness
+    FROM likes disliked
+    JOIN profiles viewer ON viewer.profile_id = disliked.profile_id
+    JOIN profiles p ON p.profile_id = disliked.liked_profile_id
+    JOIN users u ON u.profile_id = p.profile_id
+    WHERE disliked.profile_id = $1
+      AND disliked.status = 2
+      AND disliked.expires_at <= NOW()
+      AND viewer.second_look
+      AND u.user_id NOT IN (SELECT user_id FROM blacklist)
+      AND NOT profile_hidden_from(p.profile_id, $1)
+      AND ($5 = FALSE OR p.is_verified)
+    ORDER BY disliked.expires_at
+    LIMIT GREATEST($3 - (SELECT COUNT(*) FROM ranked_profiles), 0)
+),
+scored_deck AS (
+    SELECT profile_id, TRUE AS is_boosted, FALSE AS is_recycled, completeness
+    FROM boosted_profiles
+    UNION ALL
+    SELECT profile_id, FALSE AS is_boosted, FALSE AS is_recycled, completeness
+    FROM ranked_profiles
+    UNION ALL
+    SELECT profile_id, FALSE AS is_boosted, TRUE AS is_recycled, completeness
+    FROM second_look_profiles
 )
 SELECT DISTINCT ON (p.profile_id)
     p.profile_id, 
@@ -385,11 +534,15 @@ SELECT DISTINCT ON (p.profile_id)
     param.parameter_description,
     param.parameter_value,
     CASE WHEN sbs.sub_id IS NOT NULL THEN TRUE ELSE FALSE END AS premium_status,
-    sbs.border
-FROM filtered_profiles fp
+    sbs.border,
+    fp.is_boosted,
+    p.is_verified,
+    fp.completeness,
+    fp.is_recycled
+FROM scored_deck fp
 JOIN profiles p ON p.profile_id = fp.profile_id
 LEFT JOIN locations l ON p.location_id = l.location_id
-LEFT JOIN "static" s ON p.profile_id = s.profile_id
+LEFT JOIN "static" s ON p.profile_id = s.profile_id AND s.moderation_status = 'approved'
 LEFT JOIN profile_interests pi ON pi.profile_id = p.profile_id
 LEFT JOIN interests i ON pi.interest_id = i.interest_id
 LEFT JOIN profile_preferences pp ON pp.profile_id = p.profile_id
@@ -397,36 +550,44 @@ LEFT JOIN preferences pr ON pp.preference_id = pr.preference_id
 LEFT JOIN profile_parameter pp2 ON pp2.profile_id = p.profile_id
 LEFT JOIN parameters param ON param.parameter_id = pp2.parameter_id
 LEFT JOIN subscriptions sbs ON sbs.user_id = p.profile_id AND sbs.expires_at > NOW()
-ORDER BY p.profile_id, i.description NULLS LAST;
+ORDER BY p.profile_id, s.is_primary DESC NULLS LAST, s.position, s.id, i.description NULLS LAST;
 
 `
 
-func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, error) {
+func (pr *ProfileRepo) GetProfilesByUserId(forUserId int, verifiedOnly bool) ([]model.Profile, error) {
 	const redisKeyFormat = "profiles_for_user:%d"
 	redisKey := fmt.Sprintf(redisKeyFormat, forUserId)
+	// The verified-only deck skips profiles, so it pages on its own.
+	if verifiedOnly {
+		redisKey += ":verified"
+	}
 
-	var lastSeenID int
+	// The cursor is "score:id" of the last fresh profile served. A profile
+	// whose score rises past the cursor waits until the cursor expires.
+	var lastSeenID, lastSeenScore int
 	ctx := context.Background()
 
 	result, err := pr.Client.Get(ctx, redisKey).Result()
-	lastSeenID = 0
 	if err == nil {
-		if id, convErr := strconv.Atoi(result); convErr == nil {
-			lastSeenID = id
+		if _, scanErr := fmt.Sscanf(result, "%d:%d", &lastSeenScore, &lastSeenID); scanErr != nil {
+			lastSeenID, lastSeenScore = 0, 0
 		}
 	} else if err != redis.Nil {
 		return nil, err
 	}
 
-	rows, err := pr.DB.Query(ctx, GetProfilesQuery, forUserId, lastSeenID, model.PageSize)
+	rows, err := pr.DB.Query(ctx, GetProfilesQuery, forUserId, lastSeenID, model.PageSize, model.BoostsPerDeck, verifiedOnly, lastSeenScore)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	profileMap := make(map[int]*model.Profile)
+	boosted := make(map[int]bool)
+	recycled := make(map[int]bool)
+	scores := make(map[int]int)
 
-	var maxProfileID int
+	cursorID, cursorScore := 0, 0
 
 	for rows.Next() {
 		var (
@@ -446,6 +607,10 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 			parameterDesc, parameterValue sql.NullString
 			premiumStatus                 sql.NullBool
 			premiumBorder                 sql.NullInt64
+			isBoosted                     bool
+			isVerified                    bool
+			completeness                  int
+			isRecycled                    bool
 		)
 
 		if err := rows.Scan(
@@ -468,12 +633,21 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 			&parameterValue,
 			&premiumStatus,
 			&premiumBorder,
+			&isBoosted,
+			&isVerified,
+			&completeness,
+			&isRecycled,
 		); err != nil {
 			return nil, err
 		}
 
-		if profileId > maxProfileID {
-			maxProfileID = profileId
+		scores[profileId] = completeness
+		if isBoosted {
+			boosted[profileId] = true
+		} else if isRecycled {
+			recycled[profileId] = true
+		} else if cursorID == 0 || completeness < cursorScore || (completeness == cursorScore && profileId > cursorID) {
+			cursorID, cursorScore = profileId, completeness
 		}
 
 		profile, exists := profileMap[profileId]
@@ -488,6 +662,7 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 				Interests:   []string{},
 				Preferences: []model.Preference{},
 				Photos:      []string{},
+				Verified:    isVerified,
 			}
 
 			if birth.Valid {
@@ -550,11 +725,42 @@ func (pr *ProfileRepo) GetProfilesByUserId(forUserId int) ([]model.Profile, erro
 		profiles = append(profiles, *p)
 	}
 
-	if maxProfileID > 0 {
+	// Boosted profiles go first and second looks last, then the more
+	// complete ones, in the order the query ranked fresh profiles.
+	slices.SortFunc(profiles, func(a, b model.Profile) int {
+		if boosted[a.ProfileId] != boosted[b.ProfileId] {
+			if boosted[a.ProfileId] {
+				return -1
+			}
+			return 1
+		}
+		if recycled[a.ProfileId] != recycled[b.ProfileId] {
+			if recycled[a.ProfileId] {
+				return 1
+			}
+			return -1
+		}
+		if scores[a.ProfileId] != scores[b.ProfileId] {
+			return scores[b.ProfileId] - scores[a.ProfileId]
+		}
+		return a.ProfileId - b.ProfileId
+	})
+
+	if len(boosted) > 0 {
+		boostedIDs := make([]int, 0, len(boosted))
+		for id := range boosted {
+			boostedIDs = append(boostedIDs, id)
+		}
+		if _, err := pr.DB.Exec(ctx, RecordBoostViewsQuery, forUserId, boostedIDs); err != nil {
+			return nil, fmt.Errorf("error recording boost views: %w", err)
+		}
+	}
+
+	if cursorID > 0 {
 		go func() {
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 			defer cancel()
-			_ = pr.Client.Set(ctx, redisKey, strconv.Itoa(maxProfileID), 10*time.Minute).Err()
+			_ = pr.Client.Set(ctx, redisKey, fmt.Sprintf("%d:%d", cursorScore, cursorID), 10*time.Minute).Err()
 		}()
 	}
 
@@ -566,7 +772,8 @@ SELECT
     profile_id, 
     matched_profile_id
 FROM matches
-WHERE profile_id = $1 OR matched_profile_id = $1;
+WHERE (profile_id = $1 OR matched_profile_id = $1)
+  AND state != 'expired';
 `
 
 func (pr *ProfileRepo) GetMatches(forUserId int) ([]model.Profile, error) {
@@ -619,7 +826,8 @@ SET
 	location_id = $6,
 	birthday = $7,    
 	goal = $8,
-	updated_at = CURRENT_TIMESTAMP
+	timezone = COALESCE(NULLIF($10, ''), timezone),
+	updated_at = NOW()
 WHERE profile_id = $9;
 
 `
@@ -648,8 +856,21 @@ INSERT INTO profile_preferences (profile_id, preference_id)
 VALUES ($1, $2)
 ON CONFLICT DO NOTHING
 `
+	// GetInterestIdByDescription resolves a submitted interest to its
+	// canonical dictionary entry: an exact case-insensitive match wins,
+	// otherwise the closest close-enough spelling, most popular first.
 	GetInterestIdByDescription = `
-SELECT interest_id FROM interests WHERE description = $1
+SELECT i.interest_id
+FROM interests i
+WHERE LOWER(i.description) = LOWER($1)
+   OR (LENGTH($1) >= 4
+       AND LOWER(i.description) % LOWER($1)
+       AND similarity(LOWER(i.description), LOWER($1)) >= 0.6)
+ORDER BY LOWER(i.description) = LOWER($1) DESC,
+         similarity(LOWER(i.description), LOWER($1)) DESC,
+         (SELECT COUNT(*) FROM profile_interests pi WHERE pi.interest_id = i.interest_id) DESC,
+         i.interest_id
+LIMIT 1
 `
 	InsertProfileInterest = `
 INSERT INTO profile_interests (profile_id, interest_id)
@@ -658,8 +879,8 @@ ON CONFLICT DO NOTHING
 `
 
 	InsertStaticPhoto = `
-INSERT INTO static (profile_id, path)
-VALUES ($1, $2)
+INSERT INTO static (profile_id, path, position, is_primary)
+VALUES ($1, $2, $3, $3 = 0)
 `
 	InsertInterestIfNotExists = `
 INSERT INTO interests (description)
@@ -699,6 +920,10 @@ ON CONFLICT DO NOTHING
 `
 )
 
+// UpdateProfile writes newProfile over the stored one and records the result
+// as an update by the profile's owner. Empty lists leave the stored ones as
+// they are, except for prompts: a nil slice keeps them and an empty one
+// clears them.
 func (pr *ProfileRepo) UpdateProfile(profileID int, newProfile model.Profile) error {
 	ctx := context.Background()
 
@@ -708,6 +933,46 @@ func (pr *ProfileRepo) UpdateProfile(profileID int, newProfile model.Profile) er
 	}
 	defer tx.Rollback(ctx)
 
+	if err := updateProfileFields(ctx, tx, profileID, newProfile); err != nil {
+		return err
+	}
+
+	if len(newProfile.Interests) != 0 {
+		if err := replaceInterests(ctx, tx, profileID, newProfile.Interests); err != nil {
+			return err
+		}
+	}
+
+	if len(newProfile.Preferences) != 0 {
+		if err := replacePreferences(ctx, tx, profileID, newProfile.Preferences); err != nil {
+			return err
+		}
+	}
+
+	if len(newProfile.Parameters) != 0 {
+		if err := replaceParameters(ctx, tx, profileID, newProfile.Parameters); err != nil {
+			return err
+		}
+	}
+
+	if newProfile.Prompts != nil {
+		if err := replacePrompts(ctx, tx, profileID, newProfile.Prompts); err != nil {
+			return err
+		}
+	}
+
+	if err := recordVersion(ctx, tx, profileID, profileID, model.VersionUpdate); err != nil {
+		return fmt.Errorf("failed to record profile version: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func updateProfileFields(ctx context.Context, tx pgx.Tx, profileID int, newProfile model.Profile) error {
 	var locationID int
 	if newProfile.Location != "" {
 		parts := strings.Split(newProfile.Location, "@")
@@ -725,7 +990,7 @@ func (pr *ProfileRepo) UpdateProfile(profileID int, newProfile model.Profile) er
 		}
 	}
 
-	_, err = tx.Exec(ctx,
+	_, err := tx.Exec(ctx,
 		UpdateProfileQuery,
 		newProfile.FirstName,
 		newProfile.LastName,
@@ -736,82 +1001,89 @@ func (pr *ProfileRepo) UpdateProfile(profileID int, newProfile model.Profile) er
 		newProfile.Birthday,
 		newProfile.Goal,
 		profileID,
+		newProfile.Timezone,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update profile: %w", err)
 	}
 
-	if len(newProfile.Interests) != 0 {
-		if _, err := tx.Exec(ctx, DeleteProfileInterests, profileID); err != nil {
-			return fmt.Errorf("failed to delete old interests: %w", err)
-		}
+	return nil
+}
 
-		for _, desc := range newProfile.Interests {
-			var interestID int
+func replaceInterests(ctx context.Context, tx pgx.Tx, profileID int, interests []string) error {
+	if _, err := tx.Exec(ctx, DeleteProfileInterests, profileID); err != nil {
+		return fmt.Errorf("failed to delete old interests: %w", err)
+	}
 
-			err := tx.QueryRow(ctx, GetInterestIdByDescription, desc).Scan(&interestID)
-			if err != nil {
-				err = tx.QueryRow(ctx, InsertInterestIfNotExists, desc).Scan(&interestID)
-				if err != nil {
-					return fmt.Errorf("failed to insert new interest '%s': %w", desc, err)
-				}
-			}
+	for _, desc := range interests {
+		desc = NormalizeDictionaryValue(desc)
+		if desc == "" {
+			continue
+		}
+		var interestID int
 
-			_, err = tx.Exec(ctx, InsertProfileInterest, profileID, interestID)
+		err := tx.QueryRow(ctx, GetInterestIdByDescription, desc).Scan(&interestID)
+		if err != nil {
+			err = tx.QueryRow(ctx, InsertInterestIfNotExists, desc).Scan(&interestID)
 			if err != nil {
-				return fmt.Errorf("failed to insert profile interest: %w", err)
+				return fmt.Errorf("failed to insert new interest '%s': %w", desc, err)
 			}
 		}
-	}
 
-	if len(newProfile.Preferences) != 0 {
-		if _, err := tx.Exec(ctx, DeleteProfilePreferences, profileID); err != nil {
-			return fmt.Errorf("failed to delete old preferences: %w", err)
+		_, err = tx.Exec(ctx, InsertProfileInterest, profileID, interestID)
+		if err != nil {
+			return fmt.Errorf("failed to insert profile interest: %w", err)
 		}
+	}
 
-		for _, pref := range newProfile.Preferences {
-			var preferenceID int
+	return nil
+}
 
-			err := tx.QueryRow(ctx, GetPreferenceIDByFields, 1, pref.Description, pref.Value).Scan(&preferenceID)
-			if err != nil {
-				err = tx.QueryRow(ctx, InsertPreferenceIfNotExists, 1, pref.Description, pref.Value).Scan(&preferenceID)
-				if err != nil {
-					return fmt.Errorf("failed to insert preference %+v: %w", pref, err)
-				}
-			}
+func replacePreferences(ctx context.Context, tx pgx.Tx, profileID int, preferences []model.Preference) error {
+	if _, err := tx.Exec(ctx, DeleteProfilePreferences, profileID); err != nil {
+		return fmt.Errorf("failed to delete old preferences: %w", err)
+	}
 
-			_, err = tx.Exec(ctx, InsertProfilePreference, profileID, preferenceID)
+	for _, pref := range preferences {
+		var preferenceID int
+
+		err := tx.QueryRow(ctx, GetPreferenceIDByFields, 1, pref.Description, pref.Value).Scan(&preferenceID)
+		if err != nil {
+			err = tx.QueryRow(ctx, InsertPreferenceIfNotExists, 1, pref.Description, pref.Value).Scan(&preferenceID)
 			if err != nil {
-				return fmt.Errorf("failed to insert profile preference: %w", err)
+				return fmt.Errorf("failed to insert preference %+v: %w", pref, err)
 			}
 		}
-	}
 
-	if len(newProfile.Parameters) != 0 {
-		if _, err := tx.Exec(ctx, DeleteProfileParameters, profileID); err != nil {
-			return fmt.Errorf("failed to delete old parameters: %w", err)
+		_, err = tx.Exec(ctx, InsertProfilePreference, profileID, preferenceID)
+		if err != nil {
+			return fmt.Errorf("failed to insert profile preference: %w", err)
 		}
+	}
 
-		for _, pref := range newProfile.Parameters {
-			var parameterID int
+	return nil
+}
 
-			err := tx.QueryRow(ctx, GetParameterIDByFields, 1, pref.Description, pref.Value).Scan(&parameterID)
-			if err != nil {
-				err = tx.QueryRow(ctx, InsertParameterIfNotExists, 1, pref.Description, pref.Value).Scan(&parameterID)
-				if err != nil {
-					return fmt.Errorf("failed to insert parameter %+v: %w", pref, err)
-				}
-			}
+func replaceParameters(ctx context.Context, tx pgx.Tx, profileID int, parameters []model.Preference) error {
+	if _, err := tx.Exec(ctx, DeleteProfileParameters, profileID); err != nil {
+		return fmt.Errorf("failed to delete old parameters: %w", err)
+	}
+
+	for _, pref := range parameters {
+		var parameterID int
 
-			_, err = tx.Exec(ctx, InsertProfileParameter, profileID, parameterID)
+		err := tx.QueryRow(ctx, GetParameterIDByFields, 1, pref.Description, pref.Value).Scan(&parameterID)
+		if err != nil {
+			err = tx.QueryRow(ctx, InsertParameterIfNotExists, 1, pref.Description, pref.Value).Scan(&parameterID)
 			if err != nil {
-				return fmt.Errorf("failed to insert profile parameter: %w", err)
+				return fmt.Errorf("failed to insert parameter %+v: %w", pref, err)
 			}
 		}
-	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		_, err = tx.Exec(ctx, InsertProfileParameter, profileID, parameterID)
+		if err != nil {
+			return fmt.Errorf("failed to insert profile parameter: %w", err)
+		}
 	}
 
 	return nil
@@ -821,7 +1093,8 @@ const GetPhotoPathsQuery = `
 SELECT path FROM static 
 WHERE profile_id = (
 	SELECT profile_id FROM users WHERE user_id = $1
-);
+)
+ORDER BY is_primary DESC, position, id;
 `
 
 func (pr *ProfileRepo) GetPhotos(userID int) ([]string, error) {
@@ -850,11 +1123,32 @@ func (pr *ProfileRepo) GetPhotos(userID int) ([]string, error) {
 const (
 	DeleteStaticQuery = `
 	DELETE FROM "static" WHERE profile_id = $1 AND path = $2;
+`
+
+	// PromotePrimaryPhotoQuery picks a new primary photo once the old one is
+	// gone: the first remaining photo in order.
+	PromotePrimaryPhotoQuery = `
+UPDATE static SET is_primary = TRUE
+WHERE id = (
+    SELECT id FROM static
+    WHERE profile_id = $1
+    ORDER BY position, id
+    LIMIT 1
+)
+AND NOT EXISTS (SELECT 1 FROM static WHERE profile_id = $1 AND is_primary);
 `
 )
 
 func (pr *ProfileRepo) DeletePhoto(profileID int, url string) error {
-	cmdTag, err := pr.DB.Exec(context.Background(), DeleteStaticQuery, profileID, "/"+url)
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	cmdTag, err := tx.Exec(ctx, DeleteStaticQuery, profileID, "/"+url)
 	if err != nil {
 		return fmt.Errorf("error deleting photo: %w", err)
 	}
@@ -863,18 +1157,175 @@ func (pr *ProfileRepo) DeletePhoto(profileID int, url string) error {
 		return fmt.Errorf("no photo found to delete")
 	}
 
-	return nil
+	_, err = tx.Exec(ctx, PromotePrimaryPhotoQuery, profileID)
+	if err != nil {
+		return fmt.Errorf("error promoting primary photo: %w", err)
+	}
+
+	if err := recordVersion(ctx, tx, profileID, profileID, model.VersionPhotos); err != nil {
+		return fmt.Errorf("error recording profile version: %w", err)
+	}
+
+	return tx.Commit(ctx)
 }
 
+// UploadPhotoQuery appends a photo after the existing ones, making it
+// primary if the profile has none. Nothing is inserted once the profile
+// already holds $3 photos; rejected ones do not take up a slot. The photo
+// waits for moderation until an admin approves it. It runs after
+// LockProfileQuery, so concurrent uploads see each other's rows and cannot
+// overshoot the limit or both claim the primary slot.
 const UploadPhotoQuery = `
-INSERT INTO static (profile_id, path, created_at, updated_at)
-VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-RETURNING profile_id, path, created_at;
+INSERT INTO static (profile_id, path, position, is_primary, phash, created_at, updated_at)
+SELECT $1, $2,
+       COALESCE((SELECT MAX(position) + 1 FROM static WHERE profile_id = $1), 0),
+       NOT EXISTS (SELECT 1 FROM static WHERE profile_id = $1 AND is_primary),
+       $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+WHERE (
+    SELECT COUNT(*) FROM static
+    WHERE profile_id = $1 AND moderation_status <> 'rejected'
+) < $3;
+`
+
+const CountPhotosQuery = `
+SELECT COUNT(*) FROM static WHERE profile_id = $1 AND moderation_status <> 'rejected';
 `
 
-func (pr *ProfileRepo) StorePhoto(userID int, url string) error {
-	_, err := pr.DB.Exec(context.Background(), UploadPhotoQuery, userID, url)
-	return err
+func (pr *ProfileRepo) StorePhoto(userID int, url string, hash uint64) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var lockedID int
+	if err := tx.QueryRow(ctx, LockProfileQuery, userID).Scan(&lockedID); err != nil {
+		return fmt.Errorf("error locking profile: %w", err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, UploadPhotoQuery, userID, url, model.MaxPhotosPerProfile, int64(hash))
+	if err != nil {
+		return err
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return model.ErrTooManyPhotos
+	}
+	return tx.Commit(ctx)
+}
+
+func (pr *ProfileRepo) CountPhotos(userID int) (int, error) {
+	var count int
+	err := pr.DB.QueryRow(context.Background(), CountPhotosQuery, userID).Scan(&count)
+	return count, err
+}
+
+const (
+	LockProfilePhotosQuery = `
+SELECT path FROM static WHERE profile_id = $1 FOR UPDATE;
+`
+
+	ReorderPhotosQuery = `
+UPDATE static s
+SET position = o.ord - 1
+FROM UNNEST($2::text[]) WITH ORDINALITY AS o(path, ord)
+WHERE s.profile_id = $1 AND s.path = o.path;
+`
+
+	ClearPrimaryPhotoQuery = `
+UPDATE static SET is_primary = FALSE WHERE profile_id = $1 AND is_primary;
+`
+
+	SetPrimaryPhotoQuery = `
+UPDATE static SET is_primary = TRUE WHERE profile_id = $1 AND path = $2;
+`
+)
+
+// ReorderPhotos sets photo positions to the order of paths, which must list
+// every photo of the profile exactly once.
+func (pr *ProfileRepo) ReorderPhotos(profileID int, paths []string) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := lockPhotos(ctx, tx, profileID)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) != len(current) {
+		return model.ErrInvalidPhotoOrder
+	}
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if !current[path] || seen[path] {
+			return model.ErrInvalidPhotoOrder
+		}
+		seen[path] = true
+	}
+
+	if _, err := tx.Exec(ctx, ReorderPhotosQuery, profileID, paths); err != nil {
+		return err
+	}
+	if err := recordVersion(ctx, tx, profileID, profileID, model.VersionPhotos); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (pr *ProfileRepo) SetPrimaryPhoto(profileID int, path string) error {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := lockPhotos(ctx, tx, profileID)
+	if err != nil {
+		return err
+	}
+	if !current[path] {
+		return model.ErrPhotoNotFound
+	}
+
+	if _, err := tx.Exec(ctx, ClearPrimaryPhotoQuery, profileID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, SetPrimaryPhotoQuery, profileID, path); err != nil {
+		return err
+	}
+	if err := recordVersion(ctx, tx, profileID, profileID, model.VersionPhotos); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func lockPhotos(ctx context.Context, tx pgx.Tx, profileID int) (map[string]bool, error) {
+	rows, err := tx.Query(ctx, LockProfilePhotosQuery, profileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	photos := make(map[string]bool)
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		photos[path] = true
+	}
+
+	return photos, rows.Err()
 }
 
 func (pr *ProfileRepo) CloseRepo() {
@@ -889,137 +1340,175 @@ const (
 	WHERE profile_id = $1 AND liked_profile_id = $2 ;
 	`
 
+	// Swiping again on a profile whose dislike had expired is a second look,
+	// which the new like records in recycled_at.
 	CreateLikeQuery = `
-	INSERT INTO likes (profile_id, liked_profile_id, created_at, status)
-	VALUES ($1, $2, CURRENT_TIMESTAMP, $3)
+	INSERT INTO likes (profile_id, liked_profile_id, created_at, status, comment, comment_photo, comment_prompt_id, expires_at)
+	VALUES ($1, $2, CURRENT_TIMESTAMP, $3, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, 0), $7)
 	ON CONFLICT (profile_id, liked_profile_id)
 	DO UPDATE SET
     	status = EXCLUDED.status,
-    	created_at = CURRENT_TIMESTAMP
+    	created_at = CURRENT_TIMESTAMP,
+    	comment = EXCLUDED.comment,
+    	comment_photo = EXCLUDED.comment_photo,
+    	comment_prompt_id = EXCLUDED.comment_prompt_id,
+    	expires_at = EXCLUDED.expires_at,
+    	recycled_at = CASE
+    		WHEN likes.status = 2 AND likes.expires_at <= NOW()
+    		     AND (SELECT second_look FROM profiles WHERE profile_id = likes.profile_id)
+    		THEN NOW()
+    	END
 	RETURNING like_id;
 
 	`
 
+	// A pair that matches again revives its expired match, whichever side
+	// made it. It returns the number of matches made or revived.
 	CreateMatchQuery = `
-	INSERT INTO matches (profile_id, matched_profile_id, created_at)
-	VALUES ($1, $2, CURRENT_TIMESTAMP)
+	WITH pair AS (
+		SELECT 1 FROM matches
+		WHERE (profile_id = $1 AND matched_profile_id = $2)
+		   OR (profile_id = $2 AND matched_profile_id = $1)
+	), revived AS (
+		UPDATE matches SET
+			created_at = CURRENT_TIMESTAMP,
+			state = 'created',
+			expires_at = $3,
+			extended = FALSE,
+			reminders_sent = 0,
+			archived_at = NULL
+		WHERE ((profile_id = $1 AND matched_profile_id = $2)
+		    OR (profile_id = $2 AND matched_profile_id = $1))
+		  AND state = 'expired'
+		RETURNING 1
+	), created AS (
+		INSERT INTO matches (profile_id, matched_profile_id, created_at, state, expires_at)
+		SELECT $1, $2, CURRENT_TIMESTAMP, 'created', $3
+		WHERE NOT EXISTS (SELECT 1 FROM pair)
+		ON CONFLICT (profile_id, matched_profile_id) DO NOTHING
+		RETURNING 1
+	)
+	SELECT (SELECT COUNT(*) FROM revived) + (SELECT COUNT(*) FROM created);
 	`
 	DeleteMatchQuery = `DELETE FROM matches WHERE 
 				(profile_id = $1 AND matched_profile_id = $2) OR 
 				(profile_id = $2 AND matched_profile_id = $1)`
 )
 
-func (pr *ProfileRepo) SetLike(from int, to int, status int) (likeID int, err error) {
-	var existingID int
-	var existing_status int
-	err = pr.DB.QueryRow(
-		context.Background(),
-		CreateLikeQuery,
-		from,
-		to,
-		status,
-	).Scan(&likeID)
-
-	if err != nil {
-		return 0, fmt.Errorf("error inserting like: %w", err)
+// dislikeExpiry is when a like of status stops keeping the liked profile out
+// of the deck. Only dislikes expire.
+func dislikeExpiry(status int) *time.Time {
+	if status != 2 {
+		return nil
 	}
+	expiresAt := time.Now().Add(model.DislikeTTL)
+	return &expiresAt
+}
 
-	if status == 3 {
-		err = pr.DB.QueryRow(context.Background(), CheckLikeExistsQuery, to, from).Scan(&existingID, &existing_status)
-		if err == pgx.ErrNoRows {
-			_, err = pr.DB.Exec(
-				context.Background(),
-				CreateLikeQuery,
-				to,
-				from,
-				1,
-			)
-			if err != nil {
-				return likeID, fmt.Errorf("error inserting reverse like: %w", err)
-			}
-		} else if err != nil {
-			return likeID, fmt.Errorf("error checking reverse like: %w", err)
-		}
-
-		_, err = pr.DB.Exec(
-			context.Background(),
-			CreateMatchQuery,
-			from,
-			to,
-		)
-		if err != nil {
-			return likeID, fmt.Errorf("error creating match: %w", err)
-		}
+// SetLike stores a like, dislike (status 2) or superlike (status 3) and
+// returns -1 as the like id when it makes a new match. It runs in one
+// transaction that holds the pair of profiles, so two profiles liking each
+// other at once get exactly one match, queued once in the outbox.
+//
+// With an idempotency key a repeated request changes nothing and returns
+// the first result with replayed set.
+func (pr *ProfileRepo) SetLike(from int, to int, status int, comment model.LikeComment, idempotencyKey string) (likeID int, replayed bool, err error) {
+	ctx := context.Background()
 
-		likeID = -1
-		return likeID, nil
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return 0, false, err
 	}
+	defer tx.Rollback(ctx)
 
-	var reverseStatus int
-	err = pr.DB.QueryRow(context.Background(), CheckLikeExistsQuery, to, from).Scan(&existingID, &reverseStatus)
-	if err == nil && reverseStatus == 1 && status == 1 {
-		_, err = pr.DB.Exec(
-			context.Background(),
-			CreateMatchQuery,
-			from,
-			to,
-		)
+	if idempotencyKey != "" {
+		storedID, found, err := claimLikeRequest(ctx, tx, from, to, status, idempotencyKey)
 		if err != nil {
-			return likeID, fmt.Errorf("error creating match: %w", err)
+			return 0, false, err
 		}
-		likeID = -1
-	}
-
-	if status == 2 {
-		_, err = pr.DB.Exec(
-			context.Background(),
-			DeleteMatchQuery,
-			from, to,
-		)
-		if err != nil {
-			return likeID, fmt.Errorf("error deleting match on dislike: %w", err)
+		if found {
+			return storedID, true, nil
 		}
 	}
 
-	return likeID, nil
-}
-
-func (pr *ProfileRepo) StoreInterests(profileID int, interests []string) error {
-	ctx := context.Background()
+	if _, err := tx.Exec(ctx, LockLikePairQuery, from, to); err != nil {
+		return 0, false, fmt.Errorf("error locking like pair: %w", err)
+	}
 
-	tx, err := pr.DB.Begin(ctx)
+	err = tx.QueryRow(
+		ctx,
+		CreateLikeQuery,
+		from,
+		to,
+		status,
+		comment.Text,
+		comment.Photo,
+		comment.PromptId,
+		dislikeExpiry(status),
+	).Scan(&likeID)
 	if err != nil {
-		return err
+		return 0, false, fmt.Errorf("error inserting like: %w", err)
 	}
-	defer tx.Rollback(ctx)
 
-	for _, desc := range interests {
-		var interestID int
+	if _, err := tx.Exec(ctx, RecordSecondLookQuery, from, to); err != nil {
+		return 0, false, fmt.Errorf("error recording second look: %w", err)
+	}
 
-		err := tx.QueryRow(ctx, GetInterestIdByDescription, desc).Scan(&interestID)
-		if err != nil {
-			err = tx.QueryRow(ctx, InsertInterestIfNotExists, desc).Scan(&interestID)
+	var (
+		existingID    int
+		reverseStatus int
+		matched       bool
+	)
+	switch status {
+	case 2:
+		if _, err := tx.Exec(ctx, DeleteMatchQuery, from, to); err != nil {
+			return 0, false, fmt.Errorf("error deleting match on dislike: %w", err)
+		}
+	case 3:
+		err = tx.QueryRow(ctx, CheckLikeExistsQuery, to, from).Scan(&existingID, &reverseStatus)
+		if err == pgx.ErrNoRows {
+			_, err = tx.Exec(ctx, CreateLikeQuery, to, from, 1, "", "", 0, dislikeExpiry(1))
 			if err != nil {
-				return err
+				return 0, false, fmt.Errorf("error inserting reverse like: %w", err)
 			}
+		} else if err != nil {
+			return 0, false, fmt.Errorf("error checking reverse like: %w", err)
 		}
 
-		_, err = tx.Exec(ctx, InsertProfileInterest, profileID, interestID)
-		if err != nil {
-			return err
+		if matched, err = createMatch(ctx, tx, from, to); err != nil {
+			return 0, false, fmt.Errorf("error creating match: %w", err)
 		}
+	default:
+		err = tx.QueryRow(ctx, CheckLikeExistsQuery, to, from).Scan(&existingID, &reverseStatus)
+		if err != nil && err != pgx.ErrNoRows {
+			return 0, false, fmt.Errorf("error checking reverse like: %w", err)
+		}
+		if err == nil && reverseStatus == 1 && status == 1 {
+			if matched, err = createMatch(ctx, tx, from, to); err != nil {
+				return 0, false, fmt.Errorf("error creating match: %w", err)
+			}
+		}
+	}
+	if matched {
+		likeID = -1
+	}
 
+	if idempotencyKey != "" {
+		if _, err := tx.Exec(ctx, StoreLikeResultQuery, from, idempotencyKey, likeID); err != nil {
+			return 0, false, fmt.Errorf("error storing like result: %w", err)
+		}
 	}
 
-	return tx.Commit(ctx)
+	return likeID, false, tx.Commit(ctx)
 }
 
-func (pr *ProfileRepo) StorePhotos(profileID int, paths []string) error {
-	ctx := context.Background()
+func insertPhotos(ctx context.Context, tx pgx.Tx, profileID int, paths []string) error {
+	if len(paths) > model.MaxPhotosPerProfile {
+		return model.ErrTooManyPhotos
+	}
 
-	for _, path := range paths {
-		_, err := pr.DB.Exec(ctx, InsertStaticPhoto, profileID, path)
-		if err != nil {
+	for i, path := range paths {
+		if _, err := tx.Exec(ctx, InsertStaticPhoto, profileID, path, i); err != nil {
 			return err
 		}
 	}
@@ -1053,17 +1542,31 @@ func (pr *ProfileRepo) DeleteProfile(userId int) error {
 	return nil
 }
 
-const SearchProfilesQuery = `
+// searchFilterCTE selects every profile matching the search filters for
+// viewer $1. It is shared by the page query and the facet query so that
+// facet counts always describe the same result set.
+const searchFilterCTE = `
 WITH filtered_profiles AS (
-    SELECT DISTINCT p.profile_id, p.firstname, p.lastname, p.birthday, p.goal,
-           s.path AS avatar
+    SELECT p.profile_id, p.firstname, p.lastname, p.fullname_translit,
+           p.description, p.prompt_answers, p.birthday, p.goal, p.location_id, p.updated_at, u.user_id,
+           p.is_verified,
+           (CASE WHEN $11 = '' THEN 0 ELSE
+               ts_rank(profile_search_document(p.firstname, p.lastname, p.fullname_translit, p.description, p.prompt_answers), profile_search_query($11))
+               + GREATEST(
+                   word_similarity($11, p.firstname || ' ' || p.lastname),
+                   word_similarity(translit($11), COALESCE(p.fullname_translit, '')),
+                   word_similarity($11, COALESCE(p.fullname_translit, '')),
+                   word_similarity($11, COALESCE(p.description, '')) / 2,
+                   word_similarity($11, p.prompt_answers) / 2
+               )
+           END)::float8 AS text_rank
     FROM profiles p
     JOIN users u ON u.profile_id = p.profile_id
-    LEFT JOIN "static" s ON s.profile_id = p.profile_id
     LEFT JOIN likes liked ON liked.liked_profile_id = p.profile_id AND liked.profile_id = $1
     WHERE p.profile_id != $1
       AND liked.profile_id IS NULL
       AND u.user_id NOT IN (SELECT user_id FROM blacklist)
+      AND NOT profile_hidden_from(p.profile_id, $1)
       AND (
           $2 = '' OR $2 = 'Any' OR
           (p.is_male = CASE 
@@ -1118,32 +1621,125 @@ WITH filtered_profiles AS (
       )
       AND (
           $11 = '' OR (
-              similarity((p.firstname || ' ' || p.lastname), $11) > 0.3
+              profile_search_document(p.firstname, p.lastname, p.fullname_translit, p.description, p.prompt_answers) @@ profile_search_query($11)
+              OR similarity((p.firstname || ' ' || p.lastname), $11) > 0.3
               OR similarity(p.fullname_translit, $11) > 0.3
-              OR to_tsvector('russian', (p.firstname || ' ' || p.lastname)) @@ plainto_tsquery('russian', $11)
-              OR to_tsvector('english', (p.firstname || ' ' || p.lastname)) @@ plainto_tsquery('english', $11)
+              OR word_similarity($11, p.firstname || ' ' || p.lastname) > 0.5
+              OR word_similarity($11, p.fullname_translit) > 0.5
+              OR word_similarity(translit($11), p.fullname_translit) > 0.5
+              OR word_similarity($11, p.description) > 0.6
+              OR word_similarity($11, p.prompt_answers) > 0.6
               OR LOWER(p.firstname) LIKE LOWER($11 || '%')
               OR LOWER(p.lastname) LIKE LOWER($11 || '%')
           )
       )
+      /* filter */
+)`
+
+// SearchProfilesQuery pages through the filtered profiles. Every sort key is
+// mapped to an ascending sort_value so that a single (sort_value, profile_id)
+// keyset cursor works for all of them:
+//   - relevance: text rank plus shared interests, scaled down for
+//     incomplete profiles, best first;
+//   - age: youngest first;
+//   - distance: same district, city, country, then everyone else;
+//   - recent: latest session or profile update first.
+const SearchProfilesQuery = searchFilterCTE + `,
+viewer_location AS (
+    SELECT l.country, l.city, l.district
+    FROM profiles vp
+    JOIN locations l ON l.location_id = vp.location_id
+    WHERE vp.profile_id = $1
+),
+ranked AS (
+    SELECT
+        fp.profile_id,
+        fp.firstname,
+        fp.lastname,
+        fp.description,
+        fp.prompt_answers,
+        fp.birthday,
+        fp.goal,
+        fp.is_verified,
+        (CASE $12
+            WHEN 'age' THEN -EXTRACT(EPOCH FROM fp.birthday)
+            WHEN 'distance' THEN (
+                SELECT CASE
+                    WHEN l.district = vl.district AND l.city = vl.city AND l.country = vl.country THEN 0
+                    WHEN l.city = vl.city AND l.country = vl.country THEN 1
+                    WHEN l.country = vl.country THEN 2
+                    ELSE 3
+                END
+                FROM locations l, viewer_location vl
+                WHERE l.location_id = fp.location_id
+            )
+            WHEN 'recent' THEN -EXTRACT(EPOCH FROM GREATEST(
+                fp.updated_at,
+                (SELECT MAX(se.created_at) FROM sessions se WHERE se.user_id = fp.user_id)
+            ))
+            ELSE -(
+                fp.text_rank
+                + (
+                    SELECT COUNT(*) FROM profile_interests pi1
+                    JOIN profile_interests pi2 ON pi2.interest_id = pi1.interest_id
+                    WHERE pi1.profile_id = $1 AND pi2.profile_id = fp.profile_id
+                )
+            ) * completeness_factor(fp.profile_id)
+        END)::float8 AS sort_value
+    FROM filtered_profiles fp
 )
-SELECT DISTINCT ON (profile_id)
-    profile_id AS "IDUser",
-    avatar AS "FirstImg",
-    firstname || ' ' || lastname AS "Fullname",
-    FLOOR(DATE_PART('year', AGE(CURRENT_DATE, birthday)))::int AS "Age",
-    goal AS "Goal"
-FROM filtered_profiles
-ORDER BY profile_id
-LIMIT 20;
-
+SELECT
+    r.profile_id AS "IDUser",
+    COALESCE(profile_avatar(r.profile_id), '') AS "FirstImg",
+    r.firstname || ' ' || r.lastname AS "Fullname",
+    FLOOR(DATE_PART('year', AGE(CURRENT_DATE, r.birthday)))::int AS "Age",
+    r.goal AS "Goal",
+    COALESCE(r.sort_value, 'Infinity'::float8) AS "SortValue",
+    CASE WHEN $11 = '' THEN '' ELSE
+        search_headline(r.firstname || ' ' || r.lastname, $11, 'HighlightAll=true')
+    END AS "NameHighlight",
+    CASE WHEN $11 = '' OR COALESCE(r.description, '') || r.prompt_answers = '' THEN '' ELSE
+        search_headline(concat_ws(' … ', NULLIF(r.description, ''), NULLIF(r.prompt_answers, '')), $11, 'MaxWords=20, MinWords=5, MaxFragments=2, FragmentDelimiter=" … "')
+    END AS "Snippet",
+    r.is_verified AS "Verified"
+FROM ranked r
+WHERE $13::float8 IS NULL
+   OR (COALESCE(r.sort_value, 'Infinity'::float8), r.profile_id) > ($13::float8, $14)
+ORDER BY COALESCE(r.sort_value, 'Infinity'::float8), r.profile_id
+LIMIT $15;
+`
 
+const SearchFacetsQuery = searchFilterCTE + `
+SELECT 'goal' AS facet, fp.goal::text AS value, COUNT(*)::int AS cnt
+FROM filtered_profiles fp
+GROUP BY fp.goal
+UNION ALL
+SELECT 'age', CASE
+        WHEN DATE_PART('year', AGE(CURRENT_DATE, fp.birthday)) < 25 THEN '18-24'
+        WHEN DATE_PART('year', AGE(CURRENT_DATE, fp.birthday)) < 35 THEN '25-34'
+        WHEN DATE_PART('year', AGE(CURRENT_DATE, fp.birthday)) < 45 THEN '35-44'
+        ELSE '45+'
+    END, COUNT(*)::int
+FROM filtered_profiles fp
+GROUP BY 2
+UNION ALL
+SELECT 'city', l.city, COUNT(*)::int
+FROM filtered_profiles fp
+JOIN locations l ON l.location_id = fp.location_id
+GROUP BY l.city
+UNION ALL
+SELECT 'interest', i.description, COUNT(DISTINCT fp.profile_id)::int
+FROM filtered_profiles fp
+JOIN profile_interests pi ON pi.profile_id = fp.profile_id
+JOIN interests i ON i.interest_id = pi.interest_id
+GROUP BY i.description
+ORDER BY 1, 3 DESC, 2;
 `
 
-func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRequest) ([]model.FoundProfile, error) {
+func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRequest, page model.SearchPage) ([]model.FoundProfile, error) {
 	ctx := context.Background()
 
-	rows, err := pr.DB.Query(ctx, SearchProfilesQuery,
+	args := []interface{}{
 		cur_user,
 		params.IsMale,
 		params.AgeMin,
@@ -1155,7 +1751,18 @@ func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRe
 		params.City,
 		params.Preferences,
 		params.Input,
-	)
+		page.SortBy,
+		page.AfterValue,
+		page.AfterID,
+		page.Limit,
+	}
+
+	predicate, filterArgs, err := CompileSearchFilter(params.Filter, len(args))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := pr.DB.Query(ctx, withSearchFilter(SearchProfilesQuery, predicate), append(args, filterArgs...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -1171,6 +1778,10 @@ func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRe
 			&fp.Fullname,
 			&fp.Age,
 			&fp.Goal,
+			&fp.SortValue,
+			&fp.NameHighlight,
+			&fp.Snippet,
+			&fp.Verified,
 		); err != nil {
 			return nil, err
 		}
@@ -1184,6 +1795,70 @@ func (pr *ProfileRepo) SearchProfiles(cur_user int, params model.SearchProfileRe
 	return results, nil
 }
 
+func (pr *ProfileRepo) SearchFacets(cur_user int, params model.SearchProfileRequest) (model.SearchFacets, error) {
+	facets := model.SearchFacets{
+		Goals:      []model.FacetCount{},
+		AgeBuckets: []model.FacetCount{},
+		Cities:     []model.FacetCount{},
+		Interests:  []model.FacetCount{},
+	}
+
+	args := []interface{}{
+		cur_user,
+		params.IsMale,
+		params.AgeMin,
+		params.AgeMax,
+		params.HeightMin,
+		params.HeightMax,
+		params.Goal,
+		params.Country,
+		params.City,
+		params.Preferences,
+		params.Input,
+	}
+
+	predicate, filterArgs, err := CompileSearchFilter(params.Filter, len(args))
+	if err != nil {
+		return facets, err
+	}
+
+	rows, err := pr.DB.Query(context.Background(), withSearchFilter(SearchFacetsQuery, predicate), append(args, filterArgs...)...)
+	if err != nil {
+		return facets, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var facet string
+		var count model.FacetCount
+		if err := rows.Scan(&facet, &count.Value, &count.Count); err != nil {
+			return facets, err
+		}
+
+		switch facet {
+		case "goal":
+			facets.Goals = append(facets.Goals, count)
+			facets.Total += count.Count
+		case "age":
+			facets.AgeBuckets = append(facets.AgeBuckets, count)
+		case "city":
+			if len(facets.Cities) < model.SearchFacetLimit {
+				facets.Cities = append(facets.Cities, count)
+			}
+		case "interest":
+			if len(facets.Interests) < model.SearchFacetLimit {
+				facets.Interests = append(facets.Interests, count)
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return facets, err
+	}
+
+	return facets, nil
+}
+
 const GetStaticticsQuery = `
 SELECT 
 	COUNT(DISTINCT l.like_id) FILTER (WHERE l.profile_id = p.profile_id) AS likes_given,
@@ -1192,7 +1867,8 @@ SELECT
 	COUNT(DISTINCT c1.complaint_id) AS complaints_made,
 	COUNT(DISTINCT c2.complaint_id) AS complaints_received,
 	COUNT(DISTINCT msg.message_id) AS messages_sent,
-	COUNT(DISTINCT ch.chat_id) AS chat_count
+	COUNT(DISTINCT ch.chat_id) AS chat_count,
+	COALESCE(MAX(p.recycled_count), 0) AS recycled
 FROM profiles p
 LEFT JOIN users u ON u.profile_id = p.profile_id
 LEFT JOIN likes l ON l.profile_id = p.profile_id
@@ -1215,6 +1891,7 @@ func (r *ProfileRepo) GetProfileStats(profileID int) (model.ProfileStats, error)
 		&stats.ComplaintsReceived,
 		&stats.MessagesSent,
 		&stats.ChatCount,
+		&stats.Recycled,
 	)
 	if err != nil {
 		return stats, fmt.Errorf("failed to get profile stats: %w", err)
@@ -1244,7 +1921,8 @@ SELECT
     param.parameter_description,
     param.parameter_value,
     CASE WHEN sbs.sub_id IS NOT NULL THEN TRUE ELSE FALSE END AS premium_status,
-    sbs.border
+    sbs.border,
+    bp.is_verified
 FROM profiles bp
 JOIN users bu ON bu.profile_id = bp.profile_id
 LEFT JOIN profile_interests pi ON pi.profile_id = bp.profile_id
@@ -1254,13 +1932,15 @@ LEFT JOIN preferences pr ON pr.preference_id = pp.preference_id
 LEFT JOIN profile_parameter ppar ON ppar.profile_id = bp.profile_id
 LEFT JOIN parameters param ON param.parameter_id = ppar.parameter_id
 LEFT JOIN locations l ON l.location_id = bp.location_id
-LEFT JOIN static s ON bp.profile_id = s.profile_id
+LEFT JOIN static s ON bp.profile_id = s.profile_id AND s.moderation_status = 'approved'
 LEFT JOIN likes liked ON liked.liked_profile_id = bp.profile_id
 LEFT JOIN subscriptions sbs ON sbs.user_id = bp.profile_id
 LEFT JOIN blacklist bl ON bl.user_id = bu.user_id
+LEFT JOIN boosts bst ON bst.profile_id = bp.profile_id AND bst.ends_at > NOW()
 WHERE 
     bl.user_id IS NULL 
     AND bp.profile_id != $1 
+    AND NOT profile_hidden_from(bp.profile_id, $1)
     AND NOT EXISTS (
         SELECT 1 FROM likes l2
         WHERE l2.profile_id = $1 AND l2.liked_profile_id = bp.profile_id
@@ -1292,6 +1972,7 @@ WHERE
             SELECT COUNT(*) FROM profile_preferences pp6 WHERE pp6.profile_id = bp.profile_id
         ), 0)
     ) / 2.0 >= 0.5
+ORDER BY (bst.boost_id IS NOT NULL) DESC, bp.completeness DESC, s.is_primary DESC NULLS LAST
 LIMIT 1;
 `
 
@@ -1342,6 +2023,7 @@ func (pr *ProfileRepo) GetRecomendations(profileId int) (model.Profile, error) {
 			&paramValue,
 			&premiumStatus,
 			&premiumBorder,
+			&profile.Verified,
 		); err != nil {
 			return profile, err
 		}
@@ -1402,3 +2084,383 @@ func (pr *ProfileRepo) GetRecomendations(profileId int) (model.Profile, error) {
 
 	return profile, nil
 }
+
+const (
+	GenerateTopPicksQuery = `
+WITH zoned_profiles AS MATERIALIZED (
+    SELECT p.profile_id, p.timezone
+    FROM profiles p
+    WHERE p.timezone IN (SELECT name FROM pg_timezone_names)
+),
+premium_profiles AS (
+    SELECT
+        p.profile_id,
+        (NOW() AT TIME ZONE p.timezone)::date AS local_date,
+        p.timezone
+    FROM zoned_profiles p
+    JOIN users u ON u.profile_id = p.profile_id
+    JOIN subscriptions sbs ON sbs.user_id = u.user_id AND sbs.expires_at > NOW()
+    WHERE NOT EXISTS (
+        SELECT 1 FROM top_picks tp
+        WHERE tp.profile_id = p.profile_id
+          AND tp.pick_date = (NOW() AT TIME ZONE p.timezone)::date
+    )
+)
+INSERT INTO top_picks (profile_id, picked_profile_id, score, pick_date, expires_at)
+SELECT
+    pp.profile_id,
+    cand.profile_id,
+    cand.score,
+    pp.local_date,
+    (pp.local_date + 1)::timestamp AT TIME ZONE pp.timezone
+FROM premium_profiles pp
+CROSS JOIN LATERAL (
+    SELECT scored.profile_id, scored.score
+    FROM (
+        SELECT
+            c.profile_id,
+            (
+                SELECT COUNT(*) * 2 FROM profile_interests pi1
+                JOIN profile_interests pi2 ON pi2.interest_id = pi1.interest_id
+                WHERE pi1.profile_id = pp.profile_id AND pi2.profile_id = c.profile_id
+            ) + (
+                SELECT COUNT(*) FROM profile_preferences pr1
+                JOIN profile_preferences pr2 ON pr2.preference_id = pr1.preference_id
+                WHERE pr1.profile_id = pp.profile_id AND pr2.profile_id = c.profile_id
+            ) AS score
+        FROM profiles c
+        JOIN users cu ON cu.profile_id = c.profile_id
+        WHERE c.profile_id != pp.profile_id
+          AND cu.user_id NOT IN (SELECT user_id FROM blacklist)
+          AND NOT profile_hidden_from(c.profile_id, pp.profile_id)
+          AND NOT EXISTS (
+              SELECT 1 FROM likes l
+              WHERE l.profile_id = pp.profile_id AND l.liked_profile_id = c.profile_id
+          )
+    ) scored
+    ORDER BY scored.score * completeness_factor(scored.profile_id) DESC, scored.profile_id
+    LIMIT $1
+) cand
+ON CONFLICT (profile_id, picked_profile_id, pick_date) DO NOTHING;
+`
+
+	DeleteExpiredTopPicksQuery = `
+DELETE FROM top_picks WHERE expires_at < NOW();
+`
+
+	GetTopPicksQuery = `
+SELECT tp.picked_profile_id, tp.expires_at
+FROM top_picks tp
+WHERE tp.profile_id = $1
+  AND tp.expires_at > NOW()
+  AND NOT profile_hidden_from(tp.picked_profile_id, tp.profile_id)
+  AND NOT EXISTS (
+      SELECT 1 FROM likes l
+      WHERE l.profile_id = tp.profile_id AND l.liked_profile_id = tp.picked_profile_id
+  )
+ORDER BY tp.score DESC, tp.picked_profile_id;
+`
+)
+
+// InvalidTimezonesQuery lists premium profiles whose timezone postgres
+// doesn't know; GenerateTopPicksQuery skips them.
+const InvalidTimezonesQuery = `
+SELECT p.profile_id, p.timezone
+FROM profiles p
+JOIN users u ON u.profile_id = p.profile_id
+JOIN subscriptions sbs ON sbs.user_id = u.user_id AND sbs.expires_at > NOW()
+WHERE p.timezone NOT IN (SELECT name FROM pg_timezone_names)
+ORDER BY p.profile_id;
+`
+
+func (pr *ProfileRepo) GetInvalidTimezones() ([]model.ProfileTimezone, error) {
+	rows, err := pr.DB.Query(context.Background(), InvalidTimezonesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error listing invalid timezones: %w", err)
+	}
+	defer rows.Close()
+
+	var zones []model.ProfileTimezone
+	for rows.Next() {
+		var zone model.ProfileTimezone
+		if err := rows.Scan(&zone.ProfileId, &zone.Timezone); err != nil {
+			return nil, err
+		}
+		zones = append(zones, zone)
+	}
+	return zones, rows.Err()
+}
+
+func (pr *ProfileRepo) GenerateTopPicks(limit int) (int, error) {
+	ctx := context.Background()
+
+	if _, err := pr.DB.Exec(ctx, DeleteExpiredTopPicksQuery); err != nil {
+		return 0, fmt.Errorf("error deleting expired top picks: %w", err)
+	}
+
+	cmdTag, err := pr.DB.Exec(ctx, GenerateTopPicksQuery, limit)
+	if err != nil {
+		return 0, fmt.Errorf("error generating top picks: %w", err)
+	}
+
+	return int(cmdTag.RowsAffected()), nil
+}
+
+func (pr *ProfileRepo) GetTopPicks(profileId int) (model.TopPicks, error) {
+	var picks model.TopPicks
+
+	rows, err := pr.DB.Query(context.Background(), GetTopPicksQuery, profileId)
+	if err != nil {
+		return picks, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var pickedID int
+		var expiresAt sql.NullTime
+		if err := rows.Scan(&pickedID, &expiresAt); err != nil {
+			return picks, err
+		}
+		if expiresAt.Valid {
+			picks.ExpiresAt = expiresAt.Time
+		}
+		ids = append(ids, pickedID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return picks, err
+	}
+
+	picks.Profiles = make([]model.Profile, 0, len(ids))
+	for _, id := range ids {
+		profile, err := pr.GetProfileById(id)
+		if err != nil {
+			return picks, err
+		}
+		profile.LikedBy = nil
+		picks.Profiles = append(picks.Profiles, profile)
+	}
+
+	return picks, nil
+}
+
+const GetIncomingLikesQuery = `
+SELECT l.profile_id, l.status, l.created_at,
+       COALESCE(l.comment, ''), COALESCE(l.comment_photo, ''), COALESCE(l.comment_prompt_id, 0)
+FROM likes l
+JOIN users u ON u.profile_id = l.profile_id
+WHERE l.liked_profile_id = $1
+  AND l.status IN (1, 3)
+  AND u.user_id NOT IN (SELECT user_id FROM blacklist)
+  AND NOT profile_incognito(l.profile_id)
+  AND NOT profile_paused(l.profile_id)
+  AND NOT profiles_blocked(l.profile_id, $1)
+  AND NOT EXISTS (
+      SELECT 1 FROM likes answered
+      WHERE answered.profile_id = $1 AND answered.liked_profile_id = l.profile_id
+  )
+ORDER BY l.created_at DESC;
+`
+
+func (pr *ProfileRepo) GetIncomingLikes(profileId int) ([]model.IncomingLike, error) {
+	rows, err := pr.DB.Query(context.Background(), GetIncomingLikesQuery, profileId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var likes []model.IncomingLike
+	for rows.Next() {
+		var like model.IncomingLike
+		var likedAt sql.NullTime
+		var comment model.LikeComment
+		if err := rows.Scan(&like.Profile.ProfileId, &like.Status, &likedAt, &comment.Text, &comment.Photo, &comment.PromptId); err != nil {
+			return nil, err
+		}
+		if likedAt.Valid {
+			like.LikedAt = likedAt.Time
+		}
+		if comment.Text != "" {
+			comment.ProfileId = like.Profile.ProfileId
+			like.Comment = &comment
+		}
+		likes = append(likes, like)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range likes {
+		profile, err := pr.GetProfileById(likes[i].Profile.ProfileId)
+		if err != nil {
+			return likes, err
+		}
+		profile.LikedBy = nil
+		likes[i].Profile = profile
+	}
+
+	return likes, nil
+}
+
+const (
+	RecordBoostViewsQuery = `
+WITH viewed AS (
+    INSERT INTO boost_views (boost_id, viewer_profile_id)
+    SELECT boost_id, $1 FROM boosts
+    WHERE profile_id = ANY($2) AND ends_at > NOW()
+    ON CONFLICT (boost_id, viewer_profile_id) DO NOTHING
+    RETURNING boost_id
+)
+UPDATE boosts b SET views = b.views + 1
+FROM viewed v
+WHERE b.boost_id = v.boost_id;
+`
+
+	EnsureBoostInventoryQuery = `
+INSERT INTO boost_inventory (profile_id, available)
+VALUES ($1, 0)
+ON CONFLICT (profile_id) DO NOTHING;
+`
+
+	LockBoostInventoryQuery = `
+SELECT available FROM boost_inventory WHERE profile_id = $1 FOR UPDATE;
+`
+
+	CheckActiveBoostQuery = `
+SELECT EXISTS (
+    SELECT 1 FROM boosts WHERE profile_id = $1 AND ends_at > NOW()
+);
+`
+
+	ConsumeBoostQuery = `
+UPDATE boost_inventory
+SET available = available - 1
+WHERE profile_id = $1 AND available > 0
+RETURNING available;
+`
+
+	InsertBoostQuery = `
+INSERT INTO boosts (profile_id, source, started_at, ends_at)
+VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
+RETURNING boost_id, started_at, ends_at;
+`
+
+	GetBoostStatsQuery = `
+SELECT
+    b.boost_id,
+    b.source,
+    b.started_at,
+    b.ends_at,
+    b.views,
+    (
+        SELECT COUNT(*) FROM likes l
+        WHERE l.liked_profile_id = b.profile_id
+          AND l.status IN (1, 3)
+          AND l.created_at BETWEEN b.started_at AND b.ends_at
+    ) AS likes,
+    (
+        SELECT COUNT(*) FROM matches m
+        WHERE (m.profile_id = b.profile_id OR m.matched_profile_id = b.profile_id)
+          AND m.created_at BETWEEN b.started_at AND b.ends_at
+    ) AS matches
+FROM boosts b
+WHERE b.profile_id = $1
+ORDER BY b.started_at DESC
+LIMIT $2;
+`
+)
+
+// ActivateBoost starts a boost for profileId and returns it together with
+// the remaining inventory. With useAllowance the boost comes from the plan's
+// allowance, which the caller has already charged; otherwise a purchased
+// boost is consumed. The inventory row is locked for the whole transaction,
+// so concurrent activations cannot overspend it.
+func (pr *ProfileRepo) ActivateBoost(profileId int, duration time.Duration, useAllowance bool) (boost model.Boost, remaining int, err error) {
+	ctx := context.Background()
+
+	tx, err := pr.DB.Begin(ctx)
+	if err != nil {
+		return boost, 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, EnsureBoostInventoryQuery, profileId); err != nil {
+		return boost, 0, fmt.Errorf("error creating boost inventory: %w", err)
+	}
+
+	if err = tx.QueryRow(ctx, LockBoostInventoryQuery, profileId).Scan(&remaining); err != nil {
+		return boost, 0, fmt.Errorf("error locking boost inventory: %w", err)
+	}
+
+	var active bool
+	if err = tx.QueryRow(ctx, CheckActiveBoostQuery, profileId).Scan(&active); err != nil {
+		return boost, remaining, fmt.Errorf("error checking active boost: %w", err)
+	}
+	if active {
+		return boost, remaining, model.ErrBoostAlreadyActive
+	}
+
+	boost.Source = "premium"
+	if !useAllowance {
+		err = tx.QueryRow(ctx, ConsumeBoostQuery, profileId).Scan(&remaining)
+		if err == pgx.ErrNoRows {
+			return boost, 0, model.ErrNoBoostsLeft
+		}
+		if err != nil {
+			return boost, remaining, fmt.Errorf("error consuming boost: %w", err)
+		}
+		boost.Source = "inventory"
+	}
+
+	err = tx.QueryRow(ctx, InsertBoostQuery, profileId, boost.Source, duration.Seconds()).
+		Scan(&boost.BoostID, &boost.StartedAt, &boost.EndsAt)
+	if err != nil {
+		return boost, remaining, fmt.Errorf("error inserting boost: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return boost, remaining, err
+	}
+
+	return boost, remaining, nil
+}
+
+func (pr *ProfileRepo) GetBoostStats(profileId int) ([]model.Boost, error) {
+	rows, err := pr.DB.Query(context.Background(), GetBoostStatsQuery, profileId, model.BoostStatsLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var boosts []model.Boost
+	for rows.Next() {
+		var boost model.Boost
+		var startedAt, endsAt sql.NullTime
+		if err := rows.Scan(
+			&boost.BoostID,
+			&boost.Source,
+			&startedAt,
+			&endsAt,
+			&boost.Views,
+			&boost.Likes,
+			&boost.Matches,
+		); err != nil {
+			return nil, err
+		}
+		if startedAt.Valid {
+			boost.StartedAt = startedAt.Time
+		}
+		if endsAt.Valid {
+			boost.EndsAt = endsAt.Time
+		}
+		boosts = append(boosts, boost)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return boosts, nil
+}
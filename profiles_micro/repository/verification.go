@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+)
+
+const (
+	// GetVerificationQuery returns the profile's verified flag together with
+	// its latest request, if any.
+	GetVerificationQuery = `
+SELECT p.profile_id, p.is_verified,
+       v.request_id, v.pose, v.selfie_key, v.status, v.reason, v.expires_at
+FROM users u
+JOIN profiles p ON p.profile_id = u.profile_id
+LEFT JOIN LATERAL (
+    SELECT vr.request_id, vr.pose, vr.selfie_key, vr.status, vr.reason, vr.expires_at
+    FROM verification_requests vr
+    WHERE vr.profile_id = p.profile_id
+    ORDER BY vr.created_at DESC, vr.request_id DESC
+    LIMIT 1
+) v ON TRUE
+WHERE u.user_id = $1;
+`
+
+	CreateVerificationQuery = `
+INSERT INTO verification_requests (profile_id, pose, selfie_key, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING request_id;
+`
+
+	SubmitVerificationQuery = `
+UPDATE verification_requests
+SET status = 'submitted', submitted_at = NOW()
+WHERE request_id = $1 AND status = 'challenged' AND expires_at > NOW();
+`
+
+	GetVerificationQueueQuery = `
+SELECT v.request_id, v.profile_id, p.firstname, v.pose, v.selfie_key, v.submitted_at,
+       ARRAY(
+           SELECT s.path FROM static s
+           WHERE s.profile_id = v.profile_id AND s.moderation_status <> 'rejected'
+           ORDER BY s.is_primary DESC, s.position, s.id
+       ) AS photos
+FROM verification_requests v
+JOIN profiles p ON p.profile_id = v.profile_id
+WHERE v.status = 'submitted'
+ORDER BY v.submitted_at, v.request_id
+LIMIT $1;
+`
+
+	// ReviewVerificationQuery settles a submitted request and, on approval,
+	// marks the profile verified. It returns the selfie key so the caller
+	// can remove the selfie once it is no longer needed.
+	ReviewVerificationQuery = `
+WITH reviewed AS (
+    UPDATE verification_requests
+    SET status = $2,
+        reason = NULLIF($3, ''),
+        reviewed_by = $4,
+        reviewed_at = NOW()
+    WHERE request_id = $1 AND status = 'submitted'
+    RETURNING profile_id, selfie_key
+), verified AS (
+    UPDATE profiles p
+    SET is_verified = TRUE, verified_at = NOW()
+    FROM reviewed r
+    WHERE p.profile_id = r.profile_id AND $2 = 'approved'
+)
+SELECT selfie_key FROM reviewed;
+`
+)
+
+// GetVerification loads the verification state of the user's profile. An
+// unanswered challenge past its deadline is reported as expired.
+func (pr *ProfileRepo) GetVerification(userId int) (model.Verification, error) {
+	rows, err := pr.DB.Query(context.Background(), GetVerificationQuery, userId)
+	if err != nil {
+		return model.Verification{}, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return model.Verification{}, err
+		}
+		return model.Verification{}, model.ErrProfileNotFound
+	}
+
+	var (
+		v         model.Verification
+		requestId sql.NullInt64
+		pose      sql.NullString
+		selfieKey sql.NullString
+		status    sql.NullString
+		reason    sql.NullString
+		expiresAt sql.NullTime
+	)
+	if err := rows.Scan(&v.ProfileId, &v.Verified, &requestId, &pose, &selfieKey, &status, &reason, &expiresAt); err != nil {
+		return model.Verification{}, err
+	}
+
+	v.Status = model.VerificationNone
+	if requestId.Valid {
+		v.RequestId = int(requestId.Int64)
+		v.Pose = pose.String
+		v.SelfieKey = selfieKey.String
+		v.Status = status.String
+		v.Reason = reason.String
+		v.ExpiresAt = expiresAt.Time
+		if v.Status == model.VerificationChallenged && !v.ExpiresAt.After(time.Now()) {
+			v.Status = model.VerificationExpired
+		}
+	}
+
+	return v, rows.Err()
+}
+
+func (pr *ProfileRepo) CreateVerification(profileId int, pose, selfieKey string, expiresAt time.Time) (int, error) {
+	var requestId int
+	err := pr.DB.QueryRow(context.Background(), CreateVerificationQuery, profileId, pose, selfieKey, expiresAt).Scan(&requestId)
+	return requestId, err
+}
+
+// SubmitVerification queues a challenged request for review. Requests that
+// expired or moved on count as not found.
+func (pr *ProfileRepo) SubmitVerification(requestId int) error {
+	cmdTag, err := pr.DB.Exec(context.Background(), SubmitVerificationQuery, requestId)
+	if err != nil {
+		return err
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return model.ErrVerificationNotFound
+	}
+	return nil
+}
+
+func (pr *ProfileRepo) GetVerificationQueue(limit int) ([]model.PendingVerification, error) {
+	rows, err := pr.DB.Query(context.Background(), GetVerificationQueueQuery, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pending := []model.PendingVerification{}
+	for rows.Next() {
+		var v model.PendingVerification
+		var submittedAt sql.NullTime
+		if err := rows.Scan(&v.RequestId, &v.ProfileId, &v.FirstName, &v.Pose, &v.SelfieKey, &submittedAt, &v.Photos); err != nil {
+			return nil, err
+		}
+		v.SubmittedAt = submittedAt.Time
+		pending = append(pending, v)
+	}
+
+	return pending, rows.Err()
+}
+
+// ReviewVerification approves or rejects a submitted request and returns
+// the key of its selfie. As with photo moderation, a request that was
+// already reviewed counts as not found.
+func (pr *ProfileRepo) ReviewVerification(requestId int, reviewerId int, approve bool, reason string) (string, error) {
+	decision := model.VerificationRejected
+	if approve {
+		decision = model.VerificationApproved
+	}
+
+	rows, err := pr.DB.Query(context.Background(), ReviewVerificationQuery, requestId, decision, reason, reviewerId)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", err
+		}
+		return "", model.ErrVerificationNotFound
+	}
+
+	var selfieKey string
+	if err := rows.Scan(&selfieKey); err != nil {
+		return "", err
+	}
+	return selfieKey, rows.Err()
+}
@@ -31,6 +31,8 @@ func (m *MockRows) Scan(dest ...interface{}) error {
 		switch d := dest[i].(type) {
 		case *int:
 			*d = row[i].(int)
+		case *float64:
+			*d = row[i].(float64)
 		case *bool:
 			*d = row[i].(bool)
 		case *sql.NullTime:
@@ -106,13 +108,21 @@ func (m *MockDB) QueryRow(ctx context.Context, query string, args ...interface{}
 type mockRow struct {
 	mock.Mock
 	values []interface{}
+	err    error
 }
 
 func (m *mockRow) Scan(dest ...interface{}) error {
+	if m.err != nil {
+		return m.err
+	}
 	for i := range dest {
 		switch d := dest[i].(type) {
 		case *int:
 			*d = m.values[i].(int)
+		case *string:
+			*d = m.values[i].(string)
+		case *bool:
+			*d = m.values[i].(bool)
 		default:
 			return fmt.Errorf("unsupported scan type %T", d)
 		}
@@ -203,3 +213,27 @@ func (m *MockTx) QueryRow(ctx context.Context, query string, args ...interface{}
 	called := m.Called(callArgs...)
 	return called.Get(0).(pgx.Row)
 }
+
+func (m *MockTx) Begin(ctx context.Context) (pgx.Tx, error) {
+	return nil, fmt.Errorf("nested transactions are not mocked")
+}
+
+func (m *MockTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, fmt.Errorf("copy is not mocked")
+}
+
+func (m *MockTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return nil
+}
+
+func (m *MockTx) LargeObjects() pgx.LargeObjects {
+	return pgx.LargeObjects{}
+}
+
+func (m *MockTx) Prepare(ctx context.Context, name, sql string) (*pgconn.StatementDescription, error) {
+	return nil, fmt.Errorf("prepare is not mocked")
+}
+
+func (m *MockTx) Conn() *pgx.Conn {
+	return nil
+}
@@ -0,0 +1,298 @@
+package repository
+
+import (
+	"bytes"
+	"database/sql"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/image/webp"
+)
+
+func testImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+// withOrientation inserts an APP1 EXIF segment holding only the orientation
+// tag right after the JPEG SOI marker.
+func withOrientation(jpg []byte, orientation byte) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x01,
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, orientation, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	size := len(payload) + 2
+
+	segment := []byte{0xFF, 0xE1, byte(size >> 8), byte(size)}
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, jpg[:2]...)
+	out = append(out, segment...)
+	return append(out, jpg[2:]...)
+}
+
+func TestProcessPhoto(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, testImage(2000, 1000)))
+
+	photo, err := repository.ProcessPhoto(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Len(t, photo.Variants, len(model.PhotoVariants))
+
+	expected := map[string]image.Point{
+		model.PhotoThumb: {X: 160, Y: 80},
+		model.PhotoCard:  {X: 640, Y: 320},
+		model.PhotoFull:  {X: 1600, Y: 800},
+	}
+	for name, size := range expected {
+		cfg, err := webp.DecodeConfig(bytes.NewReader(photo.Variants[name]))
+		assert.NoError(t, err, name)
+		assert.Equal(t, size, image.Point{X: cfg.Width, Y: cfg.Height}, name)
+	}
+}
+
+func TestProcessPhotoAppliesOrientation(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, testImage(960, 320), nil))
+
+	photo, err := repository.ProcessPhoto(withOrientation(buf.Bytes(), 6))
+	assert.NoError(t, err)
+
+	cfg, err := webp.DecodeConfig(bytes.NewReader(photo.Variants[model.PhotoFull]))
+	assert.NoError(t, err)
+	assert.Equal(t, 320, cfg.Width)
+	assert.Equal(t, 960, cfg.Height)
+
+	assert.NotContains(t, string(photo.Variants[model.PhotoFull]), "Exif")
+}
+
+func TestProcessPhotoRejectsNonImages(t *testing.T) {
+	_, err := repository.ProcessPhoto([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+	assert.Equal(t, model.ErrUnsupportedImage, err)
+
+	_, err = repository.ProcessPhoto([]byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00})
+	assert.Equal(t, model.ErrUnsupportedImage, err)
+}
+
+func TestProcessPhotoPreChecks(t *testing.T) {
+	var small bytes.Buffer
+	assert.NoError(t, png.Encode(&small, testImage(640, 200)))
+	_, err := repository.ProcessPhoto(small.Bytes())
+	assert.Equal(t, model.ErrImageTooSmall, err)
+
+	solid := image.NewRGBA(image.Rect(0, 0, 800, 600))
+	for i := range solid.Pix {
+		solid.Pix[i] = 250
+	}
+	var blank bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&blank, solid, nil))
+	_, err = repository.ProcessPhoto(blank.Bytes())
+	assert.Equal(t, model.ErrBlankImage, err)
+}
+
+// spotImage draws a bright spot fading out around (cx, cy), given as
+// fractions of the image size.
+func spotImage(w, h int, cx, cy float64) image.Image {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dx, dy := float64(x)/float64(w)-cx, float64(y)/float64(h)-cy
+			img.SetGray(x, y, color.Gray{Y: uint8(255 / (1 + 8*(dx*dx+dy*dy)))})
+		}
+	}
+	return img
+}
+
+func processedHash(t *testing.T, img image.Image, quality int) uint64 {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}))
+	photo, err := repository.ProcessPhoto(buf.Bytes())
+	assert.NoError(t, err)
+	return photo.Hash
+}
+
+func TestDifferenceHash(t *testing.T) {
+	original := processedHash(t, spotImage(1200, 900, 0.3, 0.4), 95)
+	recompressed := processedHash(t, spotImage(600, 450, 0.3, 0.4), 40)
+	other := processedHash(t, spotImage(1200, 900, 0.7, 0.6), 95)
+
+	assert.LessOrEqual(t, repository.HashDistance(original, recompressed), model.DuplicatePhotoDistance)
+	assert.Greater(t, repository.HashDistance(original, other), model.DuplicatePhotoDistance)
+}
+
+func TestPhotoVariantKeys(t *testing.T) {
+	full := repository.PhotoVariantKey("/1_2_me.jpg", model.PhotoFull)
+	assert.Equal(t, "/1_2_me_full.webp", full)
+
+	assert.Equal(t, map[string]string{
+		model.PhotoThumb: "/1_2_me_thumb.webp",
+		model.PhotoCard:  "/1_2_me_card.webp",
+		model.PhotoFull:  "/1_2_me_full.webp",
+	}, repository.PhotoVariantKeys(full))
+
+	assert.Equal(t, map[string]string{
+		model.PhotoThumb: "/1_2_old.png",
+		model.PhotoCard:  "/1_2_old.png",
+		model.PhotoFull:  "/1_2_old.png",
+	}, repository.PhotoVariantKeys("/1_2_old.png"))
+}
+
+func TestPresignPhotoURLs(t *testing.T) {
+	client, err := minio.New("photos.example.com", &minio.Options{
+		Creds:  credentials.NewStaticV4("key", "secret", ""),
+		Secure: true,
+		Region: "us-east-1",
+	})
+	assert.NoError(t, err)
+
+	repo := repository.NewStaticRepoCl(client, "profile-photos")
+
+	upload, err := repo.PresignUpload("uploads/7/0b6f1f4e-2b39-4d4e-9d1c-6f3f4c1a2b3c", 15*time.Minute)
+	assert.NoError(t, err)
+
+	u, err := url.Parse(upload)
+	assert.NoError(t, err)
+	assert.Equal(t, "photos.example.com", u.Host)
+	assert.Equal(t, "/profile-photos/uploads/7/0b6f1f4e-2b39-4d4e-9d1c-6f3f4c1a2b3c", u.Path)
+	assert.Equal(t, "900", u.Query().Get("X-Amz-Expires"))
+	assert.NotEmpty(t, u.Query().Get("X-Amz-Signature"))
+
+	download, err := repo.PresignDownload("/1_2_me_card.webp", time.Hour)
+	assert.NoError(t, err)
+	assert.Contains(t, download, "1_2_me_card.webp")
+	assert.Contains(t, download, "X-Amz-Expires=3600")
+}
+
+func TestStorePhotoRespectsLimit(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("QueryRow", mock.Anything, repository.LockProfileQuery, 7).Return(&mockRow{values: []interface{}{7}})
+	tx.On("Exec", mock.Anything, repository.UploadPhotoQuery, 7, "/7_1_me_full.webp", model.MaxPhotosPerProfile, int64(42)).
+		Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Exec", mock.Anything, repository.UploadPhotoQuery, 7, "/7_1_me_full.webp", model.MaxPhotosPerProfile, int64(42)).
+		Return(pgconn.NewCommandTag("INSERT 0 0"), nil).Once()
+	tx.On("Commit", mock.Anything).Return(nil).Once()
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.NoError(t, repo.StorePhoto(7, "/7_1_me_full.webp", 42))
+	assert.Equal(t, model.ErrTooManyPhotos, repo.StorePhoto(7, "/7_1_me_full.webp", 42))
+	tx.AssertExpectations(t)
+}
+
+func TestCountPhotos(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("QueryRow", mock.Anything, repository.CountPhotosQuery, []interface{}{7}).
+		Return(&mockRow{values: []interface{}{4}})
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	count, err := repo.CountPhotos(7)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+}
+
+func TestModeratePhoto(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	profileRows := &MockRows{
+		data: [][]interface{}{
+			{
+				7, "Alice", "Smith", false, 170,
+				sql.NullTime{}, "", sql.NullInt64{},
+				sql.NullString{}, sql.NullString{}, sql.NullString{},
+				sql.NullInt64{},
+				sql.NullString{String: "/7_1_me_full.webp", Valid: true},
+				sql.NullString{}, sql.NullString{}, sql.NullString{}, sql.NullString{}, sql.NullString{},
+				sql.NullBool{}, sql.NullInt64{},
+				false, "", `[]`,
+			},
+		},
+	}
+	tx.On("QueryRow", mock.Anything, repository.ModeratePhotoQuery, 3, model.PhotoApproved, "", 1).
+		Return(&mockRow{values: []interface{}{7}})
+	tx.On("QueryRow", mock.Anything, repository.ModeratePhotoQuery, 3, model.PhotoRejected, "not a face", 1).
+		Return(&mockRow{err: pgx.ErrNoRows})
+	tx.On("QueryRow", mock.Anything, repository.LockProfileQuery, 7).Return(&mockRow{values: []interface{}{7}})
+	tx.On("QueryRow", mock.Anything, repository.GetLatestSnapshotQuery, 7).Return(&mockRow{err: pgx.ErrNoRows})
+	tx.On("Query", mock.Anything, repository.GetProfileByIdQuery, 7).Return(profileRows, nil)
+	tx.On("Exec", mock.Anything, repository.InsertProfileVersionQuery, 7, 1, model.VersionModeration, mock.Anything, mock.Anything).
+		Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	tx.On("Commit", mock.Anything).Return(nil).Once()
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	profileId, err := repo.ModeratePhoto(3, 1, true, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, profileId)
+	// The version is written in the moderation's own tx, before it commits.
+	tx.AssertCalled(t, "Exec", mock.Anything, repository.InsertProfileVersionQuery, 7, 1, model.VersionModeration, mock.Anything, mock.Anything)
+
+	_, err = repo.ModeratePhoto(3, 1, false, "not a face")
+	assert.Equal(t, model.ErrPhotoNotFound, err)
+	tx.AssertNumberOfCalls(t, "Commit", 1)
+}
+
+func TestHashPhotoMatchesUploadHash(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, spotImage(1200, 900, 0.3, 0.4), nil))
+
+	photo, err := repository.ProcessPhoto(buf.Bytes())
+	assert.NoError(t, err)
+
+	hash, err := repository.HashPhoto(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, photo.Hash, hash)
+
+	stored, err := repository.HashPhoto(photo.Variants[model.PhotoThumb])
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, repository.HashDistance(photo.Hash, stored), model.StolenPhotoDistance)
+}
+
+func TestClusterPhotoPairs(t *testing.T) {
+	pair := func(a int, pathA string, b int, pathB string) model.PhotoPair {
+		return model.PhotoPair{
+			First:  model.PhotoMatch{ProfileId: a, Path: pathA},
+			Second: model.PhotoMatch{ProfileId: b, Path: pathB},
+		}
+	}
+
+	clusters := repository.ClusterPhotoPairs([]model.PhotoPair{
+		pair(1, "/a", 4, "/b"),
+		pair(7, "/x", 9, "/y"),
+		pair(4, "/b", 5, "/c"),
+	})
+
+	assert.Equal(t, []model.PhotoCluster{
+		{ProfileIds: []int{1, 4, 5}, Paths: []string{"/a", "/b", "/c"}},
+		{ProfileIds: []int{7, 9}, Paths: []string{"/x", "/y"}},
+	}, clusters)
+	assert.Empty(t, repository.ClusterPhotoPairs(nil))
+}
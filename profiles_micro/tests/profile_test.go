@@ -7,6 +7,8 @@ import (
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -31,6 +33,9 @@ func TestGetProfileById(t *testing.T) {
 				sql.NullString{String: "170", Valid: true},
 				sql.NullBool{Bool: true, Valid: true},
 				sql.NullInt64{Int64: 5, Valid: true},
+				true,
+				"Europe/Moscow",
+				`[{"promptId": 1, "question": "My ideal weekend…", "answer": "Hiking"}]`,
 			},
 		},
 	}
@@ -49,6 +54,74 @@ func TestGetProfileById(t *testing.T) {
 	assert.Contains(t, profile.Interests, "Music")
 	assert.Equal(t, true, profile.Premium.Status)
 	assert.Equal(t, 5, profile.Premium.Border)
+	assert.True(t, profile.Verified)
+	assert.Equal(t, []model.ProfilePrompt{{PromptId: 1, Question: "My ideal weekend…", Answer: "Hiking"}}, profile.Prompts)
+	assert.Equal(t, "Europe/Moscow", profile.Timezone)
+}
+
+func TestGetInvalidTimezones(t *testing.T) {
+	mockDB := new(MockDB)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{7, "Mars/Olympus_Mons"},
+		},
+	}
+	mockDB.On("Query", mock.Anything, repository.InvalidTimezonesQuery, []interface{}(nil)).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	zones, err := repo.GetInvalidTimezones()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ProfileTimezone{{ProfileId: 7, Timezone: "Mars/Olympus_Mons"}}, zones)
+}
+
+func TestGetPrompts(t *testing.T) {
+	mockDB := new(MockDB)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{1, "My ideal weekend…"},
+			{2, "The way to win me over is…"},
+		},
+	}
+	mockDB.On("Query", mock.Anything, repository.GetPromptsQuery, []interface{}(nil)).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	prompts, err := repo.GetPrompts()
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Prompt{
+		{PromptId: 1, Question: "My ideal weekend…"},
+		{PromptId: 2, Question: "The way to win me over is…"},
+	}, prompts)
+}
+
+func TestUpdateProfilePrompts(t *testing.T) {
+	newTx := func() (*MockDB, *MockTx) {
+		mockDB := new(MockDB)
+		tx := new(MockTx)
+		mockDB.On("Begin", mock.Anything).Return(tx, nil)
+		tx.On("Exec", mock.Anything, repository.UpdateProfileQuery,
+			"Ann", "", false, 0, "", 0, time.Time{}, 0, 5, "").Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+		// Nothing the version snapshot covers changes here, so no version is written.
+		tx.On("QueryRow", mock.Anything, repository.LockProfileQuery, 5).Return(&mockRow{values: []interface{}{5}})
+		tx.On("QueryRow", mock.Anything, repository.GetLatestSnapshotQuery, 5).Return(&mockRow{err: pgx.ErrNoRows})
+		tx.On("Query", mock.Anything, repository.GetProfileByIdQuery, 5).Return(&MockRows{}, nil)
+		tx.On("Commit", mock.Anything).Return(nil)
+		tx.On("Rollback", mock.Anything).Return(nil)
+		return mockDB, tx
+	}
+
+	mockDB, tx := newTx()
+	repo := &repository.ProfileRepo{DB: mockDB}
+	assert.NoError(t, repo.UpdateProfile(5, model.Profile{FirstName: "Ann"}))
+	tx.AssertNotCalled(t, "Exec", mock.Anything, repository.DeleteProfilePrompts, 5)
+
+	mockDB, tx = newTx()
+	tx.On("Exec", mock.Anything, repository.DeleteProfilePrompts, 5).Return(pgconn.NewCommandTag("DELETE 2"), nil)
+	repo = &repository.ProfileRepo{DB: mockDB}
+	assert.NoError(t, repo.UpdateProfile(5, model.Profile{FirstName: "Ann", Prompts: []model.ProfilePrompt{}}))
+	tx.AssertCalled(t, "Exec", mock.Anything, repository.DeleteProfilePrompts, 5)
 }
 
 func TestGetRecommendations(t *testing.T) {
@@ -77,6 +150,7 @@ func TestGetRecommendations(t *testing.T) {
 				sql.NullString{String: "170", Valid: true},
 				sql.NullBool{Bool: true, Valid: true},
 				sql.NullInt64{Int64: 3, Valid: true},
+				false,
 			},
 		},
 	}
@@ -124,6 +198,7 @@ func TestGetProfileStats(t *testing.T) {
 		2,
 		50,
 		7,
+		4,
 	}
 
 	row := &mockRow{
@@ -144,6 +219,7 @@ func TestGetProfileStats(t *testing.T) {
 	assert.Equal(t, 2, stats.ComplaintsReceived)
 	assert.Equal(t, 50, stats.MessagesSent)
 	assert.Equal(t, 7, stats.ChatCount)
+	assert.Equal(t, 4, stats.Recycled)
 }
 
 func TestSearchProfiles(t *testing.T) {
@@ -167,6 +243,8 @@ func TestSearchProfiles(t *testing.T) {
 		},
 	}
 
+	page := model.SearchPage{SortBy: model.SearchSortRelevance, Limit: 21}
+
 	rows := &MockRows{
 		data: [][]interface{}{
 			{
@@ -175,6 +253,10 @@ func TestSearchProfiles(t *testing.T) {
 				"Alice Smith",
 				25,
 				1,
+				-1.5,
+				"<mark>Ali</mark>ce Smith",
+				"Loves <mark>Ali</mark> Baba",
+				true,
 			},
 			{
 				2,
@@ -182,6 +264,10 @@ func TestSearchProfiles(t *testing.T) {
 				"Bob Johnson",
 				28,
 				1,
+				-0.5,
+				"Bob Johnson",
+				"",
+				false,
 			},
 		},
 	}
@@ -198,11 +284,15 @@ func TestSearchProfiles(t *testing.T) {
 		searchParams.City,
 		searchParams.Preferences,
 		searchParams.Input,
+		page.SortBy,
+		page.AfterValue,
+		page.AfterID,
+		page.Limit,
 	}).Return(rows, nil)
 
 	repo := &repository.ProfileRepo{DB: mockDB}
 
-	results, err := repo.SearchProfiles(1, searchParams)
+	results, err := repo.SearchProfiles(1, searchParams, page)
 
 	assert.NoError(t, err)
 	assert.Len(t, results, 2)
@@ -212,7 +302,739 @@ func TestSearchProfiles(t *testing.T) {
 	assert.Equal(t, "Alice Smith", results[0].Fullname)
 	assert.Equal(t, 25, results[0].Age)
 	assert.Equal(t, 1, results[0].Goal)
+	assert.Equal(t, "<mark>Ali</mark>ce Smith", results[0].NameHighlight)
+	assert.Equal(t, "Loves <mark>Ali</mark> Baba", results[0].Snippet)
+	assert.True(t, results[0].Verified)
 
 	assert.Equal(t, 2, results[1].IDUser)
 	assert.Equal(t, "Bob Johnson", results[1].Fullname)
+	assert.Equal(t, -0.5, results[1].SortValue)
+}
+
+func TestSearchFacets(t *testing.T) {
+	mockDB := new(MockDB)
+
+	searchParams := model.SearchProfileRequest{IsMale: "Any", Preferences: []model.Preference{}}
+
+	rows := &MockRows{
+		data: [][]interface{}{
+			{"age", "18-24", 3},
+			{"city", "Moscow", 4},
+			{"goal", "1", 3},
+			{"goal", "2", 2},
+			{"interest", "Music", 2},
+		},
+	}
+
+	mockDB.On("Query", mock.Anything, repository.SearchFacetsQuery, []interface{}{
+		1,
+		searchParams.IsMale,
+		searchParams.AgeMin,
+		searchParams.AgeMax,
+		searchParams.HeightMin,
+		searchParams.HeightMax,
+		searchParams.Goal,
+		searchParams.Country,
+		searchParams.City,
+		searchParams.Preferences,
+		searchParams.Input,
+	}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	facets, err := repo.SearchFacets(1, searchParams)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 5, facets.Total)
+	assert.Len(t, facets.Goals, 2)
+	assert.Equal(t, []model.FacetCount{{Value: "18-24", Count: 3}}, facets.AgeBuckets)
+	assert.Equal(t, []model.FacetCount{{Value: "Moscow", Count: 4}}, facets.Cities)
+	assert.Equal(t, []model.FacetCount{{Value: "Music", Count: 2}}, facets.Interests)
+}
+
+func TestGetTopPicks(t *testing.T) {
+	mockDB := new(MockDB)
+
+	expiresAt := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	picksRows := &MockRows{
+		data: [][]interface{}{
+			{2, sql.NullTime{Time: expiresAt, Valid: true}},
+		},
+	}
+
+	profileRows := &MockRows{
+		data: [][]interface{}{
+			{
+				2, "Bob", "Brown", true, 180,
+				sql.NullTime{Time: time.Date(1993, 3, 3, 0, 0, 0, 0, time.UTC), Valid: true},
+				"Description", sql.NullInt64{Int64: 2, Valid: true},
+				sql.NullString{String: "USA", Valid: true},
+				sql.NullString{String: "NYC", Valid: true},
+				sql.NullString{String: "Queens", Valid: true},
+				sql.NullInt64{Int64: 7, Valid: true},
+				sql.NullString{String: "/images/b.jpg", Valid: true},
+				sql.NullString{String: "Music", Valid: true},
+				sql.NullString{}, sql.NullString{},
+				sql.NullString{}, sql.NullString{},
+				sql.NullBool{}, sql.NullInt64{},
+				false,
+				"Europe/Moscow",
+				"[]",
+			},
+		},
+	}
+
+	mockDB.On("Query", mock.Anything, repository.GetTopPicksQuery, []interface{}{1}).Return(picksRows, nil)
+	mockDB.On("Query", mock.Anything, repository.GetProfileByIdQuery, []interface{}{2}).Return(profileRows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	picks, err := repo.GetTopPicks(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expiresAt, picks.ExpiresAt)
+	assert.Len(t, picks.Profiles, 1)
+	assert.Equal(t, 2, picks.Profiles[0].ProfileId)
+	assert.Empty(t, picks.Profiles[0].LikedBy)
+}
+
+func TestGetIncomingLikes(t *testing.T) {
+	mockDB := new(MockDB)
+
+	likedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	likesRows := &MockRows{
+		data: [][]interface{}{
+			{3, 1, sql.NullTime{Time: likedAt, Valid: true}, "Same here!", "", 2},
+		},
+	}
+
+	profileRows := &MockRows{
+		data: [][]interface{}{
+			{
+				3, "Carol", "White", false, 165,
+				sql.NullTime{Time: time.Date(1996, 6, 6, 0, 0, 0, 0, time.UTC), Valid: true},
+				"Description", sql.NullInt64{Int64: 1, Valid: true},
+				sql.NullString{}, sql.NullString{}, sql.NullString{},
+				sql.NullInt64{Int64: 9, Valid: true},
+				sql.NullString{String: "/images/c.jpg", Valid: true},
+				sql.NullString{}, sql.NullString{}, sql.NullString{},
+				sql.NullString{}, sql.NullString{},
+				sql.NullBool{}, sql.NullInt64{},
+				false,
+				"Europe/Moscow",
+				"[]",
+			},
+		},
+	}
+
+	mockDB.On("Query", mock.Anything, repository.GetIncomingLikesQuery, []interface{}{1}).Return(likesRows, nil)
+	mockDB.On("Query", mock.Anything, repository.GetProfileByIdQuery, []interface{}{3}).Return(profileRows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likes, err := repo.GetIncomingLikes(1)
+
+	assert.NoError(t, err)
+	assert.Len(t, likes, 1)
+	assert.Equal(t, 3, likes[0].Profile.ProfileId)
+	assert.Equal(t, "Carol", likes[0].Profile.FirstName)
+	assert.Equal(t, 1, likes[0].Status)
+	assert.Equal(t, likedAt, likes[0].LikedAt)
+	assert.Empty(t, likes[0].Profile.LikedBy)
+	assert.Equal(t, &model.LikeComment{ProfileId: 3, Text: "Same here!", PromptId: 2}, likes[0].Comment)
+}
+
+func TestGetBoostStats(t *testing.T) {
+	mockDB := new(MockDB)
+
+	startedAt := time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC)
+	endsAt := startedAt.Add(30 * time.Minute)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{
+				4,
+				"inventory",
+				sql.NullTime{Time: startedAt, Valid: true},
+				sql.NullTime{Time: endsAt, Valid: true},
+				120,
+				9,
+				2,
+			},
+		},
+	}
+
+	mockDB.On("Query", mock.Anything, repository.GetBoostStatsQuery, []interface{}{1, model.BoostStatsLimit}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	boosts, err := repo.GetBoostStats(1)
+
+	assert.NoError(t, err)
+	assert.Len(t, boosts, 1)
+	assert.Equal(t, 4, boosts[0].BoostID)
+	assert.Equal(t, "inventory", boosts[0].Source)
+	assert.Equal(t, startedAt, boosts[0].StartedAt)
+	assert.Equal(t, endsAt, boosts[0].EndsAt)
+	assert.Equal(t, 120, boosts[0].Views)
+	assert.Equal(t, 9, boosts[0].Likes)
+	assert.Equal(t, 2, boosts[0].Matches)
+}
+
+func TestCompileSearchFilter(t *testing.T) {
+	expr := &model.FilterExpr{
+		Op: model.FilterAnd,
+		Children: []model.FilterExpr{
+			{Op: model.FilterInterest, Description: "hiking"},
+			{Op: model.FilterOr, Children: []model.FilterExpr{
+				{Op: model.FilterParameter, Description: "height", Value: "180"},
+				{Op: model.FilterGoal, Value: "2"},
+			}},
+			{Op: model.FilterVerified},
+			{Op: model.FilterNot, Children: []model.FilterExpr{
+				{Op: model.FilterInterest, Description: "'; DROP TABLE profiles; --"},
+			}},
+		},
+	}
+
+	predicate, args, err := repository.CompileSearchFilter(expr, 15)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"hiking", "height", "180", 2, "'; DROP TABLE profiles; --"}, args)
+	assert.Contains(t, predicate, "LOWER(fi.description) = LOWER($16)")
+	assert.Contains(t, predicate, "fpr.parameter_description = $17")
+	assert.Contains(t, predicate, "p.goal = $19")
+	assert.Contains(t, predicate, "AND p.is_verified AND")
+	assert.Contains(t, predicate, "(NOT EXISTS")
+	assert.NotContains(t, predicate, "DROP TABLE")
+
+	_, _, err = repository.CompileSearchFilter(&model.FilterExpr{Op: "xor"}, 0)
+	assert.ErrorIs(t, err, model.ErrInvalidSearchFilter)
+
+	_, _, err = repository.CompileSearchFilter(&model.FilterExpr{
+		Op:       model.FilterNot,
+		Children: []model.FilterExpr{{Op: model.FilterGoal, Value: "1"}, {Op: model.FilterGoal, Value: "2"}},
+	}, 0)
+	assert.ErrorIs(t, err, model.ErrInvalidSearchFilter)
+
+	predicate, args, err = repository.CompileSearchFilter(nil, 11)
+	assert.NoError(t, err)
+	assert.Empty(t, predicate)
+	assert.Empty(t, args)
+}
+
+func TestGetSavedSearches(t *testing.T) {
+	mockDB := new(MockDB)
+
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{
+				3,
+				"Hikers",
+				`{"isMale":"Female","ageMin":25,"filter":{"op":"interest","description":"hiking"}}`,
+				true,
+				sql.NullTime{Time: createdAt, Valid: true},
+				sql.NullTime{Time: createdAt, Valid: true},
+			},
+		},
+	}
+
+	mockDB.On("Query", mock.Anything, repository.GetSavedSearchesQuery, []interface{}{1}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	searches, err := repo.GetSavedSearches(1)
+
+	assert.NoError(t, err)
+	assert.Len(t, searches, 1)
+	assert.Equal(t, 3, searches[0].SearchID)
+	assert.Equal(t, "Hikers", searches[0].Name)
+	assert.True(t, searches[0].Alerts)
+	assert.Equal(t, "Female", searches[0].Params.IsMale)
+	assert.Equal(t, 25, searches[0].Params.AgeMin)
+	assert.Equal(t, &model.FilterExpr{Op: model.FilterInterest, Description: "hiking"}, searches[0].Params.Filter)
+	assert.Equal(t, createdAt, searches[0].CreatedAt)
+}
+
+func TestFindNewSearchHits(t *testing.T) {
+	mockDB := new(MockDB)
+
+	watermark := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	runAt := watermark.Add(15 * time.Minute)
+	search := model.SavedSearch{
+		SearchID:  3,
+		ProfileID: 1,
+		Params:    model.SearchProfileRequest{Preferences: []model.Preference{}},
+		Watermark: watermark,
+	}
+
+	rows := &MockRows{
+		data: [][]interface{}{
+			{
+				sql.NullTime{Time: runAt, Valid: true},
+				sql.NullInt64{Int64: 7, Valid: true},
+				sql.NullTime{Time: watermark.Add(time.Minute), Valid: true},
+			},
+			{
+				sql.NullTime{Time: runAt, Valid: true},
+				sql.NullInt64{Int64: 9, Valid: true},
+				sql.NullTime{Time: watermark.Add(2 * time.Minute), Valid: true},
+			},
+		},
+	}
+
+	params := search.Params
+	mockDB.On("Query", mock.Anything, repository.FindNewSearchHitsQuery, []interface{}{
+		1,
+		params.IsMale,
+		params.AgeMin,
+		params.AgeMax,
+		params.HeightMin,
+		params.HeightMax,
+		params.Goal,
+		params.Country,
+		params.City,
+		params.Preferences,
+		params.Input,
+		watermark,
+		3,
+		2,
+	}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	hits, next, err := repo.FindNewSearchHits(search, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7, 9}, hits)
+	// The page is full, so the watermark stops at the last hit instead of
+	// jumping to the run time.
+	assert.Equal(t, watermark.Add(2*time.Minute), next)
+}
+
+func TestSaveSearchLimitUnderLock(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("QueryRow", mock.Anything, repository.LockProfileQuery, 1).Return(&mockRow{values: []interface{}{1}})
+	tx.On("QueryRow", mock.Anything, repository.CountSavedSearchesQuery, 1, "nearby").Return(&mockRow{values: []interface{}{model.MaxSavedSearches}})
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	_, err := repo.SaveSearch(model.SavedSearch{ProfileID: 1, Name: "nearby"})
+	assert.Equal(t, model.ErrTooManySavedSearches, err)
+	tx.AssertNotCalled(t, "QueryRow", mock.Anything, repository.SaveSearchQuery, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAutocomplete(t *testing.T) {
+	mockDB := new(MockDB)
+
+	rows := &MockRows{
+		data: [][]interface{}{
+			{"Rock climbing", "", 12},
+			{"Rock_n_roll", "", 3},
+		},
+	}
+
+	mockDB.On("Query", mock.Anything, repository.AutocompleteInterestsQuery, []interface{}{
+		"rock_",
+		`rock\_%`,
+		10,
+	}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	suggestions, err := repo.Autocomplete(model.DictionaryInterests, "  rock_ ", "Height", 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Suggestion{
+		{Value: "Rock climbing", Popularity: 12},
+		{Value: "Rock_n_roll", Popularity: 3},
+	}, suggestions)
+
+	_, err = repo.Autocomplete("planets", "mars", "", 10)
+	assert.Equal(t, model.ErrUnknownDictionary, err)
+}
+
+func TestGetCompleteness(t *testing.T) {
+	mockDB := new(MockDB)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{model.CompletenessPhoto, 25, true},
+			{model.CompletenessDescription, 15, false},
+			{model.CompletenessInterests, 15, true},
+			{model.CompletenessPrompts, 15, true},
+			{model.CompletenessMorePhotos, 10, false},
+			{model.CompletenessPreferences, 10, false},
+			{model.CompletenessParameters, 10, false},
+		},
+	}
+	mockDB.On("Query", mock.Anything, repository.GetCompletenessQuery, []interface{}{4}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	completeness, err := repo.GetCompleteness(4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 55, completeness.Score)
+	assert.Equal(t, []string{
+		model.CompletenessDescription,
+		model.CompletenessMorePhotos,
+		model.CompletenessPreferences,
+		model.CompletenessParameters,
+	}, completeness.Missing)
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	prev := repository.SnapshotOf(model.Profile{
+		FirstName: "Alice",
+		Interests: []string{"Music", "Chess"},
+		Photos:    []string{"/a.webp", "/b.webp"},
+	})
+	next := repository.SnapshotOf(model.Profile{
+		FirstName:   "Alice",
+		Description: "Likes chess",
+		Interests:   []string{"Chess", "Music"},
+		Photos:      []string{"/b.webp", "/a.webp"},
+	})
+
+	changes, err := repository.DiffSnapshots(prev, next)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.FieldChange{
+		{Field: "description", Old: []byte(`""`), New: []byte(`"Likes chess"`)},
+		{Field: "photos", Old: []byte(`["/a.webp","/b.webp"]`), New: []byte(`["/b.webp","/a.webp"]`)},
+	}, changes)
+
+	unchanged, err := repository.DiffSnapshots(next, next)
+	assert.NoError(t, err)
+	assert.Empty(t, unchanged)
+}
+
+func TestGetProfileVersions(t *testing.T) {
+	mockDB := new(MockDB)
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{
+				2, 1, 9, model.VersionModeration,
+				`{"firstName": "Alice", "photos": ["/a.webp"]}`,
+				`[{"field": "photos", "old": [], "new": ["/a.webp"]}]`,
+				sql.NullTime{Time: createdAt, Valid: true},
+			},
+		},
+	}
+	mockDB.On("Query", mock.Anything, repository.GetProfileVersionsQuery, []interface{}{1, model.ProfileHistoryLimit}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	versions, err := repo.GetProfileVersions(1, model.ProfileHistoryLimit)
+	assert.NoError(t, err)
+	assert.Len(t, versions, 1)
+	assert.Equal(t, 9, versions[0].ActorId)
+	assert.Equal(t, model.VersionModeration, versions[0].Action)
+	assert.Equal(t, "Alice", versions[0].Snapshot.FirstName)
+	assert.Equal(t, []string{"/a.webp"}, versions[0].Snapshot.Photos)
+	assert.Equal(t, "photos", versions[0].Changes[0].Field)
+	assert.JSONEq(t, `["/a.webp"]`, string(versions[0].Changes[0].New))
+	assert.Equal(t, createdAt, versions[0].CreatedAt)
+}
+
+func TestUnblockProfile(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("Exec", mock.Anything, repository.DeleteBlockQuery, []interface{}{1, 2}).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	mockDB.On("Exec", mock.Anything, repository.DeleteBlockQuery, []interface{}{1, 3}).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.NoError(t, repo.UnblockProfile(1, 2))
+	assert.ErrorIs(t, repo.UnblockProfile(1, 3), model.ErrBlockNotFound)
+}
+
+func TestGetBlocks(t *testing.T) {
+	mockDB := new(MockDB)
+	blockedAt := time.Date(2025, 6, 2, 9, 30, 0, 0, time.UTC)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{7, "Bob", "/bob.webp", sql.NullTime{Time: blockedAt, Valid: true}},
+		},
+	}
+	mockDB.On("Query", mock.Anything, repository.GetBlocksQuery, []interface{}{1}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	blocks, err := repo.GetBlocks(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.BlockedProfile{
+		{ProfileId: 7, FirstName: "Bob", Avatar: "/bob.webp", BlockedAt: blockedAt},
+	}, blocks)
+}
+
+func TestRemindMatches(t *testing.T) {
+	mockDB := new(MockDB)
+	expiresAt := time.Date(2025, 6, 3, 12, 0, 0, 0, time.UTC)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{1, 2, model.MatchExpiring, sql.NullTime{Time: expiresAt, Valid: true}},
+		},
+	}
+	reminders := []time.Duration{48 * time.Hour, 3 * time.Hour}
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+	tx.On("Query", mock.Anything, repository.RemindMatchesQuery, []int64{172800, 10800}).Return(rows, nil)
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxMatchReminder, 1, `{"matchedId":2,"expiresAt":"2025-06-03T12:00:00Z"}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxMatchReminder, 2, `{"matchedId":1,"expiresAt":"2025-06-03T12:00:00Z"}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	due, err := repo.RemindMatches(reminders)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.MatchExpiry{
+		{ProfileId: 1, MatchedId: 2, State: model.MatchExpiring, ExpiresAt: expiresAt},
+	}, due)
+	tx.AssertExpectations(t)
+}
+
+func TestGetPendingMatches(t *testing.T) {
+	mockDB := new(MockDB)
+	expiresAt := time.Date(2025, 6, 3, 12, 0, 0, 0, time.UTC)
+	rows := &MockRows{
+		data: [][]interface{}{
+			{5, model.MatchCreated, sql.NullTime{Time: expiresAt, Valid: true}, true},
+		},
+	}
+	mockDB.On("Query", mock.Anything, repository.GetPendingMatchesQuery, []interface{}{1}).Return(rows, nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	matches, err := repo.GetPendingMatches(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.MatchExpiry{
+		{ProfileId: 1, MatchedId: 5, State: model.MatchCreated, ExpiresAt: expiresAt, Extended: true},
+	}, matches)
+}
+
+func TestSetLikeMakesOneMatch(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("Exec", mock.Anything, repository.ClaimLikeRequestQuery, 1, "key-1", 2, 1, int64(86400)).Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	tx.On("Exec", mock.Anything, repository.LockLikePairQuery, 1, 2).Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CreateLikeQuery, 1, 2, 1, "", "", 0, (*time.Time)(nil)).Return(&mockRow{values: []interface{}{10}})
+	tx.On("Exec", mock.Anything, repository.RecordSecondLookQuery, 1, 2).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CheckLikeExistsQuery, 2, 1).Return(&mockRow{values: []interface{}{9, 1}})
+	tx.On("QueryRow", mock.Anything, repository.CreateMatchQuery, 1, 2, mock.Anything).Return(&mockRow{values: []interface{}{1}})
+	tx.On("Query", mock.Anything, repository.GetMatchCommentsQuery, 1, 2).Return(&MockRows{}, nil)
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxMatch, 2, `{"matchedId":1}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxMatch, 1, `{"matchedId":2}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Exec", mock.Anything, repository.StoreLikeResultQuery, 1, "key-1", -1).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likeID, replayed, err := repo.SetLike(1, 2, 1, model.LikeComment{}, "key-1")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, likeID)
+	assert.False(t, replayed)
+	tx.AssertExpectations(t)
+}
+
+func TestSetLikeQueuesMatchComments(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	rows := &MockRows{
+		data: [][]interface{}{
+			{2, "Nice hike!", "/images/b.jpg", 0},
+			{1, "Thanks :)", "", 0},
+		},
+	}
+	comment := model.LikeComment{ProfileId: 1, Text: "Thanks :)"}
+	tx.On("Exec", mock.Anything, repository.LockLikePairQuery, 1, 2).Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CreateLikeQuery, 1, 2, 1, "Thanks :)", "", 0, (*time.Time)(nil)).Return(&mockRow{values: []interface{}{10}})
+	tx.On("Exec", mock.Anything, repository.RecordSecondLookQuery, 1, 2).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CheckLikeExistsQuery, 2, 1).Return(&mockRow{values: []interface{}{9, 1}})
+	tx.On("QueryRow", mock.Anything, repository.CreateMatchQuery, 1, 2, mock.Anything).Return(&mockRow{values: []interface{}{1}})
+	tx.On("Query", mock.Anything, repository.GetMatchCommentsQuery, 1, 2).Return(rows, nil)
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxMatch, 2, `{"matchedId":1,"comments":[{"profileId":2,"text":"Nice hike!"},{"profileId":1,"text":"Thanks :)"}]}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxMatch, 1, `{"matchedId":2,"comments":[{"profileId":2,"text":"Nice hike!"},{"profileId":1,"text":"Thanks :)"}]}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likeID, _, err := repo.SetLike(1, 2, 1, comment, "")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, likeID)
+	tx.AssertExpectations(t)
+}
+
+func TestSetLikeKeepsExistingMatch(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("Exec", mock.Anything, repository.LockLikePairQuery, 1, 2).Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CreateLikeQuery, 1, 2, 1, "", "", 0, (*time.Time)(nil)).Return(&mockRow{values: []interface{}{10}})
+	tx.On("Exec", mock.Anything, repository.RecordSecondLookQuery, 1, 2).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CheckLikeExistsQuery, 2, 1).Return(&mockRow{values: []interface{}{9, 1}})
+	tx.On("QueryRow", mock.Anything, repository.CreateMatchQuery, 1, 2, mock.Anything).Return(&mockRow{values: []interface{}{0}})
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likeID, replayed, err := repo.SetLike(1, 2, 1, model.LikeComment{}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, likeID)
+	assert.False(t, replayed)
+	tx.AssertNotCalled(t, "Exec", mock.Anything, repository.InsertOutboxEventQuery, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetLikeReplaysIdempotencyKey(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("Exec", mock.Anything, repository.ClaimLikeRequestQuery, 1, "key-1", mock.Anything, mock.Anything, int64(86400)).Return(pgconn.NewCommandTag("INSERT 0 0"), nil)
+	tx.On("QueryRow", mock.Anything, repository.GetLikeRequestQuery, 1, "key-1").Return(&mockRow{values: []interface{}{2, 1, -1}})
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likeID, replayed, err := repo.SetLike(1, 2, 1, model.LikeComment{}, "key-1")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, likeID)
+	assert.True(t, replayed)
+
+	_, _, err = repo.SetLike(1, 3, 1, model.LikeComment{}, "key-1")
+	assert.ErrorIs(t, err, model.ErrIdempotencyKeyReused)
+
+	tx.AssertNotCalled(t, "Exec", mock.Anything, repository.LockLikePairQuery, mock.Anything, mock.Anything)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestSetLikeDislikeExpires(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	var expiresAt *time.Time
+	tx.On("Exec", mock.Anything, repository.LockLikePairQuery, 1, 2).Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CreateLikeQuery, 1, 2, 2, "", "", 0, mock.Anything).
+		Run(func(args mock.Arguments) { expiresAt = args.Get(8).(*time.Time) }).
+		Return(&mockRow{values: []interface{}{10}})
+	tx.On("Exec", mock.Anything, repository.RecordSecondLookQuery, 1, 2).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+	tx.On("Exec", mock.Anything, repository.DeleteMatchQuery, 1, 2).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	likeID, _, err := repo.SetLike(1, 2, 2, model.LikeComment{}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, likeID)
+	if assert.NotNil(t, expiresAt) {
+		assert.WithinDuration(t, time.Now().Add(model.DislikeTTL), *expiresAt, time.Minute)
+	}
+}
+
+func TestGetSecondLook(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("QueryRow", mock.Anything, repository.GetSecondLookQuery, []interface{}{1}).Return(&mockRow{values: []interface{}{true, 4}})
+	mockDB.On("QueryRow", mock.Anything, repository.GetSecondLookQuery, []interface{}{2}).Return(&mockRow{err: pgx.ErrNoRows})
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	settings, err := repo.GetSecondLook(1)
+	assert.NoError(t, err)
+	assert.Equal(t, model.SecondLook{Enabled: true, Recycled: 4}, settings)
+
+	_, err = repo.GetSecondLook(2)
+	assert.ErrorIs(t, err, model.ErrProfileNotFound)
+}
+
+func TestSetSecondLook(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("Exec", mock.Anything, repository.SetSecondLookQuery, []interface{}{1, false}).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	mockDB.On("Exec", mock.Anything, repository.SetSecondLookQuery, []interface{}{2, false}).Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.NoError(t, repo.SetSecondLook(1, false))
+	assert.ErrorIs(t, repo.SetSecondLook(2, false), model.ErrProfileNotFound)
+}
+
+func TestSetLikeRecordsSecondLook(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("Exec", mock.Anything, repository.LockLikePairQuery, 1, 2).Return(pgconn.NewCommandTag("SELECT 1"), nil)
+	tx.On("QueryRow", mock.Anything, repository.CreateLikeQuery, 1, 2, 1, "", "", 0, (*time.Time)(nil)).Return(&mockRow{values: []interface{}{10}})
+	tx.On("Exec", mock.Anything, repository.RecordSecondLookQuery, 1, 2).Return(pgconn.CommandTag{}, assert.AnError)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	_, _, err := repo.SetLike(1, 2, 1, model.LikeComment{}, "")
+	assert.ErrorIs(t, err, assert.AnError)
+	tx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+func TestRewindLike(t *testing.T) {
+	mockDB := new(MockDB)
+	mockDB.On("QueryRow", mock.Anything, repository.RewindLikeQuery, []interface{}{1}).Return(&mockRow{values: []interface{}{5}})
+	mockDB.On("QueryRow", mock.Anything, repository.RewindLikeQuery, []interface{}{2}).Return(&mockRow{err: pgx.ErrNoRows})
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	dislikedId, err := repo.RewindLike(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, dislikedId)
+
+	_, err = repo.RewindLike(2)
+	assert.ErrorIs(t, err, model.ErrNothingToRewind)
+}
+
+func TestBlockProfileQueuesChatClose(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("QueryRow", mock.Anything, repository.LockProfileQuery, 2).Return(&mockRow{values: []interface{}{2}})
+	tx.On("Exec", mock.Anything, repository.InsertBlockQuery, 1, 2, "spam").Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	tx.On("Exec", mock.Anything, repository.DeleteMatchQuery, 1, 2).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxChatClosed, 1, `{"matchedId":2}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.NoError(t, repo.BlockProfile(1, 2, "spam"))
+	tx.AssertExpectations(t)
+}
+
+func TestUnmatchQueuesChatClose(t *testing.T) {
+	mockDB := new(MockDB)
+	tx := new(MockTx)
+	mockDB.On("Begin", mock.Anything).Return(tx, nil)
+
+	tx.On("Exec", mock.Anything, repository.DeleteMatchQuery, 1, 2).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.DislikeMatchQuery, 1, 2).Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	tx.On("Exec", mock.Anything, repository.InsertUnmatchQuery, 1, 2, "").Return(pgconn.NewCommandTag("INSERT 0 1"), nil)
+	tx.On("Exec", mock.Anything, repository.InsertOutboxEventQuery, model.OutboxChatClosed, 1, `{"matchedId":2}`).Return(pgconn.NewCommandTag("INSERT 0 1"), nil).Once()
+	tx.On("Commit", mock.Anything).Return(nil)
+	tx.On("Rollback", mock.Anything).Return(nil)
+
+	repo := &repository.ProfileRepo{DB: mockDB}
+
+	assert.NoError(t, repo.Unmatch(1, 2, ""))
+	tx.AssertExpectations(t)
 }
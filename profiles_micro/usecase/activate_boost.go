@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) ActivateBoost(ctx context.Context, req *profiles.ActivateBoostRequest) (*profiles.ActivateBoostResponse, error) {
+	pss.Logger.Info("ActivateBoost", "profile_id", req.GetProfileId())
+
+	boost, remaining, err := pss.ProfilesRepo.ActivateBoost(int(req.GetProfileId()), model.BoostDuration, req.GetUseAllowance())
+	switch err {
+	case nil:
+	case model.ErrBoostAlreadyActive:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrNoBoostsLeft:
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("ActivateBoost")
+		return nil, status.Errorf(codes.Internal, "failed to activate boost: %v", err)
+	}
+
+	pss.Logger.WithFields(&logrus.Fields{
+		"profile_id": req.GetProfileId(),
+		"boost_id":   boost.BoostID,
+		"source":     boost.Source,
+		"remaining":  remaining,
+	}).Info("ActivateBoost")
+
+	return &profiles.ActivateBoostResponse{
+		Boost:     boostToProto(boost),
+		Remaining: int32(remaining),
+	}, nil
+}
+
+func boostToProto(boost model.Boost) *profiles.Boost {
+	return &profiles.Boost{
+		BoostId:   int32(boost.BoostID),
+		Source:    boost.Source,
+		StartedAt: timestamppb.New(boost.StartedAt),
+		EndsAt:    timestamppb.New(boost.EndsAt),
+		Views:     int32(boost.Views),
+		Likes:     int32(boost.Likes),
+		Matches:   int32(boost.Matches),
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (pss *ProfileServiceServer) Autocomplete(ctx context.Context, req *profiles.AutocompleteRequest) (*profiles.AutocompleteResponse, error) {
+	pss.Logger.Info("Autocomplete", "dictionary", req.GetDictionary(), "query", req.GetQuery())
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = model.AutocompleteLimit
+	}
+	if limit > model.MaxAutocompleteLimit {
+		limit = model.MaxAutocompleteLimit
+	}
+
+	suggestions, err := pss.ProfilesRepo.Autocomplete(req.GetDictionary(), req.GetQuery(), req.GetDescription(), limit)
+	switch err {
+	case nil:
+	case model.ErrUnknownDictionary:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		pss.Logger.WithFields(&logrus.Fields{"dictionary": req.GetDictionary(), "error": err}).Error("Autocomplete")
+		return nil, status.Errorf(codes.Internal, "failed to autocomplete: %v", err)
+	}
+
+	resp := &profiles.AutocompleteResponse{}
+	for _, s := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, &profiles.Suggestion{
+			Value:      s.Value,
+			Context:    s.Context,
+			Popularity: int32(s.Popularity),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// checkBlockReason trims a block or unmatch reason and checks its length.
+func checkBlockReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > model.MaxBlockReasonLength {
+		return "", status.Errorf(codes.InvalidArgument, "reason longer than %d characters", model.MaxBlockReasonLength)
+	}
+	return reason, nil
+}
+
+func (pss *ProfileServiceServer) Unmatch(ctx context.Context, req *profiles.UnmatchRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("Unmatch", "profile_id", req.GetProfileId(), "matched_id", req.GetMatchedId())
+
+	reason, err := checkBlockReason(req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	err = pss.ProfilesRepo.Unmatch(int(req.GetProfileId()), int(req.GetMatchedId()), reason)
+	if err == model.ErrMatchNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "matched_id": req.GetMatchedId(), "error": err}).Error("Unmatch")
+		return nil, status.Errorf(codes.Internal, "failed to unmatch: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (pss *ProfileServiceServer) BlockProfile(ctx context.Context, req *profiles.BlockProfileRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("BlockProfile", "profile_id", req.GetProfileId(), "blocked_id", req.GetBlockedId())
+
+	if req.GetProfileId() == req.GetBlockedId() {
+		return nil, status.Error(codes.InvalidArgument, "cannot block yourself")
+	}
+	reason, err := checkBlockReason(req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	err = pss.ProfilesRepo.BlockProfile(int(req.GetProfileId()), int(req.GetBlockedId()), reason)
+	if err == model.ErrProfileNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "blocked_id": req.GetBlockedId(), "error": err}).Error("BlockProfile")
+		return nil, status.Errorf(codes.Internal, "failed to block profile: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (pss *ProfileServiceServer) UnblockProfile(ctx context.Context, req *profiles.UnblockProfileRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("UnblockProfile", "profile_id", req.GetProfileId(), "blocked_id", req.GetBlockedId())
+
+	err := pss.ProfilesRepo.UnblockProfile(int(req.GetProfileId()), int(req.GetBlockedId()))
+	if err == model.ErrBlockNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "blocked_id": req.GetBlockedId(), "error": err}).Error("UnblockProfile")
+		return nil, status.Errorf(codes.Internal, "failed to unblock profile: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (pss *ProfileServiceServer) GetBlocks(ctx context.Context, req *profiles.GetBlocksRequest) (*profiles.GetBlocksResponse, error) {
+	pss.Logger.Info("GetBlocks", "profile_id", req.GetProfileId())
+
+	blocks, err := pss.ProfilesRepo.GetBlocks(int(req.GetProfileId()))
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("GetBlocks")
+		return nil, status.Errorf(codes.Internal, "failed to get blocks: %v", err)
+	}
+
+	resp := &profiles.GetBlocksResponse{}
+	for _, b := range blocks {
+		resp.Blocks = append(resp.Blocks, &profiles.BlockedProfile{
+			ProfileId: int32(b.ProfileId),
+			FirstName: b.FirstName,
+			Avatar:    b.Avatar,
+			BlockedAt: timestamppb.New(b.BlockedAt),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CollectSearchAlerts re-runs saved searches with alerts enabled and returns
+// the profiles that started matching since the previous run. Watermarks are
+// advanced as hits are collected, so each hit is reported only once; the
+// caller is responsible for delivering the notifications.
+func (pss *ProfileServiceServer) CollectSearchAlerts(ctx context.Context, _ *emptypb.Empty) (*profiles.CollectSearchAlertsResponse, error) {
+	searches, err := pss.ProfilesRepo.GetAlertSearches(model.SearchAlertBatch)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("CollectSearchAlerts")
+		return nil, status.Errorf(codes.Internal, "failed to get alert searches: %v", err)
+	}
+
+	resp := &profiles.CollectSearchAlertsResponse{}
+	for _, search := range searches {
+		hits, watermark, err := pss.ProfilesRepo.FindNewSearchHits(search, model.SearchAlertHitsLimit)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"search_id": search.SearchID, "error": err}).Error("CollectSearchAlerts")
+			continue
+		}
+
+		if err := pss.ProfilesRepo.RecordSearchHits(search.SearchID, hits, watermark); err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"search_id": search.SearchID, "error": err}).Error("CollectSearchAlerts")
+			continue
+		}
+
+		if len(hits) == 0 {
+			continue
+		}
+
+		alert := &profiles.SearchAlert{
+			ProfileId: int32(search.ProfileID),
+			SearchId:  int32(search.SearchID),
+			Name:      search.Name,
+		}
+		for _, hit := range hits {
+			alert.Hits = append(alert.Hits, int32(hit))
+		}
+		resp.Alerts = append(resp.Alerts, alert)
+	}
+
+	pss.Logger.WithFields(&logrus.Fields{"searches": len(searches), "alerts": len(resp.Alerts)}).Info("CollectSearchAlerts")
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (pss *ProfileServiceServer) DeleteSavedSearch(ctx context.Context, req *profiles.DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("DeleteSavedSearch", "profile_id", req.GetProfileId(), "search_id", req.GetSearchId())
+
+	err := pss.ProfilesRepo.DeleteSavedSearch(int(req.GetProfileId()), int(req.GetSearchId()))
+	switch err {
+	case nil:
+	case model.ErrSavedSearchNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("DeleteSavedSearch")
+		return nil, status.Errorf(codes.Internal, "failed to delete saved search: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
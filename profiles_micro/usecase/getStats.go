@@ -22,11 +22,29 @@ func (pss *ProfileServiceServer) GetProfileStats(
 		return nil, status.Errorf(codes.Internal, "failed to get profile stats: %v", err)
 	}
 
+	boosts, err := pss.ProfilesRepo.GetBoostStats(int(profileID))
+	if err != nil {
+		pss.Logger.Error("GetStats boosts error", "profile_id", profileID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get boost stats: %v", err)
+	}
+
+	completeness, err := pss.ProfilesRepo.GetCompleteness(int(profileID))
+	if err != nil {
+		pss.Logger.Error("GetStats completeness error", "profile_id", profileID, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get profile completeness: %v", err)
+	}
+
 	pss.Logger.WithFields(&logrus.Fields{
 		"profile_id": profileID,
 		"stats":      stats,
+		"boosts":     len(boosts),
 	}).Info("Fetched stats")
 
+	var boostStats []*profiles.Boost
+	for _, boost := range boosts {
+		boostStats = append(boostStats, boostToProto(boost))
+	}
+
 	return &profiles.GetProfileStatsResponse{
 		LikesGiven:         int32(stats.LikesGiven),
 		LikesReceived:      int32(stats.LikesReceived),
@@ -35,5 +53,8 @@ func (pss *ProfileServiceServer) GetProfileStats(
 		ComplaintsReceived: int32(stats.ComplaintsReceived),
 		MessagesSent:       int32(stats.MessagesSent),
 		ChatCount:          int32(stats.ChatCount),
+		Recycled:           int32(stats.Recycled),
+		Boosts:             boostStats,
+		Completeness:       completenessToProto(completeness),
 	}, nil
 }
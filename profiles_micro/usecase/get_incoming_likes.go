@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) GetIncomingLikes(ctx context.Context, req *profiles.GetIncomingLikesRequest) (*profiles.GetIncomingLikesResponse, error) {
+	pss.Logger.Info("GetIncomingLikes", "profile_id", req.GetProfileId())
+
+	likes, err := pss.ProfilesRepo.GetIncomingLikes(int(req.GetProfileId()))
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("GetIncomingLikes")
+		return nil, status.Errorf(codes.Internal, "failed to get incoming likes: %v", err)
+	}
+
+	var result []*profiles.IncomingLike
+	for _, like := range likes {
+		incoming := &profiles.IncomingLike{
+			Profile: pss.profileToProto(like.Profile, model.PhotoThumb),
+			Status:  int32(like.Status),
+			LikedAt: timestamppb.New(like.LikedAt),
+		}
+		if like.Comment != nil {
+			incoming.Comment = likeCommentToProto(*like.Comment)
+		}
+		result = append(result, incoming)
+	}
+
+	pss.Logger.WithFields(&logrus.Fields{
+		"profile_id": req.GetProfileId(),
+		"count":      len(result),
+	}).Info("GetIncomingLikes")
+
+	return &profiles.GetIncomingLikesResponse{Likes: result}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
 	"github.com/sirupsen/logrus"
 )
 
@@ -20,6 +22,17 @@ func (pss *ProfileServiceServer) GetProfileImages(ctx context.Context, req *prof
 		pss.Logger.Error("GetProfileImages", "user_id", req.GetUserId(), "files error", err)
 		return nil, err
 	}
+
+	var variants []*profiles.PhotoVariants
+	for _, url := range urls {
+		keys := repository.PhotoVariantKeys(url)
+		variants = append(variants, &profiles.PhotoVariants{
+			Thumb: keys[model.PhotoThumb],
+			Card:  keys[model.PhotoCard],
+			Full:  keys[model.PhotoFull],
+		})
+	}
+
 	pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "urlsCount": len(urls)}).Info("ok")
-	return &profiles.GetProfileImagesResponse{Files: files, Urls: urls}, nil
+	return &profiles.GetProfileImagesResponse{Files: files, Urls: urls, Variants: variants}, nil
 }
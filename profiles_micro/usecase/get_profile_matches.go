@@ -4,8 +4,8 @@ import (
 	"context"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (pss *ProfileServiceServer) GetProfileMatches(ctx context.Context, req *profiles.GetProfileMatchesRequest) (*profiles.GetProfileMatchesResponse, error) {
@@ -19,27 +19,7 @@ func (pss *ProfileServiceServer) GetProfileMatches(ctx context.Context, req *pro
 
 	var profs []*profiles.Profile
 	for _, profile := range result {
-		var prefs []*profiles.Preference
-		for _, preference := range profile.Preferences {
-			prefs = append(prefs, &profiles.Preference{
-				Description: preference.Description,
-				Value:       preference.Value,
-			})
-		}
-		profs = append(profs, &profiles.Profile{
-			ProfileId:   int32(profile.ProfileId),
-			FirstName:   profile.FirstName,
-			LastName:    profile.LastName,
-			IsMale:      profile.IsMale,
-			Height:      int32(profile.Height),
-			Birthday:    timestamppb.New(profile.Birthday),
-			Description: profile.Description,
-			Location:    profile.Location,
-			Interests:   profile.Interests,
-			// we dont give anybody information about whom profile liked by
-			Preferences: prefs,
-			Photos:      profile.Photos,
-		})
+		profs = append(profs, pss.profileToProto(profile, model.PhotoThumb))
 	}
 
 	return &profiles.GetProfileMatchesResponse{Profiles: profs}, err
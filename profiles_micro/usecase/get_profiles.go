@@ -4,16 +4,16 @@ import (
 	"context"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (pss *ProfileServiceServer) GetProfiles(
 	ctx context.Context,
 	req *profiles.GetProfilesRequest,
 ) (*profiles.GetProfilesResponse, error) {
-	pss.Logger.Info("GetProfiles", "forUserId", req.GetForUserId())
-	result, err := pss.ProfilesRepo.GetProfilesByUserId(int(req.GetForUserId()))
+	pss.Logger.Info("GetProfiles", "forUserId", req.GetForUserId(), "verifiedOnly", req.GetVerifiedOnly())
+	result, err := pss.ProfilesRepo.GetProfilesByUserId(int(req.GetForUserId()), req.GetVerifiedOnly())
 	if err != nil {
 		pss.Logger.Error("GetProfiles", "forUserId", req.GetForUserId(), "error", err)
 	} else {
@@ -25,45 +25,7 @@ func (pss *ProfileServiceServer) GetProfiles(
 
 	var profs []*profiles.Profile
 	for _, profile := range result {
-		likedBy := []int32{}
-		for _, like := range profile.LikedBy {
-			likedBy = append(likedBy, int32(like))
-		}
-		var prefs []*profiles.Preference
-		for _, preference := range profile.Preferences {
-			prefs = append(prefs, &profiles.Preference{
-				Description: preference.Description,
-				Value:       preference.Value,
-			})
-		}
-		var params []*profiles.Preference
-		for _, preference := range profile.Parameters {
-			params = append(params, &profiles.Preference{
-				Description: preference.Description,
-				Value:       preference.Value,
-			})
-		}
-
-		profs = append(profs, &profiles.Profile{
-			ProfileId:   int32(profile.ProfileId),
-			FirstName:   profile.FirstName,
-			LastName:    profile.LastName,
-			IsMale:      profile.IsMale,
-			Height:      int32(profile.Height),
-			Goal:        int32(profile.Goal),
-			Birthday:    timestamppb.New(profile.Birthday),
-			Description: profile.Description,
-			Location:    profile.Location,
-			Interests:   profile.Interests,
-			Preferences: prefs,
-			Parametres:  params,
-			Photos:      profile.Photos,
-			LikedBy:     likedBy,
-			Premium: &profiles.Premium{
-				Status: profile.Premium.Status,
-				Border: int32(profile.Premium.Border),
-			},
-		})
+		profs = append(profs, pss.profileToProto(profile, model.PhotoCard))
 	}
 
 	return &profiles.GetProfilesResponse{Profiles: profs}, nil
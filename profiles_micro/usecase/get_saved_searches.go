@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (pss *ProfileServiceServer) GetSavedSearches(ctx context.Context, req *profiles.GetSavedSearchesRequest) (*profiles.GetSavedSearchesResponse, error) {
+	pss.Logger.Info("GetSavedSearches", "profile_id", req.GetProfileId())
+
+	searches, err := pss.ProfilesRepo.GetSavedSearches(int(req.GetProfileId()))
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("GetSavedSearches")
+		return nil, status.Errorf(codes.Internal, "failed to get saved searches: %v", err)
+	}
+
+	resp := &profiles.GetSavedSearchesResponse{}
+	for _, search := range searches {
+		resp.Searches = append(resp.Searches, savedSearchToProto(search))
+	}
+
+	return resp, nil
+}
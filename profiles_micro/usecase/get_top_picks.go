@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) GetTopPicks(ctx context.Context, req *profiles.GetTopPicksRequest) (*profiles.GetTopPicksResponse, error) {
+	pss.Logger.Info("GetTopPicks", "profile_id", req.GetProfileId())
+
+	picks, err := pss.ProfilesRepo.GetTopPicks(int(req.GetProfileId()))
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("GetTopPicks")
+		return nil, status.Errorf(codes.Internal, "failed to get top picks: %v", err)
+	}
+
+	var profs []*profiles.Profile
+	for _, profile := range picks.Profiles {
+		profs = append(profs, pss.profileToProto(profile, model.PhotoCard))
+	}
+
+	resp := &profiles.GetTopPicksResponse{Profiles: profs}
+	if !picks.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(picks.ExpiresAt)
+	}
+
+	return resp, nil
+}
+
+// RunTopPicksJob refreshes daily top picks for premium users. Picks are
+// generated per user-local day, so the job ticks hourly and only fills
+// users whose local midnight has already passed.
+func (pss *ProfileServiceServer) RunTopPicksJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pss.logInvalidTimezones()
+
+		inserted, err := pss.ProfilesRepo.GenerateTopPicks(model.TopPicksLimit)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("RunTopPicksJob")
+		} else {
+			pss.Logger.WithFields(&logrus.Fields{"inserted": inserted}).Info("RunTopPicksJob")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// logInvalidTimezones reports the premium profiles the job had to skip
+// because their stored timezone is unknown to postgres.
+func (pss *ProfileServiceServer) logInvalidTimezones() {
+	zones, err := pss.ProfilesRepo.GetInvalidTimezones()
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("RunTopPicksJob")
+		return
+	}
+	for _, zone := range zones {
+		pss.Logger.WithFields(&logrus.Fields{
+			"profileId": zone.ProfileId,
+			"timezone":  zone.Timezone,
+			"error":     "invalid timezone, profile skipped",
+		}).Warn("RunTopPicksJob")
+	}
+}
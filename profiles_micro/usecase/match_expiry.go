@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func matchExpiryToProto(m model.MatchExpiry) *profiles.MatchExpiry {
+	return &profiles.MatchExpiry{
+		ProfileId: int32(m.ProfileId),
+		MatchedId: int32(m.MatchedId),
+		State:     m.State,
+		ExpiresAt: timestamppb.New(m.ExpiresAt),
+		Extended:  m.Extended,
+	}
+}
+
+// matchReminders leaves out the reminders set further before the expiry
+// than a match lives, which a short MatchTTL would otherwise send at once.
+func matchReminders() []time.Duration {
+	var reminders []time.Duration
+	for _, r := range model.MatchReminders {
+		if r < model.MatchTTL {
+			reminders = append(reminders, r)
+		}
+	}
+	return reminders
+}
+
+// CollectMatchExpiry moves pending matches along: it archives the expired
+// ones, marks the ones about to expire, and counts the due reminders as
+// sent. The pairs are told through the outbox; the reminded matches are
+// returned for the caller to count.
+func (pss *ProfileServiceServer) CollectMatchExpiry(ctx context.Context, _ *emptypb.Empty) (*profiles.CollectMatchExpiryResponse, error) {
+	expired, err := pss.ProfilesRepo.ExpireMatches()
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("CollectMatchExpiry")
+		return nil, status.Errorf(codes.Internal, "failed to expire matches: %v", err)
+	}
+
+	if err := pss.ProfilesRepo.MarkExpiringMatches(model.MatchExpiringWindow); err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("CollectMatchExpiry")
+		return nil, status.Errorf(codes.Internal, "failed to mark expiring matches: %v", err)
+	}
+
+	reminders, err := pss.ProfilesRepo.RemindMatches(matchReminders())
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("CollectMatchExpiry")
+		return nil, status.Errorf(codes.Internal, "failed to collect match reminders: %v", err)
+	}
+
+	resp := &profiles.CollectMatchExpiryResponse{}
+	for _, m := range reminders {
+		resp.Reminders = append(resp.Reminders, matchExpiryToProto(m))
+	}
+
+	pss.Logger.WithFields(&logrus.Fields{"expired": len(expired), "reminders": len(resp.Reminders)}).Info("CollectMatchExpiry")
+	return resp, nil
+}
+
+// ExtendMatch pushes back the expiry of a match nobody has written in yet.
+// Whether the user may extend, i.e. has premium, is up to the caller.
+func (pss *ProfileServiceServer) ExtendMatch(ctx context.Context, req *profiles.ExtendMatchRequest) (*profiles.MatchExpiry, error) {
+	pss.Logger.Info("ExtendMatch", "profile_id", req.GetProfileId(), "matched_id", req.GetMatchedId())
+
+	match, err := pss.ProfilesRepo.ExtendMatch(int(req.GetProfileId()), int(req.GetMatchedId()), model.MatchExtension, matchReminders())
+	switch err {
+	case nil:
+		return matchExpiryToProto(match), nil
+	case model.ErrMatchNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case model.ErrMatchNotExtendable:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case model.ErrMatchAlreadyExtended:
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "matched_id": req.GetMatchedId(), "error": err}).Error("ExtendMatch")
+	return nil, status.Errorf(codes.Internal, "failed to extend match: %v", err)
+}
+
+func (pss *ProfileServiceServer) GetPendingMatches(ctx context.Context, req *profiles.GetPendingMatchesRequest) (*profiles.GetPendingMatchesResponse, error) {
+	pss.Logger.Info("GetPendingMatches", "profile_id", req.GetProfileId())
+
+	matches, err := pss.ProfilesRepo.GetPendingMatches(int(req.GetProfileId()))
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("GetPendingMatches")
+		return nil, status.Errorf(codes.Internal, "failed to get pending matches: %v", err)
+	}
+
+	resp := &profiles.GetPendingMatchesResponse{}
+	for _, m := range matches {
+		resp.Matches = append(resp.Matches, matchExpiryToProto(m))
+	}
+	return resp, nil
+}
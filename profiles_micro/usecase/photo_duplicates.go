@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) GetPhotoClusters(ctx context.Context, req *profiles.GetPhotoClustersRequest) (*profiles.GetPhotoClustersResponse, error) {
+	pss.Logger.Info("GetPhotoClusters", "limit", req.GetLimit())
+
+	clusters, err := pss.ProfilesRepo.GetPhotoClusters(model.StolenPhotoDistance, model.PhotoClusterPairsLimit)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("GetPhotoClusters")
+		return nil, status.Errorf(codes.Internal, "failed to get photo clusters: %v", err)
+	}
+	if limit := int(req.GetLimit()); limit > 0 && limit < len(clusters) {
+		clusters = clusters[:limit]
+	}
+
+	resp := &profiles.GetPhotoClustersResponse{
+		ExpiresAt: timestamppb.New(time.Now().Add(model.PhotoDownloadURLTTL)),
+	}
+	for _, cluster := range clusters {
+		protoCluster := &profiles.PhotoCluster{}
+		for _, id := range cluster.ProfileIds {
+			protoCluster.ProfileIds = append(protoCluster.ProfileIds, int32(id))
+		}
+		for _, photoPath := range cluster.Paths {
+			urls, err := pss.presignPhoto(photoPath)
+			if err != nil {
+				pss.Logger.WithFields(&logrus.Fields{"path": photoPath, "error": err}).Error("GetPhotoClusters")
+				return nil, status.Errorf(codes.Internal, "failed to presign download: %v", err)
+			}
+			protoCluster.Photos = append(protoCluster.Photos, urls)
+		}
+		resp.Clusters = append(resp.Clusters, protoCluster)
+	}
+
+	return resp, nil
+}
+
+// RunPhotoHashJob hashes photos stored before perceptual hashing was added,
+// so they take part in duplicate detection too. Photos that cannot be read
+// or decoded are skipped and retried on the next tick.
+func (pss *ProfileServiceServer) RunPhotoHashJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		hashed := pss.hashStoredPhotos(ctx)
+		if hashed > 0 {
+			pss.Logger.WithFields(&logrus.Fields{"hashed": hashed}).Info("RunPhotoHashJob")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (pss *ProfileServiceServer) hashStoredPhotos(ctx context.Context) int {
+	hashed, afterId := 0, 0
+	for ctx.Err() == nil {
+		photos, err := pss.ProfilesRepo.GetUnhashedPhotos(afterId, model.PhotoHashBatch)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("RunPhotoHashJob")
+			return hashed
+		}
+		if len(photos) == 0 {
+			return hashed
+		}
+
+		for _, photo := range photos {
+			afterId = photo.PhotoId
+
+			key := repository.PhotoVariantKeys(photo.Path)[model.PhotoThumb]
+			files, err := pss.StaticRepo.GetImages([]string{key})
+			if err != nil {
+				pss.Logger.WithFields(&logrus.Fields{"photo_id": photo.PhotoId, "error": err}).Warn("RunPhotoHashJob")
+				continue
+			}
+			hash, err := repository.HashPhoto(files[0])
+			if err != nil {
+				pss.Logger.WithFields(&logrus.Fields{"photo_id": photo.PhotoId, "error": err}).Warn("RunPhotoHashJob")
+				continue
+			}
+			if err := pss.ProfilesRepo.SetPhotoHash(photo.PhotoId, hash); err != nil {
+				pss.Logger.WithFields(&logrus.Fields{"photo_id": photo.PhotoId, "error": err}).Error("RunPhotoHashJob")
+				continue
+			}
+			hashed++
+		}
+	}
+
+	return hashed
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetModerationQueue lists pending photos oldest first, with short-lived
+// URLs so reviewers can see photos that are not public yet.
+func (pss *ProfileServiceServer) GetModerationQueue(ctx context.Context, req *profiles.GetModerationQueueRequest) (*profiles.GetModerationQueueResponse, error) {
+	pss.Logger.Info("GetModerationQueue", "limit", req.GetLimit())
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > model.ModerationQueueLimit {
+		limit = model.ModerationQueueLimit
+	}
+
+	pending, err := pss.ProfilesRepo.GetModerationQueue(limit)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("GetModerationQueue")
+		return nil, status.Errorf(codes.Internal, "failed to get moderation queue: %v", err)
+	}
+
+	resp := &profiles.GetModerationQueueResponse{
+		ExpiresAt: timestamppb.New(time.Now().Add(model.PhotoDownloadURLTTL)),
+	}
+	for _, photo := range pending {
+		urls, err := pss.presignPhoto(photo.Path)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"photo_id": photo.PhotoId, "error": err}).Error("GetModerationQueue")
+			return nil, status.Errorf(codes.Internal, "failed to presign download: %v", err)
+		}
+		urls.Status = model.PhotoPending
+
+		resp.Photos = append(resp.Photos, &profiles.PendingPhoto{
+			PhotoId:    int32(photo.PhotoId),
+			ProfileId:  int32(photo.ProfileId),
+			FirstName:  photo.FirstName,
+			Urls:       urls,
+			UploadedAt: timestamppb.New(photo.UploadedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+func (pss *ProfileServiceServer) ModeratePhoto(ctx context.Context, req *profiles.ModeratePhotoRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("ModeratePhoto", "photo_id", req.GetPhotoId(), "moderator_id", req.GetModeratorId(), "approve", req.GetApprove())
+
+	_, err := pss.ProfilesRepo.ModeratePhoto(int(req.GetPhotoId()), int(req.GetModeratorId()), req.GetApprove(), req.GetReason())
+	if err == model.ErrPhotoNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"photo_id": req.GetPhotoId(), "error": err}).Error("ModeratePhoto")
+		return nil, status.Errorf(codes.Internal, "failed to moderate photo: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
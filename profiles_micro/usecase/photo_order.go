@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (pss *ProfileServiceServer) ReorderPhotos(ctx context.Context, req *profiles.ReorderPhotosRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("ReorderPhotos", "user_id", req.GetUserId())
+
+	err := pss.ProfilesRepo.ReorderPhotos(int(req.GetUserId()), req.GetPaths())
+	switch err {
+	case nil:
+	case model.ErrInvalidPhotoOrder:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "error": err}).Error("ReorderPhotos")
+		return nil, status.Errorf(codes.Internal, "failed to reorder photos: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (pss *ProfileServiceServer) SetPrimaryPhoto(ctx context.Context, req *profiles.SetPrimaryPhotoRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("SetPrimaryPhoto", "user_id", req.GetUserId(), "path", req.GetPath())
+
+	err := pss.ProfilesRepo.SetPrimaryPhoto(int(req.GetUserId()), req.GetPath())
+	switch err {
+	case nil:
+	case model.ErrPhotoNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	default:
+		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "error": err}).Error("SetPrimaryPhoto")
+		return nil, status.Errorf(codes.Internal, "failed to set primary photo: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
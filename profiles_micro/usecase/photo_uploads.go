@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// uploadPrefix scopes raw uploads to their owner, so a finalize call can
+// only ever claim objects the same user was allowed to PUT.
+func uploadPrefix(userId int32) string {
+	return fmt.Sprintf("uploads/%d/", userId)
+}
+
+func (pss *ProfileServiceServer) CreatePhotoUpload(ctx context.Context, req *profiles.CreatePhotoUploadRequest) (*profiles.PhotoUpload, error) {
+	pss.Logger.Info("CreatePhotoUpload", "user_id", req.GetUserId())
+
+	if err := pss.checkPhotoLimit(int(req.GetUserId())); err != nil {
+		return nil, err
+	}
+
+	key := uploadPrefix(req.GetUserId()) + uuid.NewString()
+	expiresAt := time.Now().Add(model.PhotoUploadURLTTL)
+
+	url, err := pss.StaticRepo.PresignUpload(key, model.PhotoUploadURLTTL)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "error": err}).Error("CreatePhotoUpload")
+		return nil, status.Errorf(codes.Internal, "failed to presign upload: %v", err)
+	}
+
+	return &profiles.PhotoUpload{
+		UploadKey: key,
+		Url:       url,
+		ExpiresAt: timestamppb.New(expiresAt),
+		MaxSize:   model.MaxPhotoUploadSize,
+	}, nil
+}
+
+// FinalizePhotoUpload checks a presigned upload and turns it into a profile
+// photo through the same pipeline as direct uploads. The raw object is
+// removed whatever the outcome, except when it could not be found at all.
+func (pss *ProfileServiceServer) FinalizePhotoUpload(ctx context.Context, req *profiles.FinalizePhotoUploadRequest) (*profiles.UploadProfileImageResponse, error) {
+	pss.Logger.Info("FinalizePhotoUpload", "user_id", req.GetUserId(), "upload_key", req.GetUploadKey())
+
+	key := req.GetUploadKey()
+	id := strings.TrimPrefix(key, uploadPrefix(req.GetUserId()))
+	if id == key || uuid.Validate(id) != nil {
+		return nil, status.Error(codes.InvalidArgument, model.ErrInvalidUploadKey.Error())
+	}
+
+	size, err := pss.StaticRepo.StatImage(key)
+	if err == model.ErrUploadNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"upload_key": key, "error": err}).Error("FinalizePhotoUpload")
+		return nil, status.Errorf(codes.Internal, "failed to check upload: %v", err)
+	}
+	defer func() {
+		if err := pss.StaticRepo.DeleteImage(int(req.GetUserId()), key); err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"upload_key": key, "error": err}).Warn("FinalizePhotoUpload")
+		}
+	}()
+
+	if size > model.MaxPhotoUploadSize {
+		return nil, status.Error(codes.InvalidArgument, model.ErrUploadTooLarge.Error())
+	}
+
+	files, err := pss.StaticRepo.GetImages([]string{key})
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"upload_key": key, "error": err}).Error("FinalizePhotoUpload")
+		return nil, status.Errorf(codes.Internal, "failed to read upload: %v", err)
+	}
+
+	filename := fmt.Sprintf("/%d_%d_%s", req.GetUserId(), time.Now().UnixNano(), path.Base(key))
+	photoPath, err := pss.storePhoto(int(req.GetUserId()), filename, files[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &profiles.UploadProfileImageResponse{Path: photoPath}, nil
+}
+
+func (pss *ProfileServiceServer) GetPhotoURLs(ctx context.Context, req *profiles.GetProfileImagesRequest) (*profiles.GetPhotoURLsResponse, error) {
+	pss.Logger.Info("GetPhotoURLs", "user_id", req.GetUserId())
+
+	photos, err := pss.ProfilesRepo.GetOwnPhotos(int(req.GetUserId()))
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "error": err}).Error("GetPhotoURLs")
+		return nil, status.Errorf(codes.Internal, "failed to get photos: %v", err)
+	}
+
+	resp := &profiles.GetPhotoURLsResponse{
+		ExpiresAt: timestamppb.New(time.Now().Add(model.PhotoDownloadURLTTL)),
+	}
+	for _, photo := range photos {
+		urls, err := pss.presignPhoto(photo.Path)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "error": err}).Error("GetPhotoURLs")
+			return nil, status.Errorf(codes.Internal, "failed to presign download: %v", err)
+		}
+		urls.Primary = photo.Primary
+		urls.Status = photo.Status
+		urls.Reason = photo.Reason
+		resp.Photos = append(resp.Photos, urls)
+	}
+
+	return resp, nil
+}
+
+func (pss *ProfileServiceServer) presignPhoto(photoPath string) (*profiles.PhotoURLs, error) {
+	keys := repository.PhotoVariantKeys(photoPath)
+	urls := &profiles.PhotoURLs{Path: photoPath}
+
+	var err error
+	if urls.Thumb, err = pss.StaticRepo.PresignDownload(keys[model.PhotoThumb], model.PhotoDownloadURLTTL); err != nil {
+		return nil, err
+	}
+	if urls.Card, err = pss.StaticRepo.PresignDownload(keys[model.PhotoCard], model.PhotoDownloadURLTTL); err != nil {
+		return nil, err
+	}
+	if urls.Full, err = pss.StaticRepo.PresignDownload(keys[model.PhotoFull], model.PhotoDownloadURLTTL); err != nil {
+		return nil, err
+	}
+
+	return urls, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) snapshotToProto(s model.ProfileSnapshot) *profiles.Profile {
+	return pss.profileToProto(model.Profile{
+		FirstName:   s.FirstName,
+		LastName:    s.LastName,
+		IsMale:      s.IsMale,
+		Goal:        s.Goal,
+		Height:      s.Height,
+		Birthday:    s.Birthday,
+		Description: s.Description,
+		Location:    s.Location,
+		Interests:   s.Interests,
+		Preferences: s.Preferences,
+		Parameters:  s.Parameters,
+		Photos:      s.Photos,
+		Prompts:     s.Prompts,
+	}, model.PhotoCard)
+}
+
+func (pss *ProfileServiceServer) GetProfileHistory(ctx context.Context, req *profiles.GetProfileHistoryRequest) (*profiles.GetProfileHistoryResponse, error) {
+	pss.Logger.Info("GetProfileHistory", "profile_id", req.GetProfileId(), "limit", req.GetLimit())
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > model.ProfileHistoryLimit {
+		limit = model.ProfileHistoryLimit
+	}
+
+	versions, err := pss.ProfilesRepo.GetProfileVersions(int(req.GetProfileId()), limit)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("GetProfileHistory")
+		return nil, status.Errorf(codes.Internal, "failed to get profile history: %v", err)
+	}
+
+	resp := &profiles.GetProfileHistoryResponse{}
+	for _, v := range versions {
+		version := &profiles.ProfileVersion{
+			VersionId: int32(v.VersionId),
+			ProfileId: int32(v.ProfileId),
+			ActorId:   int32(v.ActorId),
+			Action:    v.Action,
+			Snapshot:  pss.snapshotToProto(v.Snapshot),
+			CreatedAt: timestamppb.New(v.CreatedAt),
+		}
+		for _, c := range v.Changes {
+			version.Changes = append(version.Changes, &profiles.FieldChange{
+				Field: c.Field,
+				Old:   string(c.Old),
+				New:   string(c.New),
+			})
+		}
+		resp.Versions = append(resp.Versions, version)
+	}
+
+	return resp, nil
+}
+
+// RevertProfile restores a profile to one of its versions. The revert is
+// recorded as a version of its own, so it can be undone the same way.
+func (pss *ProfileServiceServer) RevertProfile(ctx context.Context, req *profiles.RevertProfileRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("RevertProfile", "profile_id", req.GetProfileId(), "version_id", req.GetVersionId(), "actor_id", req.GetActorId())
+
+	version, err := pss.ProfilesRepo.GetProfileVersion(int(req.GetProfileId()), int(req.GetVersionId()))
+	if err == model.ErrVersionNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"version_id": req.GetVersionId(), "error": err}).Error("RevertProfile")
+		return nil, status.Errorf(codes.Internal, "failed to get profile version: %v", err)
+	}
+
+	if err := pss.ProfilesRepo.RevertProfile(version.ProfileId, int(req.GetActorId()), version.Snapshot); err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"version_id": req.GetVersionId(), "error": err}).Error("RevertProfile")
+		return nil, status.Errorf(codes.Internal, "failed to revert profile: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -3,7 +3,10 @@ package usecase
 import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ProfileServiceServer struct {
@@ -24,3 +27,85 @@ func NewProfileServiceServer(
 		Logger:       logger,
 	}
 }
+
+// servePhotos maps stored photo paths to the size variant a response
+// shows: feeds (deck, search, profile pages) use cards, lists such as
+// matches and incoming likes use thumbnails.
+func (pss *ProfileServiceServer) servePhotos(paths []string, variant string) []string {
+	served := make([]string, 0, len(paths))
+	for _, path := range paths {
+		served = append(served, pss.servePhoto(path, variant))
+	}
+	return served
+}
+
+// servePhoto returns a presigned GET URL for one variant of a stored photo.
+// If signing fails the bare object key is served, so one bad photo does
+// not fail the whole feed.
+func (pss *ProfileServiceServer) servePhoto(path string, variant string) string {
+	if path == "" {
+		return ""
+	}
+	key := repository.PhotoVariantKeys(path)[variant]
+	url, err := pss.StaticRepo.PresignDownload(key, model.PhotoDownloadURLTTL)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"key": key, "error": err}).Warn("servePhoto")
+		return key
+	}
+	return url
+}
+
+// profileToProto converts a repository profile to its wire form, with
+// photos presigned in the given size variant. LikedBy is never sent:
+// incoming likes are served by GetIncomingLikes.
+func (pss *ProfileServiceServer) profileToProto(profile model.Profile, variant string) *profiles.Profile {
+	var prefs []*profiles.Preference
+	for _, preference := range profile.Preferences {
+		prefs = append(prefs, &profiles.Preference{
+			Description: preference.Description,
+			Value:       preference.Value,
+		})
+	}
+
+	var params []*profiles.Preference
+	for _, preference := range profile.Parameters {
+		params = append(params, &profiles.Preference{
+			Description: preference.Description,
+			Value:       preference.Value,
+		})
+	}
+
+	prof := &profiles.Profile{
+		ProfileId:   int32(profile.ProfileId),
+		FirstName:   profile.FirstName,
+		LastName:    profile.LastName,
+		IsMale:      profile.IsMale,
+		Goal:        int32(profile.Goal),
+		Height:      int32(profile.Height),
+		Birthday:    timestamppb.New(profile.Birthday),
+		Description: profile.Description,
+		Location:    profile.Location,
+		Interests:   profile.Interests,
+		Preferences: prefs,
+		Parametres:  params,
+		Photos:      pss.servePhotos(profile.Photos, variant),
+		Verified:    profile.Verified,
+		Prompts:     promptsToProto(profile.Prompts),
+		Timezone:    profile.Timezone,
+	}
+	if profile.Premium.Status {
+		prof.Premium = &profiles.Premium{
+			Status: profile.Premium.Status,
+			Border: int32(profile.Premium.Border),
+		}
+	}
+
+	return prof
+}
+
+func completenessToProto(c model.Completeness) *profiles.Completeness {
+	return &profiles.Completeness{
+		Score:   int32(c.Score),
+		Missing: c.Missing,
+	}
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func promptsFromProto(prompts []*profiles.ProfilePrompt) []model.ProfilePrompt {
+	var res []model.ProfilePrompt
+	for _, p := range prompts {
+		res = append(res, model.ProfilePrompt{
+			PromptId: int(p.GetPromptId()),
+			Question: p.GetQuestion(),
+			Answer:   p.GetAnswer(),
+		})
+	}
+	return res
+}
+
+func promptsToProto(prompts []model.ProfilePrompt) []*profiles.ProfilePrompt {
+	var res []*profiles.ProfilePrompt
+	for _, p := range prompts {
+		res = append(res, &profiles.ProfilePrompt{
+			PromptId: int32(p.PromptId),
+			Question: p.Question,
+			Answer:   p.Answer,
+		})
+	}
+	return res
+}
+
+// checkPrompts trims the answers and checks them against the catalog. Blank
+// answers are kept: the repository treats them as removed prompts.
+func (pss *ProfileServiceServer) checkPrompts(prompts []model.ProfilePrompt) ([]model.ProfilePrompt, error) {
+	catalog, err := pss.ProfilesRepo.GetPrompts()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get prompts: %v", err)
+	}
+	active := make(map[int]bool, len(catalog))
+	for _, p := range catalog {
+		active[p.PromptId] = true
+	}
+
+	answered := make(map[int]bool)
+	checked := make([]model.ProfilePrompt, 0, len(prompts))
+	for _, p := range prompts {
+		p.Answer = strings.TrimSpace(p.Answer)
+		if p.Answer != "" {
+			if !active[p.PromptId] {
+				return nil, status.Errorf(codes.InvalidArgument, "%v: unknown prompt %d", model.ErrInvalidPrompts, p.PromptId)
+			}
+			if answered[p.PromptId] {
+				return nil, status.Errorf(codes.InvalidArgument, "%v: prompt %d answered twice", model.ErrInvalidPrompts, p.PromptId)
+			}
+			if utf8.RuneCountInString(p.Answer) > model.MaxPromptAnswerLength {
+				return nil, status.Errorf(codes.InvalidArgument, "%v: answer longer than %d characters", model.ErrInvalidPrompts, model.MaxPromptAnswerLength)
+			}
+			answered[p.PromptId] = true
+		}
+		checked = append(checked, p)
+	}
+	if len(answered) > model.MaxProfilePrompts {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: at most %d prompts", model.ErrInvalidPrompts, model.MaxProfilePrompts)
+	}
+
+	return checked, nil
+}
+
+func (pss *ProfileServiceServer) GetPrompts(ctx context.Context, req *emptypb.Empty) (*profiles.GetPromptsResponse, error) {
+	pss.Logger.Info("GetPrompts")
+
+	catalog, err := pss.ProfilesRepo.GetPrompts()
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("GetPrompts")
+		return nil, status.Errorf(codes.Internal, "failed to get prompts: %v", err)
+	}
+
+	resp := &profiles.GetPromptsResponse{}
+	for _, p := range catalog {
+		resp.Prompts = append(resp.Prompts, &profiles.Prompt{
+			PromptId: int32(p.PromptId),
+			Question: p.Question,
+		})
+	}
+	return resp, nil
+}
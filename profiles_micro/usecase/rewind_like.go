@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (pss *ProfileServiceServer) RewindLike(ctx context.Context, req *profiles.RewindLikeRequest) (*profiles.RewindLikeResponse, error) {
+	pss.Logger.Info("RewindLike", "profile_id", req.GetProfileId())
+
+	dislikedId, err := pss.ProfilesRepo.RewindLike(int(req.GetProfileId()))
+	if err == model.ErrNothingToRewind {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("RewindLike")
+		return nil, status.Errorf(codes.Internal, "failed to rewind like: %v", err)
+	}
+
+	return &profiles.RewindLikeResponse{ProfileId: int32(dislikedId)}, nil
+}
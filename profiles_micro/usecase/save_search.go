@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (pss *ProfileServiceServer) SaveSearch(ctx context.Context, req *profiles.SaveSearchRequest) (*profiles.SavedSearch, error) {
+	pss.Logger.Info("SaveSearch", "profile_id", req.GetProfileId(), "name", req.GetName())
+
+	name := strings.TrimSpace(req.GetName())
+	if name == "" || len([]rune(name)) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "search name must be 1-100 characters")
+	}
+
+	params := searchParamsFromProto(req.GetParams())
+	if _, _, err := repository.CompileSearchFilter(params.Filter, 0); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	search, err := pss.ProfilesRepo.SaveSearch(model.SavedSearch{
+		ProfileID: int(req.GetProfileId()),
+		Name:      name,
+		Params:    params,
+		Alerts:    req.GetAlerts(),
+	})
+	switch err {
+	case nil:
+	case model.ErrTooManySavedSearches:
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("SaveSearch")
+		return nil, status.Errorf(codes.Internal, "failed to save search: %v", err)
+	}
+
+	return savedSearchToProto(search), nil
+}
+
+func savedSearchToProto(search model.SavedSearch) *profiles.SavedSearch {
+	return &profiles.SavedSearch{
+		SearchId:  int32(search.SearchID),
+		Name:      search.Name,
+		Params:    searchParamsToProto(search.Params),
+		Alerts:    search.Alerts,
+		CreatedAt: timestamppb.New(search.CreatedAt),
+	}
+}
@@ -2,9 +2,17 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (pss *ProfileServiceServer) SearchProfile(
@@ -12,6 +20,136 @@ func (pss *ProfileServiceServer) SearchProfile(
 	req *profiles.SearchProfileRequest,
 ) (*profiles.SearchProfileResponse, error) {
 
+	params := searchParamsFromProto(req)
+
+	page, err := searchPage(req.GetSortBy(), req.GetCursor(), int(req.GetPageSize()))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	pageSize := page.Limit
+	page.Limit++
+
+	foundProfiles, err := pss.ProfilesRepo.SearchProfiles(int(req.GetIDUser()), params, page)
+	if errors.Is(err, model.ErrInvalidSearchFilter) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetIDUser(), "error": err}).Error("SearchProfile")
+		return nil, err
+	}
+
+	resp := &profiles.SearchProfileResponse{}
+
+	if len(foundProfiles) > pageSize {
+		foundProfiles = foundProfiles[:pageSize]
+		last := foundProfiles[len(foundProfiles)-1]
+		resp.NextCursor = encodeSearchCursor(page.SortBy, last.SortValue, last.IDUser)
+	}
+
+	for _, p := range foundProfiles {
+		resp.Profiles = append(resp.Profiles, &profiles.FoundProfile{
+			IDUser:   int32(p.IDUser),
+			FirstImg: pss.servePhoto(p.FirstImg, model.PhotoCard),
+			Fullname: p.Fullname,
+			Age:      int32(p.Age),
+			Goal:     int32(p.Goal),
+
+			NameHighlight: p.NameHighlight,
+			Snippet:       p.Snippet,
+			Verified:      p.Verified,
+		})
+	}
+
+	// Total and facets describe the whole result set, so they are only
+	// computed for the first page; later pages leave both unset rather
+	// than reporting zero.
+	if page.AfterValue == nil {
+		facets, err := pss.ProfilesRepo.SearchFacets(int(req.GetIDUser()), params)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetIDUser(), "error": err}).Error("SearchProfile")
+			return nil, err
+		}
+		total := int32(facets.Total)
+		resp.Total = &total
+		resp.Facets = &profiles.SearchFacets{
+			Goals:      facetsToProto(facets.Goals),
+			AgeBuckets: facetsToProto(facets.AgeBuckets),
+			Cities:     facetsToProto(facets.Cities),
+			Interests:  facetsToProto(facets.Interests),
+		}
+	}
+
+	return resp, nil
+}
+
+func searchPage(sortBy string, cursor string, pageSize int) (model.SearchPage, error) {
+	switch sortBy {
+	case model.SearchSortRelevance, model.SearchSortAge, model.SearchSortDistance, model.SearchSortRecent:
+	case "":
+		sortBy = model.SearchSortRelevance
+	default:
+		return model.SearchPage{}, fmt.Errorf("unknown sort key %q", sortBy)
+	}
+
+	if pageSize <= 0 {
+		pageSize = model.SearchLimit
+	}
+	pageSize = min(pageSize, model.MaxSearchPageSize)
+
+	page := model.SearchPage{SortBy: sortBy, Limit: pageSize}
+	if cursor == "" {
+		return page, nil
+	}
+
+	cursorSort, value, id, err := decodeSearchCursor(cursor)
+	if err != nil || cursorSort != sortBy {
+		return model.SearchPage{}, model.ErrInvalidSearchCursor
+	}
+	page.AfterValue = &value
+	page.AfterID = id
+
+	return page, nil
+}
+
+// Cursors are opaque to clients: the sort key they were issued for and the
+// (sort_value, profile_id) of the last row on the page.
+func encodeSearchCursor(sortBy string, value float64, id int) string {
+	raw := sortBy + "|" + strconv.FormatFloat(value, 'g', -1, 64) + "|" + strconv.Itoa(id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeSearchCursor(cursor string) (string, float64, int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 {
+		return "", 0, 0, model.ErrInvalidSearchCursor
+	}
+
+	value, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	id, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return parts[0], value, id, nil
+}
+
+func facetsToProto(counts []model.FacetCount) []*profiles.FacetCount {
+	res := make([]*profiles.FacetCount, 0, len(counts))
+	for _, c := range counts {
+		res = append(res, &profiles.FacetCount{Value: c.Value, Count: int32(c.Count)})
+	}
+	return res
+}
+
+func searchParamsFromProto(req *profiles.SearchProfileRequest) model.SearchProfileRequest {
 	params := model.SearchProfileRequest{
 		IsMale:      req.GetIsMale(),
 		Input:       req.GetInput(),
@@ -23,6 +161,7 @@ func (pss *ProfileServiceServer) SearchProfile(
 		Country:     req.GetCountry(),
 		City:        req.GetCity(),
 		Preferences: make([]model.Preference, 0, len(req.GetParametres())),
+		Filter:      profiles.FilterFromProto(req.GetFilter()),
 	}
 	for _, p := range req.GetParametres() {
 		params.Preferences = append(params.Preferences, model.Preference{
@@ -30,23 +169,27 @@ func (pss *ProfileServiceServer) SearchProfile(
 			Value:       p.GetValue(),
 		})
 	}
+	return params
+}
 
-	foundProfiles, err := pss.ProfilesRepo.SearchProfiles(int(req.GetIDUser()), params)
-	if err != nil {
-		return nil, err
+func searchParamsToProto(params model.SearchProfileRequest) *profiles.SearchProfileRequest {
+	req := &profiles.SearchProfileRequest{
+		Input:     params.Input,
+		IsMale:    params.IsMale,
+		AgeMin:    int32(params.AgeMin),
+		AgeMax:    int32(params.AgeMax),
+		HeightMin: int32(params.HeightMin),
+		HeightMax: int32(params.HeightMax),
+		Goal:      int32(params.Goal),
+		Country:   params.Country,
+		City:      params.City,
+		Filter:    profiles.FilterToProto(params.Filter),
 	}
-
-	resp := &profiles.SearchProfileResponse{}
-
-	for _, p := range foundProfiles {
-		resp.Profiles = append(resp.Profiles, &profiles.FoundProfile{
-			IDUser:   int32(p.IDUser),
-			FirstImg: p.FirstImg,
-			Fullname: p.Fullname,
-			Age:      int32(p.Age),
-			Goal:     int32(p.Goal),
+	for _, p := range params.Preferences {
+		req.Parametres = append(req.Parametres, &profiles.Preference{
+			Description: p.Description,
+			Value:       p.Value,
 		})
 	}
-
-	return resp, nil
+	return req
 }
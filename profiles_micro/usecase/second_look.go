@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (pss *ProfileServiceServer) GetSecondLook(ctx context.Context, req *profiles.GetSecondLookRequest) (*profiles.SecondLook, error) {
+	pss.Logger.Info("GetSecondLook", "profile_id", req.GetProfileId())
+
+	settings, err := pss.ProfilesRepo.GetSecondLook(int(req.GetProfileId()))
+	if err == model.ErrProfileNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("GetSecondLook")
+		return nil, status.Errorf(codes.Internal, "failed to get second look: %v", err)
+	}
+
+	return &profiles.SecondLook{
+		Enabled:  settings.Enabled,
+		Recycled: int32(settings.Recycled),
+	}, nil
+}
+
+// SetSecondLook lets a user opt out of, or back into, seeing the profiles
+// they disliked again once the dislike expires.
+func (pss *ProfileServiceServer) SetSecondLook(ctx context.Context, req *profiles.SetSecondLookRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("SetSecondLook", "profile_id", req.GetProfileId(), "enabled", req.GetEnabled())
+
+	err := pss.ProfilesRepo.SetSecondLook(int(req.GetProfileId()), req.GetEnabled())
+	if err == model.ErrProfileNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"profile_id": req.GetProfileId(), "error": err}).Error("SetSecondLook")
+		return nil, status.Errorf(codes.Internal, "failed to set second look: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
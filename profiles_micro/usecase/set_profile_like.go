@@ -2,11 +2,69 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+func likeCommentToProto(c model.LikeComment) *profiles.LikeComment {
+	return &profiles.LikeComment{
+		ProfileId: int32(c.ProfileId),
+		Text:      c.Text,
+		Photo:     c.Photo,
+		PromptId:  int32(c.PromptId),
+	}
+}
+
+// checkLikeComment trims the comment of a like and checks that it points at
+// content the liked profile actually shows. Only likes and superlikes can
+// carry a comment.
+func (pss *ProfileServiceServer) checkLikeComment(req *profiles.SetProfileLikeRequest) (model.LikeComment, error) {
+	c := req.GetComment()
+	comment := model.LikeComment{
+		ProfileId: int(req.GetFrom()),
+		Text:      strings.TrimSpace(c.GetText()),
+		Photo:     c.GetPhoto(),
+		PromptId:  int(c.GetPromptId()),
+	}
+
+	if comment.Text == "" {
+		if comment.Photo != "" || comment.PromptId != 0 {
+			return comment, status.Errorf(codes.InvalidArgument, "%v: a target needs a comment", model.ErrInvalidLikeComment)
+		}
+		return comment, nil
+	}
+	if req.GetStatus() == 2 {
+		return comment, status.Errorf(codes.InvalidArgument, "%v: only likes can carry a comment", model.ErrInvalidLikeComment)
+	}
+	if utf8.RuneCountInString(comment.Text) > model.MaxLikeCommentLength {
+		return comment, status.Errorf(codes.InvalidArgument, "%v: comment longer than %d characters", model.ErrInvalidLikeComment, model.MaxLikeCommentLength)
+	}
+	if comment.Photo != "" && comment.PromptId != 0 {
+		return comment, status.Errorf(codes.InvalidArgument, "%v: comment on a photo or a prompt, not both", model.ErrInvalidLikeComment)
+	}
+
+	exists, err := pss.ProfilesRepo.LikeCommentTargetExists(int(req.GetTo()), comment.Photo, comment.PromptId)
+	if err != nil {
+		return comment, status.Errorf(codes.Internal, "failed to check comment target: %v", err)
+	}
+	if !exists {
+		return comment, status.Errorf(codes.InvalidArgument, "%v: no such photo or prompt on the profile", model.ErrInvalidLikeComment)
+	}
+
+	return comment, nil
+}
+
+// SetProfileLike stores a like, superlike or dislike. A match is announced
+// through the outbox, whose events carry the comments both sides sent with
+// their likes to open the chat. A request repeated with the same idempotency
+// key gets the first response back, marked as replayed.
 func (pss *ProfileServiceServer) SetProfileLike(
 	ctx context.Context,
 	req *profiles.SetProfileLikeRequest,
@@ -16,17 +74,69 @@ func (pss *ProfileServiceServer) SetProfileLike(
 		"to":     req.GetTo(),
 		"status": req.GetStatus(),
 	}).Info("SetProfileLike")
-	result, err := pss.ProfilesRepo.SetLike(
+
+	blocked, err := pss.ProfilesRepo.IsBlocked(int(req.GetFrom()), int(req.GetTo()))
+	if err != nil {
+		pss.Logger.Error("SetProfileLike", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to check blocks: %v", err)
+	}
+	if blocked {
+		return nil, status.Error(codes.PermissionDenied, model.ErrProfileBlocked.Error())
+	}
+
+	// InvalidArgument is kept for the comment, so the key gets its own code.
+	if utf8.RuneCountInString(req.GetIdempotencyKey()) > model.MaxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.OutOfRange, "%v: longer than %d characters", model.ErrInvalidIdempotencyKey, model.MaxIdempotencyKeyLength)
+	}
+
+	comment, err := pss.checkLikeComment(req)
+	if err != nil {
+		pss.Logger.Warn("SetProfileLike", "error", err)
+		return nil, err
+	}
+
+	result, replayed, err := pss.ProfilesRepo.SetLike(
 		int(req.GetFrom()),
 		int(req.GetTo()),
 		int(req.GetStatus()),
+		comment,
+		req.GetIdempotencyKey(),
 	)
+	if err == model.ErrIdempotencyKeyReused {
+		pss.Logger.Warn("SetProfileLike", "error", err)
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
 	if err != nil {
 		pss.Logger.Error("SetProfileLike", "error", err)
 	} else {
-		pss.Logger.Info("SetProfileLike", "result", result)
+		pss.Logger.Info("SetProfileLike", "result", result, "replayed", replayed)
+	}
+	resp := &profiles.SetProfileLikeResponse{
+		LikeId:   int32(result),
+		Replayed: replayed,
+	}
+
+	return resp, err
+}
+
+// RunLikeRequestsCleanupJob forgets the idempotency keys of likes once they
+// can no longer be replayed.
+func (pss *ProfileServiceServer) RunLikeRequestsCleanupJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := pss.ProfilesRepo.DeleteStaleLikeRequests(model.LikeRequestTTL)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("RunLikeRequestsCleanupJob")
+		} else {
+			pss.Logger.WithFields(&logrus.Fields{"deleted": deleted}).Info("RunLikeRequestsCleanupJob")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
 	}
-	return &profiles.SetProfileLikeResponse{
-		LikeId: int32(result),
-	}, err
 }
@@ -53,6 +53,17 @@ func (pss *ProfileServiceServer) StoreProfile(
 		LikedBy:     likedBy,
 		Preferences: prefs,
 		Parameters:  params,
+		Prompts:     promptsFromProto(req.Profile.Prompts),
+	}
+
+	var prompts []model.ProfilePrompt
+	if len(sentProfile.Prompts) != 0 {
+		checked, err := pss.checkPrompts(sentProfile.Prompts)
+		if err != nil {
+			pss.Logger.Error("invalid prompts", err)
+			return &profiles.StoreProfileResponse{}, err
+		}
+		prompts = checked
 	}
 
 	if sentProfile.FirstName != "" {
@@ -136,6 +147,7 @@ func (pss *ProfileServiceServer) StoreProfile(
 		Preferences: sentProfile.Preferences,
 		Parameters:  sentProfile.Parameters,
 		LikedBy:     sentProfile.LikedBy,
+		Prompts:     prompts,
 	}
 
 	pss.Logger.Info("Profile data generated")
@@ -159,18 +171,6 @@ func (pss *ProfileServiceServer) StoreProfile(
 		pss.Logger.Error("cannot store profile", err)
 		return &profiles.StoreProfileResponse{}, err
 	}
-
-	err = pss.ProfilesRepo.StorePhotos(profileId, photos)
-	if err != nil {
-		pss.Logger.Error("cannot store photos", err)
-		return &profiles.StoreProfileResponse{}, err
-	}
-
-	err = pss.ProfilesRepo.StoreInterests(profileId, interests)
-	if err != nil {
-		pss.Logger.Error("cannot store interests", err)
-		return &profiles.StoreProfileResponse{}, err
-	}
 	pss.Logger.WithFields(&logrus.Fields{"profileId": profileId}).Info("Profile saved")
 
 	return &profiles.StoreProfileResponse{
@@ -2,10 +2,13 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -58,6 +61,21 @@ func (pss *ProfileServiceServer) UpdateProfile(ctx context.Context, req *profile
 		req.Targ.Parametres = req.Value.Parametres
 	}
 
+	if req.Value.Timezone != "" && !validTimezone(req.Value.Timezone) {
+		pss.Logger.WithFields(&logrus.Fields{"timezone": req.Value.Timezone}).Error("UpdateProfile")
+		return &emptypb.Empty{}, status.Errorf(codes.InvalidArgument, "invalid timezone %q", req.Value.Timezone)
+	}
+
+	var prompts []model.ProfilePrompt
+	if req.SetPrompts {
+		checked, err := pss.checkPrompts(promptsFromProto(req.Value.Prompts))
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("UpdateProfile")
+			return &emptypb.Empty{}, err
+		}
+		prompts = checked
+	}
+
 	likedBy := []int{}
 	for _, like := range req.Value.LikedBy {
 		likedBy = append(likedBy, int(like))
@@ -93,9 +111,21 @@ func (pss *ProfileServiceServer) UpdateProfile(ctx context.Context, req *profile
 		Preferences: prefs,
 		Parameters:  params,
 		LikedBy:     likedBy,
+		Prompts:     prompts,
+		Timezone:    req.Value.Timezone,
 	}
 
 	err := pss.ProfilesRepo.UpdateProfile(int(req.ProfileId), prof)
 	pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("UpdateProfile")
 	return &emptypb.Empty{}, err
 }
+
+// validTimezone reports whether tz names an IANA zone the top picks job
+// can evaluate; "Local" is rejected since it means nothing to postgres.
+func validTimezone(tz string) bool {
+	if tz == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(tz)
+	return err == nil
+}
@@ -4,28 +4,132 @@ import (
 	"context"
 
 	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (pss *ProfileServiceServer) UploadProfileImage(
 	ctx context.Context,
 	req *profiles.UploadProfileImageRequest,
-) (*emptypb.Empty, error) {
+) (*profiles.UploadProfileImageResponse, error) {
 	pss.Logger.Info("UploadProfileImage")
-	filenameWithPath := "/" + req.GetFilename()
-	err := pss.StaticRepo.UploadImage(req.GetFile(), filenameWithPath, req.GetContentType())
 	pss.Logger.WithFields(&logrus.Fields{
 		"user_id":      req.GetUserId(),
 		"filename":     req.GetFilename(),
 		"content_type": req.GetContentType(),
 	})
+
+	path, err := pss.storePhoto(int(req.GetUserId()), req.GetFilename(), req.GetFile())
 	if err != nil {
-		pss.Logger.Error("UploadProfileImage", err)
 		return nil, err
 	}
+
+	return &profiles.UploadProfileImageResponse{Path: path}, nil
+}
+
+// storePhoto processes raw image bytes into size variants, uploads them and
+// registers the photo in static, where it waits for moderation. It returns
+// the stored path.
+func (pss *ProfileServiceServer) storePhoto(userId int, filename string, data []byte) (string, error) {
+	if err := pss.checkPhotoLimit(userId); err != nil {
+		return "", err
+	}
+
+	photo, err := repository.ProcessPhoto(data)
+	switch err {
+	case nil:
+	case model.ErrUnsupportedImage, model.ErrImageTooLarge:
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	case model.ErrImageTooSmall, model.ErrBlankImage:
+		return "", status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		pss.Logger.Error("UploadProfileImage", err)
+		return "", status.Errorf(codes.Internal, "failed to process image: %v", err)
+	}
+
+	duplicate, err := pss.ProfilesRepo.HasSimilarPhoto(userId, photo.Hash, model.DuplicatePhotoDistance)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": userId, "error": err}).Error("UploadProfileImage")
+		return "", status.Errorf(codes.Internal, "failed to check duplicates: %v", err)
+	}
+	if duplicate {
+		return "", status.Error(codes.AlreadyExists, model.ErrDuplicatePhoto.Error())
+	}
+
+	var uploaded []string
+	for name, variant := range photo.Variants {
+		key := "/" + repository.PhotoVariantKey(filename, name)
+		err = pss.StaticRepo.UploadImage(variant, key, "image/webp")
+		if err != nil {
+			pss.Logger.Error("UploadProfileImage", err)
+			pss.deleteVariants(userId, uploaded)
+			return "", err
+		}
+		uploaded = append(uploaded, key)
+	}
 	pss.Logger.Info("UploadProfileImage: image uploaded")
-	err = pss.ProfilesRepo.StorePhoto(int(req.GetUserId()), req.GetFilename())
-	pss.Logger.Info("error", err)
-	return &emptypb.Empty{}, err
+
+	path := repository.PhotoVariantKey(filename, model.PhotoFull)
+	err = pss.ProfilesRepo.StorePhoto(userId, path, photo.Hash)
+	if err != nil {
+		// checkPhotoLimit is only an early exit; the authoritative check
+		// runs under the profile lock in StorePhoto. If that rejects the
+		// photo, or the insert fails, the variants just stored are dropped.
+		pss.deleteVariants(userId, uploaded)
+		if err == model.ErrTooManyPhotos {
+			return "", status.Error(codes.ResourceExhausted, err.Error())
+		}
+		pss.Logger.Error("UploadProfileImage", err)
+		return "", err
+	}
+
+	pss.flagStolenPhoto(userId, path, photo.Hash)
+
+	return path, nil
+}
+
+// deleteVariants removes variant objects of a photo that could not be
+// stored, so a failed upload leaves nothing behind.
+func (pss *ProfileServiceServer) deleteVariants(userId int, keys []string) {
+	for _, key := range keys {
+		if err := pss.StaticRepo.DeleteImage(userId, key); err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"user_id": userId, "key": key, "error": err}).Warn("UploadProfileImage")
+		}
+	}
+}
+
+// flagStolenPhoto raises a complaint for every other profile that already
+// has a near-identical photo. The upload itself goes through either way;
+// the photo still has to pass moderation.
+func (pss *ProfileServiceServer) flagStolenPhoto(userId int, path string, hash uint64) {
+	matches, err := pss.ProfilesRepo.FindPhotoMatches(userId, hash, model.StolenPhotoDistance)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": userId, "error": err}).Error("flagStolenPhoto")
+		return
+	}
+
+	for _, match := range matches {
+		if err := pss.ProfilesRepo.FlagStolenPhoto(userId, path, match); err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"user_id": userId, "match": match.ProfileId, "error": err}).Error("flagStolenPhoto")
+			continue
+		}
+		pss.Logger.WithFields(&logrus.Fields{"user_id": userId, "match": match.ProfileId, "distance": match.Distance}).Warn("flagStolenPhoto")
+	}
+}
+
+// checkPhotoLimit rejects an upload before any processing once the profile
+// already has model.MaxPhotosPerProfile photos.
+func (pss *ProfileServiceServer) checkPhotoLimit(userId int) error {
+	count, err := pss.ProfilesRepo.CountPhotos(userId)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": userId, "error": err}).Error("checkPhotoLimit")
+		return status.Errorf(codes.Internal, "failed to count photos: %v", err)
+	}
+	if count >= model.MaxPhotosPerProfile {
+		return status.Error(codes.ResourceExhausted, model.ErrTooManyPhotos.Error())
+	}
+	return nil
 }
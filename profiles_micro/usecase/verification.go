@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	profiles "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/repository"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// verificationPrefix keeps selfies apart from profile photos. Nothing under
+// it is ever linked from a profile; reviewers get presigned URLs only.
+func verificationPrefix(userId int32) string {
+	return fmt.Sprintf("verification/%d/", userId)
+}
+
+func poseInstruction(pose string) string {
+	for _, p := range model.VerificationPoses {
+		if p.Name == pose {
+			return p.Instruction
+		}
+	}
+	return ""
+}
+
+func verificationToProto(v model.Verification) *profiles.VerificationStatus {
+	resp := &profiles.VerificationStatus{
+		Verified: v.Verified,
+		Status:   v.Status,
+		Pose:     v.Pose,
+		Reason:   v.Reason,
+	}
+	if v.Status == model.VerificationChallenged {
+		resp.Instruction = poseInstruction(v.Pose)
+		resp.ExpiresAt = timestamppb.New(v.ExpiresAt)
+	}
+	return resp
+}
+
+func (pss *ProfileServiceServer) getVerification(userId int32, method string) (model.Verification, error) {
+	v, err := pss.ProfilesRepo.GetVerification(int(userId))
+	if err == model.ErrProfileNotFound {
+		return v, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": userId, "error": err}).Error(method)
+		return v, status.Errorf(codes.Internal, "failed to get verification: %v", err)
+	}
+	return v, nil
+}
+
+func (pss *ProfileServiceServer) GetVerification(ctx context.Context, req *profiles.VerificationRequest) (*profiles.VerificationStatus, error) {
+	pss.Logger.Info("GetVerification", "user_id", req.GetUserId())
+
+	v, err := pss.getVerification(req.GetUserId(), "GetVerification")
+	if err != nil {
+		return nil, err
+	}
+	return verificationToProto(v), nil
+}
+
+// StartVerification issues a random pose challenge with a presigned URL the
+// selfie has to be uploaded to before the challenge expires. Asking again
+// replaces an open challenge with a new pose.
+func (pss *ProfileServiceServer) StartVerification(ctx context.Context, req *profiles.VerificationRequest) (*profiles.VerificationChallenge, error) {
+	pss.Logger.Info("StartVerification", "user_id", req.GetUserId())
+
+	v, err := pss.getVerification(req.GetUserId(), "StartVerification")
+	if err != nil {
+		return nil, err
+	}
+	if v.Verified {
+		return nil, status.Error(codes.AlreadyExists, model.ErrAlreadyVerified.Error())
+	}
+	if v.Status == model.VerificationSubmitted {
+		return nil, status.Error(codes.FailedPrecondition, model.ErrVerificationPending.Error())
+	}
+
+	pose := model.VerificationPoses[rand.IntN(len(model.VerificationPoses))]
+	key := verificationPrefix(req.GetUserId()) + uuid.NewString()
+	expiresAt := time.Now().Add(model.VerificationChallengeTTL)
+
+	url, err := pss.StaticRepo.PresignUpload(key, model.VerificationChallengeTTL)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "error": err}).Error("StartVerification")
+		return nil, status.Errorf(codes.Internal, "failed to presign upload: %v", err)
+	}
+
+	requestId, err := pss.ProfilesRepo.CreateVerification(v.ProfileId, pose.Name, key, expiresAt)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"user_id": req.GetUserId(), "error": err}).Error("StartVerification")
+		return nil, status.Errorf(codes.Internal, "failed to start verification: %v", err)
+	}
+	// The replaced challenge's selfie, if one was uploaded, is never
+	// reviewed now.
+	if v.Status == model.VerificationChallenged && v.SelfieKey != "" {
+		pss.discardSelfie(int(req.GetUserId()), v.SelfieKey)
+	}
+
+	return &profiles.VerificationChallenge{
+		RequestId:   int32(requestId),
+		Pose:        pose.Name,
+		Instruction: pose.Instruction,
+		UploadUrl:   url,
+		ExpiresAt:   timestamppb.New(expiresAt),
+		MaxSize:     model.MaxPhotoUploadSize,
+	}, nil
+}
+
+// SubmitVerification checks that the selfie for the open challenge was
+// uploaded and is an image, then queues it for review. A bad upload is
+// removed so the user can upload again within the same challenge. Without
+// an open challenge the call fails with FailedPrecondition; NotFound means
+// the selfie has not been uploaded yet.
+func (pss *ProfileServiceServer) SubmitVerification(ctx context.Context, req *profiles.VerificationRequest) (*profiles.VerificationStatus, error) {
+	pss.Logger.Info("SubmitVerification", "user_id", req.GetUserId())
+
+	v, err := pss.getVerification(req.GetUserId(), "SubmitVerification")
+	if err != nil {
+		return nil, err
+	}
+	if v.Status != model.VerificationChallenged {
+		return nil, status.Error(codes.FailedPrecondition, model.ErrVerificationNotFound.Error())
+	}
+
+	size, err := pss.StaticRepo.StatImage(v.SelfieKey)
+	if err == model.ErrUploadNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"selfie_key": v.SelfieKey, "error": err}).Error("SubmitVerification")
+		return nil, status.Errorf(codes.Internal, "failed to check upload: %v", err)
+	}
+
+	if size > model.MaxPhotoUploadSize {
+		pss.discardSelfie(int(req.GetUserId()), v.SelfieKey)
+		return nil, status.Error(codes.InvalidArgument, model.ErrUploadTooLarge.Error())
+	}
+
+	files, err := pss.StaticRepo.GetImages([]string{v.SelfieKey})
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"selfie_key": v.SelfieKey, "error": err}).Error("SubmitVerification")
+		return nil, status.Errorf(codes.Internal, "failed to read upload: %v", err)
+	}
+	if _, err := repository.DetectImageType(files[0]); err != nil {
+		pss.discardSelfie(int(req.GetUserId()), v.SelfieKey)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = pss.ProfilesRepo.SubmitVerification(v.RequestId)
+	if err == model.ErrVerificationNotFound {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"request_id": v.RequestId, "error": err}).Error("SubmitVerification")
+		return nil, status.Errorf(codes.Internal, "failed to submit verification: %v", err)
+	}
+
+	v.Status = model.VerificationSubmitted
+	return verificationToProto(v), nil
+}
+
+// GetVerificationQueue lists submitted selfies oldest first, next to the
+// profile photos they have to be compared with.
+func (pss *ProfileServiceServer) GetVerificationQueue(ctx context.Context, req *profiles.GetVerificationQueueRequest) (*profiles.GetVerificationQueueResponse, error) {
+	pss.Logger.Info("GetVerificationQueue", "limit", req.GetLimit())
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > model.VerificationQueueLimit {
+		limit = model.VerificationQueueLimit
+	}
+
+	pending, err := pss.ProfilesRepo.GetVerificationQueue(limit)
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"error": err}).Error("GetVerificationQueue")
+		return nil, status.Errorf(codes.Internal, "failed to get verification queue: %v", err)
+	}
+
+	resp := &profiles.GetVerificationQueueResponse{
+		ExpiresAt: timestamppb.New(time.Now().Add(model.PhotoDownloadURLTTL)),
+	}
+	for _, v := range pending {
+		selfieURL, err := pss.StaticRepo.PresignDownload(v.SelfieKey, model.PhotoDownloadURLTTL)
+		if err != nil {
+			pss.Logger.WithFields(&logrus.Fields{"request_id": v.RequestId, "error": err}).Error("GetVerificationQueue")
+			return nil, status.Errorf(codes.Internal, "failed to presign download: %v", err)
+		}
+
+		item := &profiles.PendingVerification{
+			RequestId:   int32(v.RequestId),
+			ProfileId:   int32(v.ProfileId),
+			FirstName:   v.FirstName,
+			Pose:        v.Pose,
+			Instruction: poseInstruction(v.Pose),
+			SelfieUrl:   selfieURL,
+			SubmittedAt: timestamppb.New(v.SubmittedAt),
+		}
+		for _, photoPath := range v.Photos {
+			urls, err := pss.presignPhoto(photoPath)
+			if err != nil {
+				pss.Logger.WithFields(&logrus.Fields{"request_id": v.RequestId, "error": err}).Error("GetVerificationQueue")
+				return nil, status.Errorf(codes.Internal, "failed to presign download: %v", err)
+			}
+			item.Photos = append(item.Photos, urls)
+		}
+		resp.Requests = append(resp.Requests, item)
+	}
+
+	return resp, nil
+}
+
+// ReviewVerification settles a submitted request. The selfie is only kept
+// until the review, whatever the decision.
+func (pss *ProfileServiceServer) ReviewVerification(ctx context.Context, req *profiles.ReviewVerificationRequest) (*emptypb.Empty, error) {
+	pss.Logger.Info("ReviewVerification", "request_id", req.GetRequestId(), "reviewer_id", req.GetReviewerId(), "approve", req.GetApprove())
+
+	selfieKey, err := pss.ProfilesRepo.ReviewVerification(int(req.GetRequestId()), int(req.GetReviewerId()), req.GetApprove(), req.GetReason())
+	if err == model.ErrVerificationNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"request_id": req.GetRequestId(), "error": err}).Error("ReviewVerification")
+		return nil, status.Errorf(codes.Internal, "failed to review verification: %v", err)
+	}
+
+	pss.discardSelfie(0, selfieKey)
+	return &emptypb.Empty{}, nil
+}
+
+func (pss *ProfileServiceServer) discardSelfie(userId int, key string) {
+	if err := pss.StaticRepo.DeleteImage(userId, key); err != nil {
+		pss.Logger.WithFields(&logrus.Fields{"selfie_key": key, "error": err}).Warn("discardSelfie")
+	}
+}
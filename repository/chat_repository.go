@@ -91,7 +91,8 @@ const (
     c.first_profile_id, 
     c.second_profile_id, 
     c.last_message,
-    c.last_sender 
+    c.last_sender,
+    c.read_only
 FROM chats c
 JOIN users u1 ON u1.profile_id = c.first_profile_id
 JOIN users u2 ON u2.profile_id = c.second_profile_id
@@ -100,7 +101,8 @@ LEFT JOIN blacklist b2 ON b2.user_id = u2.user_id
 WHERE 
     (c.first_profile_id = $1 OR c.second_profile_id = $1)
     AND b1.user_id IS NULL
-    AND b2.user_id IS NULL;
+    AND b2.user_id IS NULL
+    AND NOT profiles_blocked(c.first_profile_id, c.second_profile_id);
 	`
 
 	GetProfileParams = `
@@ -108,10 +110,8 @@ WHERE
 		p.firstname, 
 		p.lastname, 
 		p.description, 
-		s.path AS avatar
+		profile_avatar(p.profile_id) AS avatar
 	FROM profiles p
-	LEFT JOIN "static" s 
-	ON p.profile_id = s.profile_id 
 	WHERE p.profile_id = $1;
 	`
 )
@@ -128,7 +128,7 @@ func (cr *ChatRepo) GetChats(userID int) ([]model.Chat, error) {
 		var chat model.Chat
 		var firstID, secondID int
 		var sender int
-		if err := rows.Scan(&chat.ChatId, &firstID, &secondID, &chat.LastMessage, &sender); err != nil {
+		if err := rows.Scan(&chat.ChatId, &firstID, &secondID, &chat.LastMessage, &sender, &chat.ReadOnly); err != nil {
 			return nil, err
 		}
 
@@ -181,20 +181,40 @@ func (cr *ChatRepo) GetChats(userID int) ([]model.Chat, error) {
 	return chats, nil
 }
 
-const CreateChatQuery = `
+const (
+	ChatBlockedQuery = `
+		SELECT profiles_blocked($1, $2);
+	`
+
+	// A blocked pair gets no chat, checked in the same statement.
+	CreateChatQuery = `
 		INSERT INTO chats (first_profile_id, second_profile_id, last_message, last_sender)
-		VALUES ($1, $2, $3, $4)
+		SELECT $1, $2, $3, $4
+		WHERE NOT profiles_blocked($1, $2)
 		ON CONFLICT (first_profile_id, second_profile_id) DO NOTHING
 		RETURNING chat_id;
 	`
+)
 
 func (cr *ChatRepo) CreateChat(firstProfileID, secondProfileID int) (int, error) {
 	var chatID int
 	if firstProfileID > secondProfileID {
 		firstProfileID, secondProfileID = secondProfileID, firstProfileID
 	}
+
 	err := cr.DB.QueryRowContext(context.Background(),
 		CreateChatQuery, firstProfileID, secondProfileID, "", secondProfileID).Scan(&chatID)
+	if err == sql.ErrNoRows {
+		// Nothing was inserted: tell a block from an existing chat.
+		var blocked bool
+		if err := cr.DB.QueryRowContext(context.Background(), ChatBlockedQuery, firstProfileID, secondProfileID).Scan(&blocked); err != nil {
+			return 0, err
+		}
+		if blocked {
+			return 0, model.ErrProfileBlocked
+		}
+		return 0, model.ErrChatExists
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -224,6 +244,32 @@ func (cr *ChatRepo) DeleteChat(firstID int, secondID int) error {
 	return err
 }
 
+// SetChatReadOnlyQuery keeps the chat between two profiles, if there is one,
+// but stops it from taking new messages. The outbox relay runs it when a
+// match expires, or an unmatch or a block ends it.
+const SetChatReadOnlyQuery = `
+	UPDATE chats SET read_only = TRUE
+	WHERE (first_profile_id = $1 AND second_profile_id = $2)
+	   OR (first_profile_id = $2 AND second_profile_id = $1);
+`
+
+// ReopenChatQuery lets the read-only chat between two profiles take messages
+// again, but only while they have a live match and neither blocks the other.
+// The outbox relay runs it for a match event.
+const ReopenChatQuery = `
+	UPDATE chats c SET read_only = FALSE
+	WHERE ((c.first_profile_id = $1 AND c.second_profile_id = $2)
+	    OR (c.first_profile_id = $2 AND c.second_profile_id = $1))
+	  AND c.read_only
+	  AND NOT profiles_blocked($1, $2)
+	  AND EXISTS (
+	      SELECT 1 FROM matches m
+	      WHERE ((m.profile_id = $1 AND m.matched_profile_id = $2)
+	          OR (m.profile_id = $2 AND m.matched_profile_id = $1))
+	        AND m.state != 'expired'
+	  );
+`
+
 const GetMessagesQuery = `
 	SELECT 
 		message_id,
@@ -365,6 +411,10 @@ func (cr *ChatRepo) DeleteMessage(messageID int, chatID int) error {
 }
 
 const (
+	LockChatQuery = `
+		SELECT read_only, profiles_blocked(first_profile_id, second_profile_id)
+		FROM chats WHERE chat_id = $1 FOR UPDATE;`
+
 	InsertMessageQuery = `
 		INSERT INTO messages (chat_id, user_id, content, status)
 		VALUES ($1, $2, $3, $4)
@@ -376,6 +426,16 @@ const (
 	WHERE chat_id = $3;
 
 	`
+
+	// The first message in a chat keeps the match from expiring.
+	ActivateMatchQuery = `
+		UPDATE matches m
+		SET state = 'active', expires_at = NULL
+		FROM chats c
+		WHERE c.chat_id = $1
+		  AND ((m.profile_id = c.first_profile_id AND m.matched_profile_id = c.second_profile_id)
+		    OR (m.profile_id = c.second_profile_id AND m.matched_profile_id = c.first_profile_id))
+		  AND m.state IN ('created', 'expiring');`
 )
 
 func (cr *ChatRepo) CreateMessage(chatID int, userID int, content string, status int) (int, error) {
@@ -385,6 +445,18 @@ func (cr *ChatRepo) CreateMessage(chatID int, userID int, content string, status
 	}
 	defer tx.Rollback()
 
+	var readOnly, blocked bool
+	err = tx.QueryRowContext(context.Background(), LockChatQuery, chatID).Scan(&readOnly, &blocked)
+	if err != nil {
+		return 0, err
+	}
+	if blocked {
+		return 0, model.ErrProfileBlocked
+	}
+	if readOnly {
+		return 0, model.ErrChatReadOnly
+	}
+
 	var messageID int
 	err = tx.QueryRowContext(context.Background(), InsertMessageQuery, chatID, userID, content, status).Scan(&messageID)
 	if err != nil {
@@ -396,6 +468,11 @@ func (cr *ChatRepo) CreateMessage(chatID int, userID int, content string, status
 		return 0, err
 	}
 
+	_, err = tx.ExecContext(context.Background(), ActivateMatchQuery, chatID)
+	if err != nil {
+		return 0, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
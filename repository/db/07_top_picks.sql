@@ -0,0 +1,18 @@
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Europe/Moscow' CHECK (LENGTH(timezone) <= 64);
+
+CREATE TABLE IF NOT EXISTS top_picks (
+    pick_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    picked_profile_id BIGINT NOT NULL,
+    score INT NOT NULL,
+    pick_date DATE NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (picked_profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT unique_top_pick UNIQUE (profile_id, picked_profile_id, pick_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_top_picks_profile_expires ON top_picks(profile_id, expires_at);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON top_picks TO app_user;
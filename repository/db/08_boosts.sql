@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS boosts (
+    boost_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    source TEXT NOT NULL CHECK (source IN ('premium', 'inventory')),
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ends_at TIMESTAMP NOT NULL,
+    views INT NOT NULL DEFAULT 0,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS boost_inventory (
+    profile_id BIGINT PRIMARY KEY,
+    available INT NOT NULL DEFAULT 0 CHECK (available >= 0),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_boosts_active ON boosts(ends_at, profile_id);
+CREATE INDEX IF NOT EXISTS idx_boosts_profile_started ON boosts(profile_id, started_at DESC);
+
+CREATE TRIGGER set_updated_at_on_boost_inventory
+BEFORE UPDATE ON boost_inventory
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON boosts, boost_inventory TO app_user;
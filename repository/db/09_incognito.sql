@@ -0,0 +1,25 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS incognito BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE OR REPLACE FUNCTION profile_incognito(candidate BIGINT) RETURNS BOOLEAN AS $$
+SELECT EXISTS (
+    SELECT 1
+    FROM subscriptions sbs
+    JOIN users u ON u.user_id = sbs.user_id
+    WHERE u.profile_id = candidate
+      AND sbs.incognito
+      AND sbs.expires_at > NOW()
+);
+$$ LANGUAGE SQL STABLE;
+
+-- profile_hidden_from reports whether candidate must not appear in viewer's
+-- feed, search or recommendations. Incognito profiles are visible only to
+-- people they have already liked.
+CREATE OR REPLACE FUNCTION profile_hidden_from(candidate BIGINT, viewer BIGINT) RETURNS BOOLEAN AS $$
+SELECT profile_incognito(candidate)
+   AND NOT EXISTS (
+       SELECT 1 FROM likes l
+       WHERE l.profile_id = candidate
+         AND l.liked_profile_id = viewer
+         AND l.status IN (1, 3)
+   );
+$$ LANGUAGE SQL STABLE;
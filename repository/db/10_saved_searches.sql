@@ -0,0 +1,28 @@
+INSERT INTO notification_types (type_description) VALUES ('search_alert')
+ON CONFLICT (type_description) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS saved_searches (
+    search_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    name TEXT NOT NULL CHECK (LENGTH(name) <= 100),
+    params JSONB NOT NULL,
+    alerts BOOLEAN NOT NULL DEFAULT FALSE,
+    watermark TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT unique_saved_search_name UNIQUE (profile_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS saved_search_hits (
+    search_id BIGINT NOT NULL,
+    profile_id BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (search_id, profile_id),
+    FOREIGN KEY (search_id) REFERENCES saved_searches(search_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_alerts ON saved_searches(alerts, watermark);
+CREATE INDEX IF NOT EXISTS idx_profiles_updated_at ON profiles(updated_at);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON saved_searches, saved_search_hits TO app_user;
@@ -0,0 +1,34 @@
+-- Weighted document used for ranked profile search: names (both spellings)
+-- rank above the free-form description.
+CREATE OR REPLACE FUNCTION profile_search_document(firstname TEXT, lastname TEXT, fullname_translit TEXT, description TEXT)
+RETURNS tsvector AS $$
+SELECT setweight(to_tsvector('russian', COALESCE(firstname, '') || ' ' || COALESCE(lastname, '')), 'A')
+    || setweight(to_tsvector('simple', COALESCE(fullname_translit, '')), 'A')
+    || setweight(to_tsvector('russian', COALESCE(description, '')), 'B')
+    || setweight(to_tsvector('english', COALESCE(description, '')), 'C');
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- A query typed in Cyrillic also matches transliterated names.
+CREATE OR REPLACE FUNCTION profile_search_query(q TEXT)
+RETURNS tsquery AS $$
+SELECT plainto_tsquery('russian', q)
+    || plainto_tsquery('english', q)
+    || plainto_tsquery('simple', translit(q));
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- ts_headline keeps markup from the source text, so the text is escaped
+-- before <mark> tags are added around matches.
+CREATE OR REPLACE FUNCTION search_headline(doc TEXT, q TEXT, opts TEXT)
+RETURNS TEXT AS $$
+SELECT ts_headline(
+    'russian',
+    replace(replace(replace(COALESCE(doc, ''), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+    profile_search_query(q),
+    'StartSel=<mark>, StopSel=</mark>, ' || opts
+);
+$$ LANGUAGE SQL STABLE;
+
+CREATE INDEX IF NOT EXISTS idx_profiles_search_document ON profiles USING gin (
+    profile_search_document(firstname, lastname, fullname_translit, description)
+);
+CREATE INDEX IF NOT EXISTS idx_profiles_description_trgm ON profiles USING gin (description gin_trgm_ops);
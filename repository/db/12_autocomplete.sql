@@ -0,0 +1,13 @@
+-- Prefix lookups for autocomplete use the lower(...) btree indexes, fuzzy
+-- lookups and interest normalization use the trigram ones.
+CREATE INDEX IF NOT EXISTS idx_locations_city_prefix ON locations (LOWER(city) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_locations_city_trgm ON locations USING gin (LOWER(city) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_interests_description_prefix ON interests (LOWER(description) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_interests_description_trgm ON interests USING gin (LOWER(description) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_preferences_value_prefix ON preferences (preference_description, LOWER(preference_value) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_preferences_value_trgm ON preferences USING gin (LOWER(preference_value) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_parameters_value_prefix ON parameters (parameter_description, LOWER(parameter_value) text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_parameters_value_trgm ON parameters USING gin (LOWER(parameter_value) gin_trgm_ops);
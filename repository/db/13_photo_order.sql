@@ -0,0 +1,25 @@
+ALTER TABLE static ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE static ADD COLUMN IF NOT EXISTS is_primary BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Existing photos keep their upload order; the oldest one becomes primary.
+UPDATE static s
+SET position = o.rn - 1,
+    is_primary = (o.rn = 1)
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY profile_id ORDER BY id) AS rn
+    FROM static
+) o
+WHERE o.id = s.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_static_one_primary ON static(profile_id) WHERE is_primary;
+CREATE INDEX IF NOT EXISTS idx_static_profile_position ON static(profile_id, position);
+
+-- Every avatar shown anywhere comes from here.
+CREATE OR REPLACE FUNCTION profile_avatar(pid BIGINT)
+RETURNS TEXT AS $$
+SELECT s.path
+FROM static s
+WHERE s.profile_id = pid
+ORDER BY s.is_primary DESC, s.position, s.id
+LIMIT 1;
+$$ LANGUAGE SQL STABLE;
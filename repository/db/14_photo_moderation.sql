@@ -0,0 +1,22 @@
+-- Photos already on profiles were never reviewed but stay visible; only new
+-- uploads wait in the moderation queue.
+ALTER TABLE static ADD COLUMN IF NOT EXISTS moderation_status TEXT NOT NULL DEFAULT 'approved'
+    CHECK (moderation_status IN ('pending', 'approved', 'rejected'));
+ALTER TABLE static ALTER COLUMN moderation_status SET DEFAULT 'pending';
+
+ALTER TABLE static ADD COLUMN IF NOT EXISTS moderation_reason TEXT CHECK (LENGTH(moderation_reason) <= 255);
+ALTER TABLE static ADD COLUMN IF NOT EXISTS moderated_by BIGINT;
+ALTER TABLE static ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMPTZ;
+ALTER TABLE static ADD COLUMN IF NOT EXISTS phash BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_static_pending ON static(created_at, id) WHERE moderation_status = 'pending';
+
+-- Other users only ever see approved photos.
+CREATE OR REPLACE FUNCTION profile_avatar(pid BIGINT)
+RETURNS TEXT AS $$
+SELECT s.path
+FROM static s
+WHERE s.profile_id = pid AND s.moderation_status = 'approved'
+ORDER BY s.is_primary DESC, s.position, s.id
+LIMIT 1;
+$$ LANGUAGE SQL STABLE;
@@ -0,0 +1,23 @@
+-- Splits a 64-bit photo hash into its eight bytes, tagged with their
+-- position. Two hashes within 7 differing bits always share at least one
+-- byte, so the GIN index below narrows Hamming-distance lookups down to a
+-- few candidates before bit_count compares them exactly.
+CREATE OR REPLACE FUNCTION phash_bands(hash BIGINT)
+RETURNS INT[] AS $$
+SELECT CASE WHEN hash IS NULL THEN NULL ELSE ARRAY(
+    SELECT band * 256 + ((hash >> (band * 8)) & 255)::int
+    FROM generate_series(0, 7) AS band
+) END;
+$$ LANGUAGE SQL IMMUTABLE;
+
+ALTER TABLE static ADD COLUMN IF NOT EXISTS phash_bands INT[]
+    GENERATED ALWAYS AS (phash_bands(phash)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_static_phash_bands ON static USING GIN (phash_bands);
+CREATE INDEX IF NOT EXISTS idx_static_unhashed ON static(id) WHERE phash IS NULL;
+
+INSERT INTO complaint_types (type_description)
+SELECT 'Чужие фотографии'
+WHERE NOT EXISTS (
+    SELECT 1 FROM complaint_types WHERE type_description = 'Чужие фотографии'
+);
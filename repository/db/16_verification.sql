@@ -0,0 +1,27 @@
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS is_verified BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS verified_at TIMESTAMPTZ;
+
+-- A request starts as a pose challenge, becomes submitted once the selfie
+-- is uploaded and is then settled by a reviewer. Unanswered challenges
+-- expire.
+CREATE TABLE IF NOT EXISTS verification_requests (
+    request_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    pose TEXT NOT NULL CHECK (LENGTH(pose) <= 64),
+    selfie_key TEXT NOT NULL CHECK (LENGTH(selfie_key) <= 255),
+    status TEXT NOT NULL DEFAULT 'challenged'
+        CHECK (status IN ('challenged', 'submitted', 'approved', 'rejected')),
+    reason TEXT CHECK (LENGTH(reason) <= 255),
+    reviewed_by BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    submitted_at TIMESTAMPTZ,
+    reviewed_at TIMESTAMPTZ,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_verification_profile ON verification_requests(profile_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_verification_submitted ON verification_requests(submitted_at, request_id)
+    WHERE status = 'submitted';
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON verification_requests TO app_user;
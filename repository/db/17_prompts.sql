@@ -0,0 +1,75 @@
+-- Prompts are a fixed catalog of questions; a profile answers a few of them
+-- and shows the answers as cards in the given order.
+CREATE TABLE IF NOT EXISTS prompts (
+    prompt_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    question TEXT NOT NULL UNIQUE CHECK (LENGTH(question) <= 255),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE IF NOT EXISTS profile_prompts (
+    profile_id BIGINT NOT NULL,
+    prompt_id BIGINT NOT NULL,
+    answer TEXT NOT NULL CHECK (LENGTH(answer) BETWEEN 1 AND 300),
+    position SMALLINT NOT NULL CHECK (position BETWEEN 0 AND 2),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (profile_id, prompt_id),
+    UNIQUE (profile_id, position),
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (prompt_id) REFERENCES prompts(prompt_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+INSERT INTO prompts (question) VALUES
+    ('My ideal weekend…'),
+    ('The way to win me over is…'),
+    ('I geek out on…'),
+    ('A shower thought I recently had…'),
+    ('My most irrational fear…'),
+    ('Two truths and a lie…'),
+    ('We''ll get along if…'),
+    ('The best trip I''ve ever taken…'),
+    ('My simple pleasures…'),
+    ('I''m looking for…')
+ON CONFLICT (question) DO NOTHING;
+
+-- Answers are copied onto the profile so they can be part of the same
+-- expression index as the rest of the searchable text.
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS prompt_answers TEXT NOT NULL DEFAULT '';
+
+CREATE OR REPLACE FUNCTION sync_prompt_answers() RETURNS TRIGGER AS $$
+DECLARE
+    target BIGINT := COALESCE(NEW.profile_id, OLD.profile_id);
+BEGIN
+    UPDATE profiles p
+    SET prompt_answers = COALESCE((
+        SELECT string_agg(pp.answer, ' ' ORDER BY pp.position)
+        FROM profile_prompts pp
+        WHERE pp.profile_id = target
+    ), '')
+    WHERE p.profile_id = target;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_sync_prompt_answers ON profile_prompts;
+CREATE TRIGGER trg_sync_prompt_answers
+AFTER INSERT OR UPDATE OR DELETE ON profile_prompts
+FOR EACH ROW EXECUTE FUNCTION sync_prompt_answers();
+
+-- Prompt answers weigh the same as the description.
+DROP INDEX IF EXISTS idx_profiles_search_document;
+DROP FUNCTION IF EXISTS profile_search_document(TEXT, TEXT, TEXT, TEXT);
+
+CREATE OR REPLACE FUNCTION profile_search_document(firstname TEXT, lastname TEXT, fullname_translit TEXT, description TEXT, prompt_answers TEXT)
+RETURNS tsvector AS $$
+SELECT setweight(to_tsvector('russian', COALESCE(firstname, '') || ' ' || COALESCE(lastname, '')), 'A')
+    || setweight(to_tsvector('simple', COALESCE(fullname_translit, '')), 'A')
+    || setweight(to_tsvector('russian', COALESCE(description, '') || ' ' || COALESCE(prompt_answers, '')), 'B')
+    || setweight(to_tsvector('english', COALESCE(description, '') || ' ' || COALESCE(prompt_answers, '')), 'C');
+$$ LANGUAGE SQL IMMUTABLE;
+
+CREATE INDEX IF NOT EXISTS idx_profiles_search_document ON profiles USING gin (
+    profile_search_document(firstname, lastname, fullname_translit, description, prompt_answers)
+);
+
+GRANT SELECT ON prompts TO app_user;
+GRANT SELECT, INSERT, UPDATE, DELETE ON profile_prompts TO app_user;
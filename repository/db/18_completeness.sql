@@ -0,0 +1,33 @@
+-- Checklist score out of 100, the same one profiles_micro reports to the
+-- user (model.CompletenessChecklist). Keep the weights and thresholds in
+-- sync with it.
+CREATE OR REPLACE FUNCTION profile_completeness(p_id BIGINT)
+RETURNS INT AS $$
+SELECT
+      (CASE WHEN c.photos >= 1 THEN 25 ELSE 0 END)
+    + (CASE WHEN c.photos >= 3 THEN 10 ELSE 0 END)
+    + (CASE WHEN c.description_length >= 50 THEN 15 ELSE 0 END)
+    + (CASE WHEN c.interests >= 3 THEN 15 ELSE 0 END)
+    + (CASE WHEN c.has_preferences THEN 10 ELSE 0 END)
+    + (CASE WHEN c.has_parameters THEN 10 ELSE 0 END)
+    + (CASE WHEN c.has_prompts THEN 15 ELSE 0 END)
+FROM (
+    SELECT
+        (SELECT COUNT(*) FROM static s
+         WHERE s.profile_id = p.profile_id AND s.moderation_status = 'approved') AS photos,
+        CHAR_LENGTH(BTRIM(COALESCE(p.description, ''), E' \t\r\n')) AS description_length,
+        (SELECT COUNT(*) FROM profile_interests pi WHERE pi.profile_id = p.profile_id) AS interests,
+        EXISTS (SELECT 1 FROM profile_preferences pp WHERE pp.profile_id = p.profile_id) AS has_preferences,
+        EXISTS (SELECT 1 FROM profile_parameter ppar WHERE ppar.profile_id = p.profile_id) AS has_parameters,
+        EXISTS (SELECT 1 FROM profile_prompts ppr WHERE ppr.profile_id = p.profile_id) AS has_prompts
+    FROM profiles p
+    WHERE p.profile_id = p_id
+) c;
+$$ LANGUAGE SQL STABLE;
+
+-- Ranking multiplies scores by this factor, so an empty profile keeps half
+-- of its score and a complete one all of it.
+CREATE OR REPLACE FUNCTION completeness_factor(p_id BIGINT)
+RETURNS FLOAT8 AS $$
+SELECT 0.5 + COALESCE(profile_completeness(p_id), 0) / 200.0;
+$$ LANGUAGE SQL STABLE;
@@ -0,0 +1,17 @@
+-- Every change to what a profile shows is kept as a full snapshot plus the
+-- list of fields that changed since the previous version. actor_id is the
+-- user who made the change; it is empty for changes made on sign up.
+CREATE TABLE IF NOT EXISTS profile_versions (
+    version_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    actor_id BIGINT,
+    action TEXT NOT NULL CHECK (action IN ('create', 'update', 'photos', 'moderation', 'revert')),
+    snapshot JSONB NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_versions_profile ON profile_versions(profile_id, version_id DESC);
+
+GRANT SELECT, INSERT ON profile_versions TO app_user;
@@ -0,0 +1,33 @@
+-- users.status is the account state: 1 is active, 2 is paused. A paused
+-- account keeps its matches and chats but is left out of every feed, search
+-- and recommendation until it is resumed or paused_until passes.
+UPDATE users SET status = 1 WHERE status NOT IN (1, 2);
+
+ALTER TABLE users ALTER COLUMN status SET DEFAULT 1;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (status IN (1, 2));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS paused_until TIMESTAMPTZ;
+
+CREATE OR REPLACE FUNCTION profile_paused(candidate BIGINT) RETURNS BOOLEAN AS $$
+SELECT EXISTS (
+    SELECT 1
+    FROM users u
+    WHERE u.profile_id = candidate
+      AND u.status = 2
+      AND (u.paused_until IS NULL OR u.paused_until > NOW())
+);
+$$ LANGUAGE SQL STABLE;
+
+-- profile_hidden_from reports whether candidate must not appear in viewer's
+-- feed, search or recommendations. Paused profiles are hidden from everyone;
+-- incognito profiles are visible only to people they have already liked.
+CREATE OR REPLACE FUNCTION profile_hidden_from(candidate BIGINT, viewer BIGINT) RETURNS BOOLEAN AS $$
+SELECT profile_paused(candidate)
+    OR (profile_incognito(candidate)
+        AND NOT EXISTS (
+            SELECT 1 FROM likes l
+            WHERE l.profile_id = candidate
+              AND l.liked_profile_id = viewer
+              AND l.status IN (1, 3)
+        ));
+$$ LANGUAGE SQL STABLE;
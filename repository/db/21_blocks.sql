@@ -0,0 +1,56 @@
+-- user_blocks is a block between two users, unlike blacklist which bans a
+-- user from the service. A block hides both sides from each other in feeds,
+-- search, recommendations, likes and chats, whoever made it.
+CREATE TABLE IF NOT EXISTS user_blocks (
+    blocker_id BIGINT NOT NULL,
+    blocked_id BIGINT NOT NULL,
+    reason TEXT CHECK (LENGTH(reason) <= 255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id != blocked_id),
+    FOREIGN KEY (blocker_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (blocked_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked ON user_blocks(blocked_id);
+
+-- unmatches keeps why a match was ended, for moderation and analytics.
+CREATE TABLE IF NOT EXISTS unmatches (
+    unmatch_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    unmatched_profile_id BIGINT NOT NULL,
+    reason TEXT CHECK (LENGTH(reason) <= 255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (unmatched_profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- A chat whose match was ended stays readable but takes no new messages.
+ALTER TABLE chats ADD COLUMN IF NOT EXISTS read_only BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE OR REPLACE FUNCTION profiles_blocked(a BIGINT, b BIGINT) RETURNS BOOLEAN AS $$
+SELECT EXISTS (
+    SELECT 1 FROM user_blocks ub
+    WHERE (ub.blocker_id = a AND ub.blocked_id = b)
+       OR (ub.blocker_id = b AND ub.blocked_id = a)
+);
+$$ LANGUAGE SQL STABLE;
+
+-- profile_hidden_from reports whether candidate must not appear in viewer's
+-- feed, search or recommendations. Paused profiles are hidden from everyone
+-- and blocked ones from the other side of the block; incognito profiles are
+-- visible only to people they have already liked.
+CREATE OR REPLACE FUNCTION profile_hidden_from(candidate BIGINT, viewer BIGINT) RETURNS BOOLEAN AS $$
+SELECT profile_paused(candidate)
+    OR profiles_blocked(candidate, viewer)
+    OR (profile_incognito(candidate)
+        AND NOT EXISTS (
+            SELECT 1 FROM likes l
+            WHERE l.profile_id = candidate
+              AND l.liked_profile_id = viewer
+              AND l.status IN (1, 3)
+        ));
+$$ LANGUAGE SQL STABLE;
+
+GRANT SELECT, INSERT, DELETE ON user_blocks TO app_user;
+GRANT SELECT, INSERT ON unmatches TO app_user;
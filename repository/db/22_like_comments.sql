@@ -0,0 +1,11 @@
+-- A like may carry a short comment, optionally pointing at one of the liked
+-- profile's photos (by path) or answered prompts. The comment belongs to the
+-- like: replacing the like, e.g. with a dislike, replaces it too.
+ALTER TABLE likes ADD COLUMN IF NOT EXISTS comment TEXT CHECK (LENGTH(comment) BETWEEN 1 AND 150);
+ALTER TABLE likes ADD COLUMN IF NOT EXISTS comment_photo TEXT;
+ALTER TABLE likes ADD COLUMN IF NOT EXISTS comment_prompt_id BIGINT REFERENCES prompts(prompt_id) ON DELETE SET NULL;
+
+ALTER TABLE likes ADD CONSTRAINT likes_comment_target CHECK (
+    (comment_photo IS NULL OR comment_prompt_id IS NULL)
+    AND (comment IS NOT NULL OR (comment_photo IS NULL AND comment_prompt_id IS NULL))
+);
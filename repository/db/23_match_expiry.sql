@@ -0,0 +1,39 @@
+-- A match starts out 'created' with an expiry date. The first message in
+-- its chat makes it 'active' for good. Otherwise it turns 'expiring' in the
+-- last hours before that date and 'expired' once the date has passed.
+-- Expired matches are kept as an archive; only unmatching deletes a match.
+--
+--   created --first message--> active
+--   created --close to expiry--> expiring --first message--> active
+--   created/expiring --expiry date passed--> expired
+--   created/expiring --extended by a premium user, once--> created
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS state TEXT NOT NULL DEFAULT 'created'
+    CHECK (state IN ('created', 'active', 'expiring', 'expired'));
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS extended BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS reminders_sent SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE matches ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+
+-- Matches that already have messages are active. The others get a full
+-- expiry period from now rather than expiring on deploy.
+UPDATE matches m
+SET state = 'active'
+WHERE EXISTS (
+    SELECT 1
+    FROM chats c
+    JOIN messages msg ON msg.chat_id = c.chat_id
+    WHERE (c.first_profile_id = m.profile_id AND c.second_profile_id = m.matched_profile_id)
+       OR (c.first_profile_id = m.matched_profile_id AND c.second_profile_id = m.profile_id)
+);
+
+UPDATE matches SET expires_at = NOW() + INTERVAL '72 hours' WHERE state = 'created';
+
+ALTER TABLE matches ADD CONSTRAINT matches_expiry CHECK (
+    (state NOT IN ('created', 'expiring') OR expires_at IS NOT NULL)
+    AND (state != 'expired' OR archived_at IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_matches_pending ON matches(expires_at) WHERE state IN ('created', 'expiring');
+
+INSERT INTO notification_types (type_description) VALUES ('match_reminder'), ('match_expired')
+ON CONFLICT (type_description) DO NOTHING;
@@ -0,0 +1,34 @@
+-- like_requests remembers the result of a like sent with an idempotency
+-- key, so a retried request gets the same answer instead of liking twice.
+-- A key can be reused for another like once it is older than a day.
+CREATE TABLE IF NOT EXISTS like_requests (
+    profile_id BIGINT NOT NULL,
+    idempotency_key TEXT NOT NULL CHECK (LENGTH(idempotency_key) BETWEEN 1 AND 64),
+    liked_profile_id BIGINT NOT NULL,
+    status INT NOT NULL,
+    like_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (profile_id, idempotency_key),
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (liked_profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_like_requests_created ON like_requests(created_at);
+
+-- outbox_events is written in the same transaction as the change it is
+-- about. The gateway relays unsent events into notifications and marks them
+-- sent, so each event is delivered once even if a request fails half-way.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    event_id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    profile_id BIGINT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ,
+    FOREIGN KEY (profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unsent ON outbox_events(event_id) WHERE sent_at IS NULL;
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON like_requests TO app_user;
+GRANT SELECT, INSERT, UPDATE, DELETE ON outbox_events TO app_user;
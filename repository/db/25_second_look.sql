@@ -0,0 +1,17 @@
+-- A dislike expires. Once it has, the disliked profile may come back in
+-- the deck for a second look, after every fresh profile, on every deck page
+-- until it is swiped again: disliking it again starts a new expiry.
+-- recycled_at marks the like made on that swipe.
+ALTER TABLE likes ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+ALTER TABLE likes ADD COLUMN IF NOT EXISTS recycled_at TIMESTAMPTZ;
+
+UPDATE likes SET expires_at = created_at + INTERVAL '30 days' WHERE status = 2 AND expires_at IS NULL;
+
+-- The deck looks up the expired dislikes of the viewer, oldest first.
+CREATE INDEX IF NOT EXISTS idx_likes_second_look ON likes(profile_id, expires_at)
+    WHERE status = 2;
+
+-- second_look lets a user opt out of seeing disliked profiles again.
+-- recycled_count is how many disliked profiles they have been shown again.
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS second_look BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS recycled_count INT NOT NULL DEFAULT 0;
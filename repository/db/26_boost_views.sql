@@ -0,0 +1,15 @@
+-- A boost is shown to each viewer once. boost_views remembers who has
+-- already seen it, so later deck pages skip it and boosts.views counts
+-- distinct viewers rather than deck loads.
+CREATE TABLE IF NOT EXISTS boost_views (
+    boost_id BIGINT NOT NULL,
+    viewer_profile_id BIGINT NOT NULL,
+    viewed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (boost_id, viewer_profile_id),
+    FOREIGN KEY (boost_id) REFERENCES boosts(boost_id) ON DELETE CASCADE,
+    FOREIGN KEY (viewer_profile_id) REFERENCES profiles(profile_id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_boost_views_viewer ON boost_views(viewer_profile_id, boost_id);
+
+GRANT SELECT, INSERT, UPDATE, DELETE ON boost_views TO app_user;
@@ -0,0 +1,16 @@
+-- Search alerts compare profiles.updated_at with the saved search
+-- watermark. Both are stored as TIMESTAMPTZ and written with NOW(), so the
+-- comparison no longer depends on the session time zone.
+ALTER TABLE profiles
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN updated_at SET DEFAULT NOW();
+
+ALTER TABLE saved_searches
+    ALTER COLUMN watermark TYPE TIMESTAMPTZ,
+    ALTER COLUMN watermark SET DEFAULT NOW(),
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN created_at SET DEFAULT NOW();
+
+ALTER TABLE saved_search_hits
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN created_at SET DEFAULT NOW();
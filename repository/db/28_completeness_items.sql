@@ -0,0 +1,35 @@
+-- The completeness checklist is defined here only. profiles_micro reads the
+-- items of a profile from profile_completeness_items() to report its score
+-- and what it misses, and ranking sums the same items.
+CREATE OR REPLACE FUNCTION profile_completeness_items(p_id BIGINT)
+RETURNS TABLE (position INT, item TEXT, weight INT, done BOOLEAN) AS $$
+SELECT v.position, v.item, v.weight, v.done
+FROM (
+    SELECT
+        (SELECT COUNT(*) FROM static s
+         WHERE s.profile_id = p.profile_id AND s.moderation_status = 'approved') AS photos,
+        CHAR_LENGTH(BTRIM(COALESCE(p.description, ''), E' \t\r\n')) AS description_length,
+        (SELECT COUNT(*) FROM profile_interests pi WHERE pi.profile_id = p.profile_id) AS interests,
+        EXISTS (SELECT 1 FROM profile_preferences pp WHERE pp.profile_id = p.profile_id) AS has_preferences,
+        EXISTS (SELECT 1 FROM profile_parameter ppar WHERE ppar.profile_id = p.profile_id) AS has_parameters,
+        EXISTS (SELECT 1 FROM profile_prompts ppr WHERE ppr.profile_id = p.profile_id) AS has_prompts
+    FROM profiles p
+    WHERE p.profile_id = p_id
+) c
+CROSS JOIN LATERAL (VALUES
+    (1, 'photo', 25, c.photos >= 1),
+    (2, 'more_photos', 10, c.photos >= 3),
+    (3, 'description', 15, c.description_length >= 50),
+    (4, 'interests', 15, c.interests >= 3),
+    (5, 'preferences', 10, c.has_preferences),
+    (6, 'parameters', 10, c.has_parameters),
+    (7, 'prompts', 15, c.has_prompts)
+) AS v(position, item, weight, done);
+$$ LANGUAGE SQL STABLE;
+
+-- Checklist score out of 100.
+CREATE OR REPLACE FUNCTION profile_completeness(p_id BIGINT)
+RETURNS INT AS $$
+SELECT COALESCE(SUM(weight) FILTER (WHERE done), 0)::INT
+FROM profile_completeness_items(p_id);
+$$ LANGUAGE SQL STABLE;
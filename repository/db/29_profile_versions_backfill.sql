@@ -0,0 +1,75 @@
+-- Profiles created before profile_versions existed have no history, so
+-- their first change was diffed against an empty profile. Give each of
+-- them a 'create' version holding the profile as it is now. The snapshot
+-- has the shape of model.ProfileSnapshot: lists are sorted the way
+-- SnapshotOf sorts them and photos are the approved ones in display order.
+INSERT INTO profile_versions (profile_id, actor_id, action, snapshot, changes)
+SELECT
+    p.profile_id,
+    NULL,
+    'create',
+    jsonb_build_object(
+        'firstName', p.firstname,
+        'lastName', p.lastname,
+        'isMale', p.is_male,
+        'goal', COALESCE(p.goal, 0),
+        'height', COALESCE(p.height, 0),
+        'birthday', COALESCE(to_char(p.birthday, 'YYYY-MM-DD"T"00:00:00"Z"'), '0001-01-01T00:00:00Z'),
+        'description', COALESCE(p.description, ''),
+        'location', COALESCE(l.country || '@' || l.city || '@' || l.district, ''),
+        'interests', COALESCE((
+            SELECT jsonb_agg(d.description ORDER BY d.description COLLATE "C")
+            FROM (
+                SELECT DISTINCT i.description
+                FROM profile_interests pi
+                JOIN interests i ON i.interest_id = pi.interest_id
+                WHERE pi.profile_id = p.profile_id
+            ) d
+        ), '[]'),
+        'preferences', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                       'preference_description', d.preference_description,
+                       'preference_value', d.preference_value
+                   ) ORDER BY d.preference_description COLLATE "C", d.preference_value COLLATE "C")
+            FROM (
+                SELECT DISTINCT pr.preference_description, pr.preference_value
+                FROM profile_preferences pp
+                JOIN preferences pr ON pr.preference_id = pp.preference_id
+                WHERE pp.profile_id = p.profile_id
+            ) d
+        ), '[]'),
+        'parameters', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                       'preference_description', d.parameter_description,
+                       'preference_value', d.parameter_value
+                   ) ORDER BY d.parameter_description COLLATE "C", d.parameter_value COLLATE "C")
+            FROM (
+                SELECT DISTINCT param.parameter_description, param.parameter_value
+                FROM profile_parameter ppar
+                JOIN parameters param ON param.parameter_id = ppar.parameter_id
+                WHERE ppar.profile_id = p.profile_id
+            ) d
+        ), '[]'),
+        'photos', COALESCE((
+            SELECT jsonb_agg(s.path ORDER BY s.is_primary DESC NULLS LAST, s.position, s.id)
+            FROM static s
+            WHERE s.profile_id = p.profile_id AND s.moderation_status = 'approved'
+        ), '[]'),
+        'prompts', COALESCE((
+            SELECT jsonb_agg(jsonb_build_object(
+                       'promptId', ppr.prompt_id,
+                       'question', q.question,
+                       'answer', ppr.answer
+                   ) ORDER BY ppr.position)
+            FROM profile_prompts ppr
+            JOIN prompts q ON q.prompt_id = ppr.prompt_id
+            WHERE ppr.profile_id = p.profile_id
+        ), '[]')
+    ),
+    '[]'
+FROM profiles p
+LEFT JOIN locations l ON l.location_id = p.location_id
+WHERE NOT EXISTS (
+    SELECT 1 FROM profile_versions pv WHERE pv.profile_id = p.profile_id
+)
+ORDER BY p.profile_id;
@@ -0,0 +1,11 @@
+-- A disliked profile is recycled when the user swipes it again on a second
+-- look, not when it is served, so one scrolled past keeps coming back.
+-- recycled_at now marks the like made on that swipe and no longer keeps a
+-- dislike out of the deck.
+DROP INDEX IF EXISTS idx_likes_second_look;
+CREATE INDEX IF NOT EXISTS idx_likes_second_look ON likes(profile_id, expires_at)
+    WHERE status = 2;
+
+-- Dislikes marked when they were served have not been swiped yet.
+-- recycled_count keeps what it counted before.
+UPDATE likes SET recycled_at = NULL WHERE status = 2 AND recycled_at IS NOT NULL;
@@ -0,0 +1,57 @@
+-- Ranking reads a stored completeness score instead of running the
+-- checklist for every candidate. Triggers keep it current on writes to the
+-- profile and to everything the checklist looks at.
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS completeness INT NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE FUNCTION sync_profile_completeness() RETURNS TRIGGER AS $$
+DECLARE
+    target BIGINT := COALESCE(NEW.profile_id, OLD.profile_id);
+BEGIN
+    UPDATE profiles p
+    SET completeness = profile_completeness(target)
+    WHERE p.profile_id = target;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Only the description counts from the profile row itself. The update the
+-- trigger makes does not touch it, so it does not fire again.
+DROP TRIGGER IF EXISTS trg_completeness_profiles ON profiles;
+CREATE TRIGGER trg_completeness_profiles
+AFTER INSERT OR UPDATE OF description ON profiles
+FOR EACH ROW EXECUTE FUNCTION sync_profile_completeness();
+
+DROP TRIGGER IF EXISTS trg_completeness_static ON static;
+CREATE TRIGGER trg_completeness_static
+AFTER INSERT OR UPDATE OR DELETE ON static
+FOR EACH ROW EXECUTE FUNCTION sync_profile_completeness();
+
+DROP TRIGGER IF EXISTS trg_completeness_interests ON profile_interests;
+CREATE TRIGGER trg_completeness_interests
+AFTER INSERT OR UPDATE OR DELETE ON profile_interests
+FOR EACH ROW EXECUTE FUNCTION sync_profile_completeness();
+
+DROP TRIGGER IF EXISTS trg_completeness_preferences ON profile_preferences;
+CREATE TRIGGER trg_completeness_preferences
+AFTER INSERT OR UPDATE OR DELETE ON profile_preferences
+FOR EACH ROW EXECUTE FUNCTION sync_profile_completeness();
+
+DROP TRIGGER IF EXISTS trg_completeness_parameters ON profile_parameter;
+CREATE TRIGGER trg_completeness_parameters
+AFTER INSERT OR UPDATE OR DELETE ON profile_parameter
+FOR EACH ROW EXECUTE FUNCTION sync_profile_completeness();
+
+DROP TRIGGER IF EXISTS trg_completeness_prompts ON profile_prompts;
+CREATE TRIGGER trg_completeness_prompts
+AFTER INSERT OR UPDATE OR DELETE ON profile_prompts
+FOR EACH ROW EXECUTE FUNCTION sync_profile_completeness();
+
+UPDATE profiles SET completeness = profile_completeness(profile_id);
+
+CREATE INDEX IF NOT EXISTS idx_profiles_completeness ON profiles(completeness DESC, profile_id);
+
+-- Search and top picks weigh their scores by the stored score as well.
+CREATE OR REPLACE FUNCTION completeness_factor(p_id BIGINT)
+RETURNS FLOAT8 AS $$
+SELECT 0.5 + COALESCE((SELECT completeness FROM profiles WHERE profile_id = p_id), 0) / 200.0;
+$$ LANGUAGE SQL STABLE;
@@ -20,5 +20,18 @@ TRUNCATE TABLE
     notifications,
     profile_ratings,
     queries,
-    user_answer
+    user_answer,
+    top_picks,
+    boosts,
+    boost_inventory,
+    saved_searches,
+    saved_search_hits,
+    verification_requests,
+    profile_prompts,
+    profile_versions,
+    user_blocks,
+    unmatches,
+    like_requests,
+    outbox_events,
+    boost_views
 RESTART IDENTITY CASCADE;
@@ -20,6 +20,7 @@ type NotificationsRepository interface {
 
 	GetCurrentNotifications(userID int) ([]model.NotificationSend, error)
 	AddNotification(userID int, notif model.NotificationSend) error
+	RelayOutbox(limit int, retention time.Duration, render func(model.OutboxEvent) model.OutboxEffect) (int, error)
 }
 
 type RedisClient interface {
@@ -232,6 +233,12 @@ func (nr *NotificationsRepo) AddNotification(userID int, notif model.Notificatio
 
 	notif.NotificationID = notifID
 
+	return nr.pushNotification(userID, notif)
+}
+
+// pushNotification puts a stored notification in the user's cache and
+// tells their open connections about it.
+func (nr *NotificationsRepo) pushNotification(userID int, notif model.NotificationSend) error {
 	redisKey := fmt.Sprintf("CACHE:user:%dnotifications", userID)
 	jsonNotif, err := json.Marshal(notif)
 	if err != nil {
@@ -253,3 +260,149 @@ func (nr *NotificationsRepo) AddNotification(userID int, notif model.Notificatio
 
 	return nil
 }
+
+const (
+	ClaimOutboxQuery = `
+SELECT event_id, event_type, profile_id, payload::text
+FROM outbox_events
+WHERE sent_at IS NULL
+ORDER BY event_id
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+`
+
+	MarkOutboxSentQuery = `
+UPDATE outbox_events SET sent_at = NOW() WHERE event_id = $1;
+`
+
+	DeleteSentOutboxQuery = `
+DELETE FROM outbox_events
+WHERE sent_at < NOW() - $1 * INTERVAL '1 second';
+`
+)
+
+// seedChat opens the chat of a new match with the comments in c.Seed. A
+// chat the pair already has, or cannot have while one blocks the other, is
+// left alone, so the two match events of a pair seed it once.
+func seedChat(ctx context.Context, tx *sql.Tx, c model.ChatChange) error {
+	first, second := c.FirstId, c.SecondId
+	if first > second {
+		first, second = second, first
+	}
+
+	var chatID int
+	err := tx.QueryRowContext(ctx, CreateChatQuery, first, second, "", second).Scan(&chatID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, m := range c.Seed {
+		if _, err := tx.ExecContext(ctx, InsertMessageQuery, chatID, m.ProfileId, m.Text, 1); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, UpdateChatLastMessageQuery, m.Text, m.ProfileId, chatID); err != nil {
+			return err
+		}
+	}
+	_, err = tx.ExecContext(ctx, ActivateMatchQuery, chatID)
+	return err
+}
+
+// RelayOutbox applies up to limit unsent outbox events: it stores their
+// notifications and opens, seeds or closes the chats they name. Claiming the
+// events, applying them and marking them sent happen in one transaction, so
+// an event takes effect exactly once even with several gateways relaying,
+// and is retried until it does. Events render does nothing for are marked
+// sent. Sent events older than retention are dropped. It returns the number
+// of notifications stored.
+func (nr *NotificationsRepo) RelayOutbox(
+	limit int,
+	retention time.Duration,
+	render func(model.OutboxEvent) model.OutboxEffect,
+) (int, error) {
+	ctx := context.Background()
+
+	tx, err := nr.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, ClaimOutboxQuery, limit)
+	if err != nil {
+		return 0, err
+	}
+	var events []model.OutboxEvent
+	for rows.Next() {
+		var (
+			e       model.OutboxEvent
+			payload string
+		)
+		if err := rows.Scan(&e.EventId, &e.EventType, &e.ProfileId, &payload); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		e.Payload = []byte(payload)
+		events = append(events, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	type delivery struct {
+		userID int
+		notif  model.NotificationSend
+	}
+	var delivered []delivery
+	for _, e := range events {
+		effect := render(e)
+		if c := effect.Chat; c != nil {
+			query := ReopenChatQuery
+			if c.ReadOnly {
+				query = SetChatReadOnlyQuery
+			}
+			if _, err := tx.ExecContext(ctx, query, c.FirstId, c.SecondId); err != nil {
+				return 0, err
+			}
+			if !c.ReadOnly && len(c.Seed) > 0 {
+				if err := seedChat(ctx, tx, *c); err != nil {
+					return 0, err
+				}
+			}
+		}
+		if effect.Notification != nil {
+			notif := *effect.Notification
+			err := tx.QueryRowContext(ctx, AddNotificationQuery, e.ProfileId, notif.NotifType, notif.Content).Scan(&notif.NotificationID)
+			if err != nil {
+				return 0, err
+			}
+			delivered = append(delivered, delivery{userID: e.ProfileId, notif: notif})
+		}
+		if _, err := tx.ExecContext(ctx, MarkOutboxSentQuery, e.EventId); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, DeleteSentOutboxQuery, int64(retention/time.Second)); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	// The notifications are stored; the cache only speeds up reading them,
+	// so a failed push is reported after trying the rest.
+	var pushErr error
+	for _, d := range delivered {
+		if err := nr.pushNotification(d.userID, d.notif); err != nil && pushErr == nil {
+			pushErr = err
+		}
+	}
+
+	return len(delivered), pushErr
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type QuotaRepository interface {
+	Increment(key string, expiresAt time.Time) (int, error)
+	Decrement(key string) error
+	GetUsage(key string) (int, time.Duration, error)
+}
+
+type QuotaRedisClient interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	TTL(ctx context.Context, key string) *redis.DurationCmd
+}
+
+// incrementScript bumps a counter and sets its expiry in one step, so a
+// counter can never be left behind without a TTL.
+const incrementScript = `
+local used = redis.call('INCR', KEYS[1])
+if used == 1 then
+    redis.call('EXPIREAT', KEYS[1], ARGV[1])
+end
+return used
+`
+
+// decrementScript only gives a unit back to a live, positive counter. A
+// missing key would otherwise come back as -1 and never expire.
+const decrementScript = `
+local used = tonumber(redis.call('GET', KEYS[1]) or '0')
+if used > 0 then
+    return redis.call('DECR', KEYS[1])
+end
+return 0
+`
+
+type QuotaRepo struct {
+	Client QuotaRedisClient
+	Ctx    context.Context
+}
+
+func NewQuotaRepo(client QuotaRedisClient) (*QuotaRepo, error) {
+	if client == nil {
+		return nil, errors.New("quota repo requires a redis client")
+	}
+	return &QuotaRepo{
+		Client: client,
+		Ctx:    context.Background(),
+	}, nil
+}
+
+// Increment bumps the usage counter and returns the new value. The expiry
+// is only set when the counter is created, so rolling windows keep the
+// deadline of their first use.
+func (qr *QuotaRepo) Increment(key string, expiresAt time.Time) (int, error) {
+	used, err := qr.Client.Eval(qr.Ctx, incrementScript, []string{key}, expiresAt.Unix()).Int()
+	if err != nil {
+		return 0, err
+	}
+	return used, nil
+}
+
+func (qr *QuotaRepo) Decrement(key string) error {
+	return qr.Client.Eval(qr.Ctx, decrementScript, []string{key}).Err()
+}
+
+// GetUsage returns the current counter and its remaining lifetime. A
+// missing key means nothing has been used in the current window.
+func (qr *QuotaRepo) GetUsage(key string) (int, time.Duration, error) {
+	used, err := qr.Client.Get(qr.Ctx, key).Int()
+	if err == redis.Nil {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ttl, err := qr.Client.TTL(qr.Ctx, key).Result()
+	if err != nil {
+		return 0, 0, err
+	}
+	return used, ttl, nil
+}
@@ -13,6 +13,8 @@ import (
 type SubsriptionRepository interface {
 	CreateSub(userID int, subType int, data string) error
 	UpdateBorder(userID int, new_border int) error
+	GrantBoosts(userID int, amount int) error
+	SetIncognito(userID int, enabled bool) error
 }
 
 type SubRepo struct {
@@ -86,3 +88,32 @@ func (sr *SubRepo) UpdateBorder(userID int, new_border int) error {
 	}
 	return nil
 }
+
+const GrantBoostsQuery = `
+INSERT INTO boost_inventory (profile_id, available)
+VALUES ($1, $2)
+ON CONFLICT (profile_id) DO UPDATE
+SET available = boost_inventory.available + EXCLUDED.available;
+`
+
+func (sr *SubRepo) GrantBoosts(userID int, amount int) error {
+	_, err := sr.DB.ExecContext(context.Background(), GrantBoostsQuery, userID, amount)
+	if err != nil {
+		return fmt.Errorf("failed to grant boosts: %w", err)
+	}
+	return nil
+}
+
+const SetIncognitoQuery = `
+UPDATE subscriptions
+SET incognito = $1
+WHERE user_id = $2;
+`
+
+func (sr *SubRepo) SetIncognito(userID int, enabled bool) error {
+	_, err := sr.DB.ExecContext(context.Background(), SetIncognitoQuery, enabled, userID)
+	if err != nil {
+		return fmt.Errorf("failed to set incognito: %w", err)
+	}
+	return nil
+}
@@ -48,3 +48,41 @@ func TestSubRepo_UpdateBorder(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestSubRepo_GrantBoosts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sr := &repository.SubRepo{
+		DB:  db,
+		Ctx: context.Background(),
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(repository.GrantBoostsQuery)).
+		WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = sr.GrantBoosts(1, 3)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubRepo_SetIncognito(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	sr := &repository.SubRepo{
+		DB:  db,
+		Ctx: context.Background(),
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(repository.SetIncognitoQuery)).
+		WithArgs(true, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = sr.SetIncognito(1, true)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
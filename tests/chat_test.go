@@ -3,10 +3,13 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
 	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 )
@@ -76,7 +79,7 @@ func TestChatRepo_CreateChat(t *testing.T) {
 	secondProfileID := 2
 	expectedChatID := 3
 
-	mock.ExpectQuery("INSERT INTO chats").
+	mock.ExpectQuery(`INSERT INTO chats .* WHERE NOT profiles_blocked`).
 		WithArgs(firstProfileID, secondProfileID, "", secondProfileID).
 		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}).AddRow(expectedChatID))
 
@@ -87,6 +90,114 @@ func TestChatRepo_CreateChat(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestChatRepo_CreateChatBlocked(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectQuery("INSERT INTO chats").
+		WithArgs(1, 2, "", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}))
+	mock.ExpectQuery(`SELECT profiles_blocked\(\$1, \$2\)`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"profiles_blocked"}).AddRow(true))
+
+	_, err := repo.CreateChat(1, 2)
+	assert.ErrorIs(t, err, model.ErrProfileBlocked)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChatRepo_CreateChatExists(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+
+	mock.ExpectQuery("INSERT INTO chats").
+		WithArgs(1, 2, "", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}))
+	mock.ExpectQuery(`SELECT profiles_blocked\(\$1, \$2\)`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"profiles_blocked"}).AddRow(false))
+
+	_, err := repo.CreateChat(2, 1)
+	assert.ErrorIs(t, err, model.ErrChatExists)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func expectCreateMessageLock(mock sqlmock.Sqlmock, chatID int, readOnly bool, blocked bool) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT read_only, profiles_blocked").
+		WithArgs(chatID).
+		WillReturnRows(sqlmock.NewRows([]string{"read_only", "profiles_blocked"}).AddRow(readOnly, blocked))
+}
+
+// The events the profiles service queues for a block and for the match that
+// follows a re-like go through the outbox relay, which closes and reopens
+// the chat; sending follows the chat and the block.
+func TestChatRepo_BlockUnblockRelikeSendMessage(t *testing.T) {
+	repo, mock, cleanup := newTestChatRepo(t)
+	defer cleanup()
+	notifRepo := &repository.NotificationsRepo{DB: repo.DB, Client: repo.Client, Ctx: context.Background()}
+
+	relay := func(events *sqlmock.Rows, expect func()) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT event_id, event_type, profile_id, payload::text`).WillReturnRows(events)
+		expect()
+		mock.ExpectExec(`DELETE FROM outbox_events`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		_, err := notifRepo.RelayOutbox(10, time.Hour, usecase.RenderOutboxEvent)
+		assert.NoError(t, err)
+	}
+	outbox := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"event_id", "event_type", "profile_id", "payload"})
+	}
+
+	// Block: the chat is closed, and the block alone already stops sending.
+	relay(outbox().AddRow(1, "chat_closed", 1, `{"matchedId": 2}`), func() {
+		mock.ExpectExec("UPDATE chats SET read_only = TRUE").WithArgs(1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE outbox_events SET sent_at`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	})
+	expectCreateMessageLock(mock, 7, true, true)
+	mock.ExpectRollback()
+	_, err := repo.CreateMessage(7, 1, "still there?", 2)
+	assert.ErrorIs(t, err, model.ErrProfileBlocked)
+
+	// Unblock: no match, so the chat stays closed.
+	expectCreateMessageLock(mock, 7, true, false)
+	mock.ExpectRollback()
+	_, err = repo.CreateMessage(7, 1, "still there?", 2)
+	assert.ErrorIs(t, err, model.ErrChatReadOnly)
+
+	// Re-like: the new match reopens the chat and messages go through.
+	relay(outbox().AddRow(2, "match", 2, `{"matchedId": 1}`), func() {
+		mock.ExpectExec("UPDATE chats c SET read_only = FALSE").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("INSERT INTO notifications").
+			WithArgs(2, "match", "You have matched with user 1!").
+			WillReturnRows(sqlmock.NewRows([]string{"notification_id"}).AddRow(5))
+		mock.ExpectExec(`UPDATE outbox_events SET sent_at`).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	})
+	expectCreateMessageLock(mock, 7, false, false)
+	mock.ExpectQuery("INSERT INTO messages").
+		WithArgs(7, 1, "hi again", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"message_id"}).AddRow(11))
+	mock.ExpectExec("UPDATE chats").
+		WithArgs("hi again", 1, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE matches m").
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"first_profile_id", "second_profile_id"}).AddRow(1, 2))
+
+	messageID, err := repo.CreateMessage(7, 1, "hi again", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, messageID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestChatRepo_UpdateMessageStatus(t *testing.T) {
 	repo, mock, cleanup := newTestChatRepo(t)
 	defer cleanup()
@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationsRepo_RelayOutbox(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer redisServer.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+	defer redisClient.Close()
+
+	repo := &repository.NotificationsRepo{
+		DB:     db,
+		Client: redisClient,
+		Ctx:    context.Background(),
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT event_id, event_type, profile_id, payload::text`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"event_id", "event_type", "profile_id", "payload"}).
+			AddRow(1, "match", 7, `{"matchedId": 8}`).
+			AddRow(2, "unknown", 8, `{}`).
+			AddRow(3, "match_expired", 9, `{"matchedId": 7}`))
+	mock.ExpectExec(`UPDATE chats c SET read_only = FALSE`).WithArgs(7, 8).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO notifications`).
+		WithArgs(7, "match", "You have matched with user 8!").
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id"}).AddRow(42))
+	mock.ExpectExec(`UPDATE outbox_events SET sent_at`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE outbox_events SET sent_at`).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE chats SET read_only = TRUE`).WithArgs(9, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO notifications`).
+		WithArgs(9, "match_expired", "Your match with user 7 has expired").
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id"}).AddRow(43))
+	mock.ExpectExec(`UPDATE outbox_events SET sent_at`).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM outbox_events`).WithArgs(int64(3600)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	sent, err := repo.RelayOutbox(10, time.Hour, usecase.RenderOutboxEvent)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	cached, err := redisServer.List("CACHE:user:7notifications")
+	assert.NoError(t, err)
+	assert.Len(t, cached, 1)
+	assert.Contains(t, cached[0], `"notificationID":42`)
+	assert.False(t, redisServer.Exists("CACHE:user:8notifications"))
+}
+
+func TestNotificationsRepo_RelayOutboxSeedsChat(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.NotificationsRepo{DB: db, Ctx: context.Background()}
+
+	payload := `"comments": [{"profileId": 8, "text": "Nice hike!"}, {"profileId": 7, "text": "Thanks :)"}]`
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT event_id, event_type, profile_id, payload::text`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"event_id", "event_type", "profile_id", "payload"}).
+			AddRow(1, "match", 8, `{"matchedId": 7, `+payload+`}`).
+			AddRow(2, "match", 7, `{"matchedId": 8, `+payload+`}`))
+	mock.ExpectExec(`UPDATE chats c SET read_only = FALSE`).WithArgs(8, 7).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO chats`).WithArgs(7, 8, "", 8).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}).AddRow(5))
+	mock.ExpectExec(`INSERT INTO messages`).WithArgs(5, 8, "Nice hike!", 1).WillReturnResult(sqlmock.NewResult(11, 1))
+	mock.ExpectExec(`UPDATE chats\s+SET last_message`).WithArgs("Nice hike!", 8, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO messages`).WithArgs(5, 7, "Thanks :)", 1).WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.ExpectExec(`UPDATE chats\s+SET last_message`).WithArgs("Thanks :)", 7, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE matches m`).WithArgs(5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO notifications`).
+		WithArgs(8, "match", "You have matched with user 7!").
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id"}).AddRow(42))
+	mock.ExpectExec(`UPDATE outbox_events SET sent_at`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE chats c SET read_only = FALSE`).WithArgs(7, 8).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO chats`).WithArgs(7, 8, "", 8).
+		WillReturnRows(sqlmock.NewRows([]string{"chat_id"}))
+	mock.ExpectQuery(`INSERT INTO notifications`).
+		WithArgs(7, "match", "You have matched with user 8!").
+		WillReturnRows(sqlmock.NewRows([]string{"notification_id"}).AddRow(43))
+	mock.ExpectExec(`UPDATE outbox_events SET sent_at`).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM outbox_events`).WithArgs(int64(3600)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	redisServer, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer redisServer.Close()
+	repo.Client = redis.NewClient(&redis.Options{Addr: redisServer.Addr()})
+
+	sent, err := repo.RelayOutbox(10, time.Hour, usecase.RenderOutboxEvent)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationsRepo_RelayOutboxRollsBack(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	repo := &repository.NotificationsRepo{DB: db, Ctx: context.Background()}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT event_id, event_type, profile_id, payload::text`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"event_id", "event_type", "profile_id", "payload"}).
+			AddRow(1, "match", 7, `{"matchedId": 8}`))
+	mock.ExpectExec(`UPDATE chats c SET read_only = FALSE`).WithArgs(7, 8).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO notifications`).
+		WithArgs(7, "match", "You have matched with user 8!").
+		WillReturnError(assert.AnError)
+	mock.ExpectRollback()
+
+	sent, err := repo.RelayOutbox(10, time.Hour, usecase.RenderOutboxEvent)
+	assert.Error(t, err)
+	assert.Equal(t, 0, sent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRenderOutboxEvent(t *testing.T) {
+	effect := usecase.RenderOutboxEvent(model.OutboxEvent{EventType: "match", ProfileId: 2, Payload: []byte(`{"matchedId": 3}`)})
+	if assert.NotNil(t, effect.Notification) && assert.NotNil(t, effect.Chat) {
+		assert.Equal(t, "match", effect.Notification.NotifType)
+		assert.Equal(t, "You have matched with user 3!", effect.Notification.Content)
+		assert.Equal(t, model.ChatChange{FirstId: 2, SecondId: 3, ReadOnly: false}, *effect.Chat)
+	}
+
+	effect = usecase.RenderOutboxEvent(model.OutboxEvent{EventType: "match", ProfileId: 2, Payload: []byte(`{"matchedId": 3, "comments": [{"profileId": 3, "text": "Hi!"}]}`)})
+	if assert.NotNil(t, effect.Chat) {
+		assert.Equal(t, []model.MatchComment{{ProfileId: 3, Text: "Hi!"}}, effect.Chat.Seed)
+	}
+
+	effect = usecase.RenderOutboxEvent(model.OutboxEvent{EventType: "match_expired", ProfileId: 3, Payload: []byte(`{"matchedId": 2}`)})
+	if assert.NotNil(t, effect.Notification) && assert.NotNil(t, effect.Chat) {
+		assert.Equal(t, "match_expired", effect.Notification.NotifType)
+		assert.Equal(t, "Your match with user 2 has expired", effect.Notification.Content)
+		assert.Equal(t, model.ChatChange{FirstId: 3, SecondId: 2, ReadOnly: true}, *effect.Chat)
+	}
+
+	effect = usecase.RenderOutboxEvent(model.OutboxEvent{EventType: "chat_closed", ProfileId: 3, Payload: []byte(`{"matchedId": 2}`)})
+	assert.Nil(t, effect.Notification)
+	if assert.NotNil(t, effect.Chat) {
+		assert.Equal(t, model.ChatChange{FirstId: 3, SecondId: 2, ReadOnly: true}, *effect.Chat)
+	}
+
+	expiresAt := time.Now().Add(3*time.Hour - time.Minute).UTC().Format(time.RFC3339)
+	effect = usecase.RenderOutboxEvent(model.OutboxEvent{EventType: "match_reminder", ProfileId: 2, Payload: []byte(`{"matchedId": 3, "expiresAt": "` + expiresAt + `"}`)})
+	assert.Nil(t, effect.Chat)
+	if assert.NotNil(t, effect.Notification) {
+		assert.Equal(t, "match_reminder", effect.Notification.NotifType)
+		assert.Equal(t, "Your match with user 3 expires in 3 h. Say hi!", effect.Notification.Content)
+	}
+
+	assert.Equal(t, model.OutboxEffect{}, usecase.RenderOutboxEvent(model.OutboxEvent{EventType: "match", Payload: []byte(`{}`)}))
+	assert.Equal(t, model.OutboxEffect{}, usecase.RenderOutboxEvent(model.OutboxEvent{EventType: "unknown", Payload: []byte(`{"matchedId": 2}`)}))
+}
@@ -49,6 +49,9 @@ func TestGetProfileById(t *testing.T) {
 							sql.NullString{String: "Значение", Valid: true},   // parameter_value
 							sql.NullBool{Bool: true, Valid: true},             // premium_status
 							sql.NullInt64{Int64: 1, Valid: true},              // border
+							true,                                              // is_verified
+							"Europe/Moscow",                                   // timezone
+							"[]",                                              // prompts
 						},
 					},
 				}
@@ -80,6 +83,7 @@ func TestGetProfileById(t *testing.T) {
 					Status: true,
 					Border: 1,
 				},
+				Verified: true,
 			},
 			expectedErr: false,
 		},
@@ -118,6 +122,7 @@ func TestGetProfileById(t *testing.T) {
 				assert.ElementsMatch(t, tt.expected.Photos, profile.Photos)
 				assert.ElementsMatch(t, tt.expected.Interests, profile.Interests)
 				assert.ElementsMatch(t, tt.expected.Preferences, profile.Preferences)
+				assert.Equal(t, tt.expected.Verified, profile.Verified)
 			}
 			mockDB.AssertExpectations(t)
 		})
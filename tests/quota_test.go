@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/usecase"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+)
+
+func newQuotaRepo(t *testing.T) (*repository.QuotaRepo, *miniredis.Miniredis) {
+	mr, err := miniredis.Run()
+	assert.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	qr, err := repository.NewQuotaRepo(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	assert.NoError(t, err)
+	return qr, mr
+}
+
+func TestQuotaRepo_IncrementKeepsFirstExpiry(t *testing.T) {
+	qr, mr := newQuotaRepo(t)
+
+	used, err := qr.Increment("quota:feeds:1", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, used)
+
+	used, err = qr.Increment("quota:feeds:1", time.Now().Add(10*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, used)
+	assert.LessOrEqual(t, mr.TTL("quota:feeds:1"), time.Hour)
+
+	used, ttl, err := qr.GetUsage("quota:feeds:1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, used)
+	assert.Greater(t, ttl, time.Duration(0))
+
+	used, _, err = qr.GetUsage("quota:feeds:2")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, used)
+}
+
+func TestQuota_Consume(t *testing.T) {
+	qr, _ := newQuotaRepo(t)
+	log, err := logger.NewLogrusLogger(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+
+	quota, err := usecase.NewQuotaUseCase(qr, log)
+	assert.NoError(t, err)
+
+	limit := model.QuotaPlans[model.PlanFree][model.QuotaFeeds].Limit
+	for i := 1; i <= limit; i++ {
+		status, err := quota.Consume(1, model.PlanFree, model.QuotaFeeds)
+		assert.NoError(t, err)
+		assert.Equal(t, limit-i, status.Remaining)
+	}
+
+	status, err := quota.Consume(1, model.PlanFree, model.QuotaFeeds)
+	assert.Equal(t, model.ErrQuotaExceeded, err)
+	assert.Equal(t, 0, status.Remaining)
+
+	_, err = quota.Consume(1, model.PlanFree, model.QuotaSuperlikes)
+	assert.Equal(t, model.ErrQuotaExceeded, err)
+
+	status, err = quota.Consume(1, model.PlanPremium, model.QuotaSwipes)
+	assert.NoError(t, err)
+	assert.Equal(t, model.QuotaUnlimited, status.Remaining)
+
+	resp, err := quota.GetQuotas(1, model.PlanFree)
+	assert.NoError(t, err)
+	assert.Equal(t, model.PlanFree, resp.Plan)
+	assert.Len(t, resp.Quotas, len(model.QuotaKinds))
+	assert.Equal(t, limit, resp.Quotas[0].Used)
+
+	unverified := model.QuotaPlans[model.PlanUnverified][model.QuotaSwipes].Limit
+	for i := 0; i < unverified; i++ {
+		_, err = quota.Consume(2, model.PlanUnverified, model.QuotaSwipes)
+		assert.NoError(t, err)
+	}
+	_, err = quota.Consume(2, model.PlanUnverified, model.QuotaSwipes)
+	assert.Equal(t, model.ErrQuotaExceeded, err)
+
+	// Passing verification lifts the limit without resetting the count.
+	status, err = quota.Consume(2, model.PlanFree, model.QuotaSwipes)
+	assert.NoError(t, err)
+	assert.Equal(t, unverified+1, status.Used)
+}
+
+func TestQuotaPlan(t *testing.T) {
+	assert.Equal(t, model.PlanPremium, usecase.QuotaPlan(true, false))
+	assert.Equal(t, model.PlanFree, usecase.QuotaPlan(false, true))
+	assert.Equal(t, model.PlanFree, usecase.QuotaPlan(false, false))
+
+	model.UnverifiedPlanEnabled = true
+	defer func() { model.UnverifiedPlanEnabled = false }()
+	assert.Equal(t, model.PlanUnverified, usecase.QuotaPlan(false, false))
+	assert.Equal(t, model.PlanPremium, usecase.QuotaPlan(true, false))
+}
+
+func TestQuotaRepo_DecrementMissingKey(t *testing.T) {
+	qr, mr := newQuotaRepo(t)
+
+	assert.NoError(t, qr.Decrement("quota:swipes:1"))
+	assert.False(t, mr.Exists("quota:swipes:1"))
+
+	_, err := qr.Increment("quota:swipes:1", time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.NoError(t, qr.Decrement("quota:swipes:1"))
+	assert.NoError(t, qr.Decrement("quota:swipes:1"))
+
+	used, _, err := qr.GetUsage("quota:swipes:1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, used)
+	assert.Greater(t, mr.TTL("quota:swipes:1"), time.Duration(0))
+}
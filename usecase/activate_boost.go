@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ActivateBoost struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewActivateBoostUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*ActivateBoost, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrActivateBoostUC
+	}
+	return &ActivateBoost{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// ActivateBoost starts a boost for profileId. With useAllowance it comes
+// from the plan's boost quota, which the caller has charged; otherwise a
+// purchased boost is spent.
+func (ab *ActivateBoost) ActivateBoost(profileId int, useAllowance bool) (model.ActivateBoostResponse, error) {
+	ab.logger.Info("ActivateBoostUseCase")
+	req := &profilespb.ActivateBoostRequest{
+		ProfileId:    int32(profileId),
+		UseAllowance: useAllowance,
+	}
+	resp, err := ab.ProfilesService.ActivateBoost(context.Background(), req)
+	if err != nil {
+		ab.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("ActivateBoostUseCase")
+
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return model.ActivateBoostResponse{}, model.ErrBoostAlreadyActive
+		case codes.ResourceExhausted:
+			return model.ActivateBoostResponse{}, model.ErrNoBoostsLeft
+		}
+		return model.ActivateBoostResponse{}, err
+	}
+
+	return model.ActivateBoostResponse{
+		Boost:     boostFromProto(resp.Boost),
+		Remaining: int(resp.Remaining),
+	}, nil
+}
+
+func boostFromProto(b *profilespb.Boost) model.Boost {
+	if b == nil {
+		return model.Boost{}
+	}
+	return model.Boost{
+		BoostID:   int(b.BoostId),
+		Source:    b.Source,
+		StartedAt: b.StartedAt.AsTime(),
+		EndsAt:    b.EndsAt.AsTime(),
+		Views:     int(b.Views),
+		Likes:     int(b.Likes),
+		Matches:   int(b.Matches),
+	}
+}
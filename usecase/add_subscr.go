@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
 
 	"github.com/sirupsen/logrus"
@@ -20,6 +21,9 @@ func NewAddSubscriptionUseCase(subRepo repository.SubsriptionRepository, logger
 func (uc *AddSubscription) CreateSub(userID int, sub_type int, data string) error {
 	uc.logger.Info("CreateSub", "userId", userID, "sub_type", sub_type)
 	err := uc.subRepo.CreateSub(userID, sub_type, data)
+	if err == nil {
+		err = uc.subRepo.GrantBoosts(userID, model.BoostsPerSubscription)
+	}
 	if err != nil {
 		uc.logger.Error("CreateSub", "userId", userID, "error", err)
 	} else {
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Autocomplete struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewAutocompleteUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*Autocomplete, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrAutocompleteUC
+	}
+	return &Autocomplete{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (ac *Autocomplete) Autocomplete(dictionary, query, description string, limit int) (model.AutocompleteResponse, error) {
+	ac.logger.Info("AutocompleteUseCase")
+
+	resp, err := ac.ProfilesService.Autocomplete(context.Background(), &profilespb.AutocompleteRequest{
+		Dictionary:  dictionary,
+		Query:       query,
+		Description: description,
+		Limit:       int32(limit),
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		return model.AutocompleteResponse{}, model.ErrUnknownDictionary
+	}
+	if err != nil {
+		ac.logger.WithFields(&logrus.Fields{
+			"dictionary": dictionary,
+			"error":      err,
+		}).Error("AutocompleteUseCase")
+		return model.AutocompleteResponse{}, err
+	}
+
+	suggestions := model.AutocompleteResponse{Suggestions: []model.Suggestion{}}
+	for _, s := range resp.Suggestions {
+		suggestions.Suggestions = append(suggestions.Suggestions, model.Suggestion{
+			Value:      s.Value,
+			Context:    s.Context,
+			Popularity: int(s.Popularity),
+		})
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BlockProfile struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewBlockProfileUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*BlockProfile, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrBlockUC
+	}
+	return &BlockProfile{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// BlockProfile blocks a profile and ends any match with it. The profiles
+// service queues the chat to be closed as on unmatch; it is hidden from both
+// chat lists while the block lasts.
+func (bp *BlockProfile) BlockProfile(profileId int, blockedId int, reason string) error {
+	bp.logger.Info("BlockProfileUseCase")
+
+	_, err := bp.ProfilesService.BlockProfile(context.Background(), &profilespb.BlockProfileRequest{
+		ProfileId: int32(profileId),
+		BlockedId: int32(blockedId),
+		Reason:    reason,
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrProfileNotFound
+	}
+	if err != nil {
+		bp.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"blocked_id": blockedId,
+			"error":      err,
+		}).Error("BlockProfileUseCase")
+		return err
+	}
+
+	return nil
+}
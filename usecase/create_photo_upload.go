@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreatePhotoUpload struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewCreatePhotoUploadUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*CreatePhotoUpload, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrPhotoUploadUC
+	}
+	return &CreatePhotoUpload{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (cu *CreatePhotoUpload) CreatePhotoUpload(userId int) (model.PhotoUpload, error) {
+	cu.logger.Info("CreatePhotoUploadUseCase")
+
+	resp, err := cu.ProfilesService.CreatePhotoUpload(context.Background(), &profilespb.CreatePhotoUploadRequest{
+		UserId: int32(userId),
+	})
+	if status.Code(err) == codes.ResourceExhausted {
+		return model.PhotoUpload{}, model.ErrTooManyPhotos
+	}
+	if err != nil {
+		cu.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("CreatePhotoUploadUseCase")
+		return model.PhotoUpload{}, err
+	}
+
+	return model.PhotoUpload{
+		UploadKey: resp.UploadKey,
+		URL:       resp.Url,
+		Method:    http.MethodPut,
+		ExpiresAt: resp.ExpiresAt.AsTime(),
+		MaxSize:   resp.MaxSize,
+	}, nil
+}
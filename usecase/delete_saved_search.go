@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DeleteSavedSearch struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewDeleteSavedSearchUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*DeleteSavedSearch, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrSavedSearchUC
+	}
+	return &DeleteSavedSearch{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (ds *DeleteSavedSearch) DeleteSavedSearch(profileId int, searchId int) error {
+	ds.logger.Info("DeleteSavedSearchUseCase")
+
+	_, err := ds.ProfilesService.DeleteSavedSearch(context.Background(), &profilespb.DeleteSavedSearchRequest{
+		ProfileId: int32(profileId),
+		SearchId:  int32(searchId),
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrSavedSearchNotFound
+	}
+	if err != nil {
+		ds.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"search_id":  searchId,
+			"error":      err,
+		}).Error("DeleteSavedSearchUseCase")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ExtendMatch struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewExtendMatchUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*ExtendMatch, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrMatchExpiryUC
+	}
+	return &ExtendMatch{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func matchStatusFromProto(m *profilespb.MatchExpiry) model.MatchStatus {
+	return model.MatchStatus{
+		ProfileId: int(m.MatchedId),
+		State:     m.State,
+		ExpiresAt: m.ExpiresAt.AsTime(),
+		Extended:  m.Extended,
+	}
+}
+
+// ExtendMatch pushes back the expiry of a match nobody has written in yet.
+// A match can be extended once, by either side.
+func (em *ExtendMatch) ExtendMatch(profileId int, matchedId int) (model.MatchStatus, error) {
+	em.logger.Info("ExtendMatchUseCase")
+
+	resp, err := em.ProfilesService.ExtendMatch(context.Background(), &profilespb.ExtendMatchRequest{
+		ProfileId: int32(profileId),
+		MatchedId: int32(matchedId),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return matchStatusFromProto(resp), nil
+	case codes.NotFound:
+		return model.MatchStatus{}, model.ErrMatchNotFound
+	case codes.FailedPrecondition:
+		return model.MatchStatus{}, model.ErrMatchNotExtendable
+	case codes.AlreadyExists:
+		return model.MatchStatus{}, model.ErrMatchAlreadyExtended
+	}
+
+	em.logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"matched_id": matchedId,
+		"error":      err,
+	}).Error("ExtendMatchUseCase")
+	return model.MatchStatus{}, err
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FinalizePhotoUpload struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewFinalizePhotoUploadUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*FinalizePhotoUpload, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrPhotoUploadUC
+	}
+	return &FinalizePhotoUpload{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (fu *FinalizePhotoUpload) FinalizePhotoUpload(userId int, uploadKey string) (string, error) {
+	fu.logger.Info("FinalizePhotoUploadUseCase")
+
+	resp, err := fu.ProfilesService.FinalizePhotoUpload(context.Background(), &profilespb.FinalizePhotoUploadRequest{
+		UserId:    int32(userId),
+		UploadKey: uploadKey,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return resp.Path, nil
+	case codes.InvalidArgument:
+		return "", model.ErrInvalidUpload
+	case codes.NotFound:
+		return "", model.ErrUploadNotFound
+	case codes.ResourceExhausted:
+		return "", model.ErrTooManyPhotos
+	case codes.FailedPrecondition:
+		return "", model.ErrLowQualityPhoto
+	case codes.AlreadyExists:
+		return "", model.ErrDuplicatePhoto
+	}
+
+	fu.logger.WithFields(&logrus.Fields{
+		"user_id":    userId,
+		"upload_key": uploadKey,
+		"error":      err,
+	}).Error("FinalizePhotoUploadUseCase")
+	return "", err
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetBlocks struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetBlocksUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetBlocks, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrBlockUC
+	}
+	return &GetBlocks{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gb *GetBlocks) GetBlocks(profileId int) (model.BlocksResponse, error) {
+	gb.logger.Info("GetBlocksUseCase")
+
+	resp, err := gb.ProfilesService.GetBlocks(context.Background(), &profilespb.GetBlocksRequest{
+		ProfileId: int32(profileId),
+	})
+	if err != nil {
+		gb.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("GetBlocksUseCase")
+		return model.BlocksResponse{}, err
+	}
+
+	blocks := model.BlocksResponse{Blocks: []model.BlockedProfile{}}
+	for _, b := range resp.Blocks {
+		blocks.Blocks = append(blocks.Blocks, model.BlockedProfile{
+			ProfileId: int(b.ProfileId),
+			FirstName: b.FirstName,
+			Avatar:    b.Avatar,
+			BlockedAt: b.BlockedAt.AsTime(),
+		})
+	}
+
+	return blocks, nil
+}
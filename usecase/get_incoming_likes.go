@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetIncomingLikes struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetIncomingLikesUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetIncomingLikes, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrGetIncomingLikesUC
+	}
+	return &GetIncomingLikes{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// GetIncomingLikes lists unanswered likes addressed to profileId.
+// Without premium only a blurred preview is returned: the liker's name and
+// photos stay hidden until the user subscribes. The liker's id is kept so
+// the like can still be answered through SetLike, and a comment sent with
+// the like is shown either way.
+func (gl *GetIncomingLikes) GetIncomingLikes(profileId int, isPremium bool) (model.IncomingLikesResponse, error) {
+	gl.logger.Info("GetIncomingLikesUseCase")
+	req := &profilespb.GetIncomingLikesRequest{
+		ProfileId: int32(profileId),
+	}
+	resp, err := gl.ProfilesService.GetIncomingLikes(context.Background(), req)
+	if err != nil {
+		gl.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("GetIncomingLikesUseCase")
+		return model.IncomingLikesResponse{}, err
+	}
+
+	likes := model.IncomingLikesResponse{Likes: []model.IncomingLike{}}
+	for _, like := range resp.Likes {
+		incoming := model.IncomingLike{
+			Profile: profileFromProto(like.Profile),
+			Status:  int(like.Status),
+			LikedAt: like.LikedAt.AsTime(),
+		}
+		if c := like.Comment; c != nil {
+			incoming.Comment = &model.LikeComment{
+				Text:     c.Text,
+				Photo:    c.Photo,
+				PromptId: int(c.PromptId),
+			}
+		}
+		if !isPremium {
+			incoming = blurIncomingLike(incoming)
+		}
+		likes.Likes = append(likes.Likes, incoming)
+	}
+	likes.Total = len(likes.Likes)
+
+	gl.logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"count":      likes.Total,
+		"premium":    isPremium,
+	}).Info("GetIncomingLikesUseCase")
+	return likes, nil
+}
+
+func blurIncomingLike(like model.IncomingLike) model.IncomingLike {
+	return model.IncomingLike{
+		Profile: model.Profile{
+			ProfileId: like.Profile.ProfileId,
+			IsMale:    like.Profile.IsMale,
+			Goal:      like.Profile.Goal,
+			Birthday:  like.Profile.Birthday,
+		},
+		Status:  like.Status,
+		LikedAt: like.LikedAt,
+		Blurred: true,
+		Comment: like.Comment,
+	}
+}
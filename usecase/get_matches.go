@@ -32,29 +32,8 @@ func (gp *GetProfileMatches) GetMatches(forUserId int) ([]model.Profile, error)
 	resp, err := gp.ProfilesService.GetProfileMatches(context.Background(), req)
 
 	var matches []model.Profile
-	for _, match := range resp.Profiles {
-		var prefs []model.Preference
-		for _, pref := range match.Preferences {
-			prefs = append(prefs, model.Preference{
-				Description: pref.Description,
-				Value:       pref.Value,
-			})
-		}
-		matches = append(matches, model.Profile{
-			ProfileId:   int(match.ProfileId),
-			FirstName:   match.FirstName,
-			LastName:    match.LastName,
-			IsMale:      match.IsMale,
-			Height:      int(match.Height),
-			Birthday:    match.Birthday.AsTime(),
-			Description: match.Description,
-			Location:    match.Location,
-			Interests:   match.Interests,
-			// LikedBy:	 match.LikedBy,
-			// give smbd information about by whom the user is liked?..
-			Preferences: prefs,
-			Photos:      match.Photos,
-		})
+	for _, match := range resp.GetProfiles() {
+		matches = append(matches, profileFromProto(match))
 	}
 	gp.logger.WithFields(&logrus.Fields{
 		"len matches": len(matches),
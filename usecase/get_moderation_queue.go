@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetModerationQueue struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetModerationQueueUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetModerationQueue, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrModerationUC
+	}
+	return &GetModerationQueue{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gm *GetModerationQueue) GetModerationQueue(limit int) (model.ModerationQueueResponse, error) {
+	gm.logger.Info("GetModerationQueueUseCase")
+
+	resp, err := gm.ProfilesService.GetModerationQueue(context.Background(), &profilespb.GetModerationQueueRequest{
+		Limit: int32(limit),
+	})
+	if err != nil {
+		gm.logger.WithFields(&logrus.Fields{
+			"limit": limit,
+			"error": err,
+		}).Error("GetModerationQueueUseCase")
+		return model.ModerationQueueResponse{}, err
+	}
+
+	queue := model.ModerationQueueResponse{
+		Photos:    []model.PendingPhoto{},
+		ExpiresAt: resp.ExpiresAt.AsTime(),
+	}
+	for _, photo := range resp.Photos {
+		queue.Photos = append(queue.Photos, model.PendingPhoto{
+			PhotoId:    int(photo.PhotoId),
+			ProfileId:  int(photo.ProfileId),
+			FirstName:  photo.FirstName,
+			Photo:      photoURLsFromProto(photo.Urls),
+			UploadedAt: photo.UploadedAt.AsTime(),
+		})
+	}
+
+	return queue, nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	userspb "github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetPause struct {
+	UsersService userspb.UsersServiceClient
+	logger       *logger.LogrusLogger
+}
+
+func NewGetPauseUseCase(
+	UsersService userspb.UsersServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetPause, error) {
+	if UsersService == nil || logger == nil {
+		return nil, model.ErrPauseUC
+	}
+	return &GetPause{UsersService: UsersService, logger: logger}, nil
+}
+
+func pauseFromProto(p *userspb.PauseStatus) model.PauseStatus {
+	pause := model.PauseStatus{Paused: p.Paused}
+	if p.ResumeAt != nil {
+		resumeAt := p.ResumeAt.AsTime()
+		pause.ResumeAt = &resumeAt
+	}
+	return pause
+}
+
+func (gp *GetPause) GetPause(userId int) (model.PauseStatus, error) {
+	gp.logger.Info("GetPauseUseCase")
+
+	resp, err := gp.UsersService.GetPause(context.Background(), &userspb.GetPauseRequest{
+		UserId: int32(userId),
+	})
+	if err != nil {
+		gp.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("GetPauseUseCase")
+		return model.PauseStatus{}, err
+	}
+
+	return pauseFromProto(resp), nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetPendingMatches struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetPendingMatchesUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetPendingMatches, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrMatchExpiryUC
+	}
+	return &GetPendingMatches{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// GetPendingMatches lists the matches of profileId that will expire unless
+// somebody writes, the soonest first.
+func (gp *GetPendingMatches) GetPendingMatches(profileId int) (model.PendingMatchesResponse, error) {
+	gp.logger.Info("GetPendingMatchesUseCase")
+
+	resp, err := gp.ProfilesService.GetPendingMatches(context.Background(), &profilespb.GetPendingMatchesRequest{
+		ProfileId: int32(profileId),
+	})
+	if err != nil {
+		gp.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("GetPendingMatchesUseCase")
+		return model.PendingMatchesResponse{}, err
+	}
+
+	matches := model.PendingMatchesResponse{Matches: []model.MatchStatus{}}
+	for _, m := range resp.Matches {
+		matches.Matches = append(matches.Matches, matchStatusFromProto(m))
+	}
+	return matches, nil
+}
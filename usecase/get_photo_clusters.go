@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetPhotoClusters struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetPhotoClustersUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetPhotoClusters, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrModerationUC
+	}
+	return &GetPhotoClusters{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gc *GetPhotoClusters) GetPhotoClusters(limit int) (model.PhotoClustersResponse, error) {
+	gc.logger.Info("GetPhotoClustersUseCase")
+
+	resp, err := gc.ProfilesService.GetPhotoClusters(context.Background(), &profilespb.GetPhotoClustersRequest{
+		Limit: int32(limit),
+	})
+	if err != nil {
+		gc.logger.WithFields(&logrus.Fields{
+			"limit": limit,
+			"error": err,
+		}).Error("GetPhotoClustersUseCase")
+		return model.PhotoClustersResponse{}, err
+	}
+
+	clusters := model.PhotoClustersResponse{
+		Clusters:  []model.PhotoCluster{},
+		ExpiresAt: resp.ExpiresAt.AsTime(),
+	}
+	for _, cluster := range resp.Clusters {
+		converted := model.PhotoCluster{
+			ProfileIds: []int{},
+			Photos:     []model.PhotoURLs{},
+		}
+		for _, id := range cluster.ProfileIds {
+			converted.ProfileIds = append(converted.ProfileIds, int(id))
+		}
+		for _, photo := range cluster.Photos {
+			converted.Photos = append(converted.Photos, photoURLsFromProto(photo))
+		}
+		clusters.Clusters = append(clusters.Clusters, converted)
+	}
+
+	return clusters, nil
+}
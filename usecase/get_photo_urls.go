@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetPhotoURLs struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetPhotoURLsUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetPhotoURLs, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrGetUserPhotoUC
+	}
+	return &GetPhotoURLs{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gu *GetPhotoURLs) GetPhotoURLs(userId int) (model.PhotoURLsResponse, error) {
+	gu.logger.Info("GetPhotoURLsUseCase")
+
+	resp, err := gu.ProfilesService.GetPhotoURLs(context.Background(), &profilespb.GetProfileImagesRequest{
+		UserId: int32(userId),
+	})
+	if err != nil {
+		gu.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("GetPhotoURLsUseCase")
+		return model.PhotoURLsResponse{}, err
+	}
+
+	urls := model.PhotoURLsResponse{
+		Photos:    []model.PhotoURLs{},
+		ExpiresAt: resp.ExpiresAt.AsTime(),
+	}
+	for _, photo := range resp.Photos {
+		urls.Photos = append(urls.Photos, photoURLsFromProto(photo))
+	}
+
+	return urls, nil
+}
+
+func photoURLsFromProto(photo *profilespb.PhotoURLs) model.PhotoURLs {
+	return model.PhotoURLs{
+		Path:    photo.Path,
+		Thumb:   photo.Thumb,
+		Card:    photo.Card,
+		Full:    photo.Full,
+		Primary: photo.Primary,
+		Status:  photo.Status,
+		Reason:  photo.Reason,
+	}
+}
@@ -27,7 +27,9 @@ func NewGetPremiumUseCase(
 	}, nil
 }
 
-func (ga *GetPremium) GetPremium(userId int) (bool, int, error) {
+// GetPremium reports whether the user has an active subscription, its
+// type, and whether the user's profile passed selfie verification.
+func (ga *GetPremium) GetPremium(userId int) (bool, int, bool, error) {
 	ga.logger.Info("GetAdmin", "userId", userId)
 	userReq := &userspb.GetPremiumRequest{
 		UserId: int32(userId),
@@ -39,9 +41,9 @@ func (ga *GetPremium) GetPremium(userId int) (bool, int, error) {
 			"error":         err.Error(),
 		}).Error("UsersService.GetPremium failed")
 
-		return false, 0, err
+		return false, 0, false, err
 	}
 	ga.logger.WithFields(&logrus.Fields{"GetAdmin": userId}).Info("DeleteUser")
 
-	return is_premium.IsSubsribe, int(is_premium.Type), err
+	return is_premium.IsSubsribe, int(is_premium.Type), is_premium.IsVerified, err
 }
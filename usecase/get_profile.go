@@ -37,50 +37,7 @@ func (gp *GetProfile) GetProfile(userId int) (model.Profile, error) {
 		return model.Profile{}, err
 	}
 
-	var prefs []model.Preference
-	for _, pref := range res.Profile.Preferences {
-		prefs = append(prefs, model.Preference{
-			Description: pref.Description,
-			Value:       pref.Value,
-		})
-	}
-
-	var params []model.Preference
-	for _, pref := range res.Profile.Parametres {
-		params = append(params, model.Preference{
-			Description: pref.Description,
-			Value:       pref.Value,
-		})
-	}
-
-	var likedBy []int
-	for _, like := range res.Profile.LikedBy {
-		likedBy = append(likedBy, int(like))
-	}
-
-	premium := model.Premium{}
-	if res.Profile.Premium != nil {
-		premium.Status = res.Profile.Premium.Status
-		premium.Border = int32(res.Profile.Premium.Border)
-	}
-
-	var profile model.Profile = model.Profile{
-		ProfileId:   int(res.Profile.ProfileId),
-		FirstName:   res.Profile.FirstName,
-		LastName:    res.Profile.LastName,
-		IsMale:      res.Profile.IsMale,
-		Height:      int(res.Profile.Height),
-		Goal:        int(res.Profile.Goal),
-		Birthday:    res.Profile.Birthday.AsTime(),
-		Description: res.Profile.Description,
-		Location:    res.Profile.Location,
-		Interests:   res.Profile.Interests,
-		LikedBy:     likedBy,
-		Preferences: prefs,
-		Parameters:  params,
-		Photos:      res.Profile.Photos,
-		Premium:     premium,
-	}
+	profile := profileFromProto(res.Profile)
 
 	gp.logger.WithFields(&logrus.Fields{
 		"profile": profile,
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetProfileHistory struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetProfileHistoryUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetProfileHistory, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrProfileHistoryUC
+	}
+	return &GetProfileHistory{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gh *GetProfileHistory) GetProfileHistory(profileId int, limit int) (model.ProfileHistoryResponse, error) {
+	gh.logger.Info("GetProfileHistoryUseCase")
+
+	resp, err := gh.ProfilesService.GetProfileHistory(context.Background(), &profilespb.GetProfileHistoryRequest{
+		ProfileId: int32(profileId),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		gh.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"limit":      limit,
+			"error":      err,
+		}).Error("GetProfileHistoryUseCase")
+		return model.ProfileHistoryResponse{}, err
+	}
+
+	history := model.ProfileHistoryResponse{Versions: []model.ProfileVersion{}}
+	for _, v := range resp.Versions {
+		version := model.ProfileVersion{
+			VersionId: int(v.VersionId),
+			ProfileId: int(v.ProfileId),
+			ActorId:   int(v.ActorId),
+			Action:    v.Action,
+			Snapshot:  profileFromProto(v.Snapshot),
+			Changes:   []model.FieldChange{},
+			CreatedAt: v.CreatedAt.AsTime(),
+		}
+		version.Snapshot.ProfileId = int(v.ProfileId)
+		for _, c := range v.Changes {
+			version.Changes = append(version.Changes, model.FieldChange{
+				Field: c.Field,
+				Old:   json.RawMessage(c.Old),
+				New:   json.RawMessage(c.New),
+			})
+		}
+		history.Versions = append(history.Versions, version)
+	}
+
+	return history, nil
+}
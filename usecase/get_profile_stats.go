@@ -47,6 +47,13 @@ func (gp *GetProfileStats) GetProfileStats(userId int) (model.ProfileStats, erro
 		ComplaintsReceived: int(res.ComplaintsReceived),
 		MessagesSent:       int(res.MessagesSent),
 		ChatCount:          int(res.ChatCount),
+		Recycled:           int(res.Recycled),
+		Boosts:             []model.Boost{},
+		Completeness:       completenessFromProto(res.Completeness),
+	}
+
+	for _, boost := range res.Boosts {
+		stats.Boosts = append(stats.Boosts, boostFromProto(boost))
 	}
 
 	gp.logger.WithFields(&logrus.Fields{
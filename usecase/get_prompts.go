@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type GetPrompts struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetPromptsUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetPrompts, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrGetPromptsUC
+	}
+	return &GetPrompts{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func promptsFromProto(prompts []*profilespb.ProfilePrompt) []model.ProfilePrompt {
+	var res []model.ProfilePrompt
+	for _, p := range prompts {
+		res = append(res, model.ProfilePrompt{
+			PromptId: int(p.PromptId),
+			Question: p.Question,
+			Answer:   p.Answer,
+		})
+	}
+	return res
+}
+
+func promptsToProto(prompts []model.ProfilePrompt) []*profilespb.ProfilePrompt {
+	var res []*profilespb.ProfilePrompt
+	for _, p := range prompts {
+		res = append(res, &profilespb.ProfilePrompt{
+			PromptId: int32(p.PromptId),
+			Answer:   p.Answer,
+		})
+	}
+	return res
+}
+
+// GetPrompts returns the catalog of prompts a profile can answer.
+func (gp *GetPrompts) GetPrompts() ([]model.Prompt, error) {
+	gp.logger.Info("GetPromptsUseCase")
+
+	resp, err := gp.ProfilesService.GetPrompts(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		gp.logger.WithFields(&logrus.Fields{"error": err}).Error("GetPromptsUseCase")
+		return nil, err
+	}
+
+	prompts := []model.Prompt{}
+	for _, p := range resp.Prompts {
+		prompts = append(prompts, model.Prompt{
+			PromptId: int(p.PromptId),
+			Question: p.Question,
+		})
+	}
+	return prompts, nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetSavedSearches struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetSavedSearchesUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetSavedSearches, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrSavedSearchUC
+	}
+	return &GetSavedSearches{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gs *GetSavedSearches) GetSavedSearches(profileId int) (model.SavedSearchesResponse, error) {
+	gs.logger.Info("GetSavedSearchesUseCase")
+
+	resp, err := gs.ProfilesService.GetSavedSearches(context.Background(), &profilespb.GetSavedSearchesRequest{
+		ProfileId: int32(profileId),
+	})
+	if err != nil {
+		gs.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("GetSavedSearchesUseCase")
+		return model.SavedSearchesResponse{}, err
+	}
+
+	searches := model.SavedSearchesResponse{Searches: []model.SavedSearch{}}
+	for _, s := range resp.Searches {
+		searches.Searches = append(searches.Searches, savedSearchFromProto(s))
+	}
+
+	return searches, nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GetSecondLook struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetSecondLookUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetSecondLook, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrSecondLookUC
+	}
+	return &GetSecondLook{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gs *GetSecondLook) GetSecondLook(profileId int) (model.SecondLook, error) {
+	gs.logger.Info("GetSecondLookUseCase")
+
+	resp, err := gs.ProfilesService.GetSecondLook(context.Background(), &profilespb.GetSecondLookRequest{
+		ProfileId: int32(profileId),
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.SecondLook{}, model.ErrProfileNotFound
+	}
+	if err != nil {
+		gs.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("GetSecondLookUseCase")
+		return model.SecondLook{}, err
+	}
+
+	return model.SecondLook{
+		Enabled:  resp.Enabled,
+		Recycled: int(resp.Recycled),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetTopPicks struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetTopPicksUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetTopPicks, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrGetTopPicksUC
+	}
+	return &GetTopPicks{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gt *GetTopPicks) GetTopPicks(profileId int) (model.TopPicksResponse, error) {
+	gt.logger.Info("GetTopPicksUseCase")
+	req := &profilespb.GetTopPicksRequest{
+		ProfileId: int32(profileId),
+	}
+	resp, err := gt.ProfilesService.GetTopPicks(context.Background(), req)
+	if err != nil {
+		gt.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("GetTopPicksUseCase")
+		return model.TopPicksResponse{}, err
+	}
+
+	picks := model.TopPicksResponse{Profiles: []model.Profile{}}
+	if resp.ExpiresAt != nil {
+		picks.ExpiresAt = resp.ExpiresAt.AsTime()
+	}
+
+	for _, pick := range resp.Profiles {
+		picks.Profiles = append(picks.Profiles, profileFromProto(pick))
+	}
+
+	gt.logger.WithFields(&logrus.Fields{
+		"profile_id": profileId,
+		"count":      len(picks.Profiles),
+	}).Info("GetTopPicksUseCase")
+	return picks, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetVerification struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetVerificationUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetVerification, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrVerificationUC
+	}
+	return &GetVerification{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func verificationFromProto(v *profilespb.VerificationStatus) model.VerificationStatus {
+	status := model.VerificationStatus{
+		Verified:    v.Verified,
+		Status:      v.Status,
+		Pose:        v.Pose,
+		Instruction: v.Instruction,
+		Reason:      v.Reason,
+	}
+	if v.ExpiresAt != nil {
+		expiresAt := v.ExpiresAt.AsTime()
+		status.ExpiresAt = &expiresAt
+	}
+	return status
+}
+
+func (gv *GetVerification) GetVerification(userId int) (model.VerificationStatus, error) {
+	gv.logger.Info("GetVerificationUseCase")
+
+	resp, err := gv.ProfilesService.GetVerification(context.Background(), &profilespb.VerificationRequest{
+		UserId: int32(userId),
+	})
+	if err != nil {
+		gv.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("GetVerificationUseCase")
+		return model.VerificationStatus{}, err
+	}
+
+	return verificationFromProto(resp), nil
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type GetVerificationQueue struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewGetVerificationQueueUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*GetVerificationQueue, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrVerificationUC
+	}
+	return &GetVerificationQueue{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (gq *GetVerificationQueue) GetVerificationQueue(limit int) (model.VerificationQueueResponse, error) {
+	gq.logger.Info("GetVerificationQueueUseCase")
+
+	resp, err := gq.ProfilesService.GetVerificationQueue(context.Background(), &profilespb.GetVerificationQueueRequest{
+		Limit: int32(limit),
+	})
+	if err != nil {
+		gq.logger.WithFields(&logrus.Fields{
+			"limit": limit,
+			"error": err,
+		}).Error("GetVerificationQueueUseCase")
+		return model.VerificationQueueResponse{}, err
+	}
+
+	queue := model.VerificationQueueResponse{
+		Requests:  []model.PendingVerification{},
+		ExpiresAt: resp.ExpiresAt.AsTime(),
+	}
+	for _, req := range resp.Requests {
+		pending := model.PendingVerification{
+			RequestId:   int(req.RequestId),
+			ProfileId:   int(req.ProfileId),
+			FirstName:   req.FirstName,
+			Pose:        req.Pose,
+			Instruction: req.Instruction,
+			SelfieURL:   req.SelfieUrl,
+			Photos:      []model.PhotoURLs{},
+			SubmittedAt: req.SubmittedAt.AsTime(),
+		}
+		for _, photo := range req.Photos {
+			pending.Photos = append(pending.Photos, photoURLsFromProto(photo))
+		}
+		queue.Requests = append(queue.Requests, pending)
+	}
+
+	return queue, nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ProfileSetLike struct {
@@ -23,17 +25,41 @@ func NewProfileSetLikeUseCase(
 	return &ProfileSetLike{ProfileService: ProfileService, logger: logger}, nil
 }
 
-func (l *ProfileSetLike) SetLike(from int, to int, status int) (int, error) {
+// SetLike stores the like. A non-empty idempotencyKey makes the call safe
+// to retry: a repeat returns the first result marked as replayed.
+func (l *ProfileSetLike) SetLike(from int, to int, likeStatus int, comment *model.LikeComment, idempotencyKey string) (model.LikeResult, error) {
 	l.logger.Info("ProfileSetLikeUseCase")
 	req := &profilespb.SetProfileLikeRequest{
-		From:   int32(from),
-		To:     int32(to),
-		Status: int32(status),
+		From:           int32(from),
+		To:             int32(to),
+		Status:         int32(likeStatus),
+		IdempotencyKey: idempotencyKey,
+	}
+	if comment != nil {
+		req.Comment = &profilespb.LikeComment{
+			Text:     comment.Text,
+			Photo:    comment.Photo,
+			PromptId: int32(comment.PromptId),
+		}
 	}
 	resp, err := l.ProfileService.SetProfileLike(context.Background(), req)
+	switch status.Code(err) {
+	case codes.PermissionDenied:
+		return model.LikeResult{}, model.ErrProfileBlocked
+	case codes.InvalidArgument:
+		return model.LikeResult{}, model.ErrInvalidLikeComment
+	case codes.OutOfRange:
+		return model.LikeResult{}, model.ErrInvalidIdempotencyKey
+	case codes.AlreadyExists:
+		return model.LikeResult{}, model.ErrIdempotencyKeyReused
+	}
 	if err != nil {
 		l.logger.Error("ProfileSetLikeUseCase", err)
-		return 0, err
+		return model.LikeResult{}, err
 	}
-	return int(resp.LikeId), nil
+
+	return model.LikeResult{
+		LikeId:   int(resp.LikeId),
+		Replayed: resp.Replayed,
+	}, nil
 }
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type MatchExpiry struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewMatchExpiryUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*MatchExpiry, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrMatchExpiryUC
+	}
+	return &MatchExpiry{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// SendMatchExpiry lets the profiles service move pending matches along.
+// The profiles service queues the expired matches and the due reminders in
+// the outbox, whose relay notifies the pair and makes the chat of an
+// expired match read-only. It returns the number of matches reminded.
+func (me *MatchExpiry) SendMatchExpiry() (int, error) {
+	resp, err := me.ProfilesService.CollectMatchExpiry(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return 0, err
+	}
+	return len(resp.Reminders), nil
+}
+
+func (me *MatchExpiry) RunMatchExpiryJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		sent, err := me.SendMatchExpiry()
+		if err != nil {
+			me.logger.WithFields(&logrus.Fields{"error": err}).Error("RunMatchExpiryJob")
+		} else {
+			me.logger.WithFields(&logrus.Fields{"sent": sent}).Info("RunMatchExpiryJob")
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ModeratePhoto struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewModeratePhotoUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*ModeratePhoto, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrModerationUC
+	}
+	return &ModeratePhoto{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (mp *ModeratePhoto) ModeratePhoto(photoId int, moderatorId int, approve bool, reason string) error {
+	mp.logger.Info("ModeratePhotoUseCase")
+
+	_, err := mp.ProfilesService.ModeratePhoto(context.Background(), &profilespb.ModeratePhotoRequest{
+		PhotoId:     int32(photoId),
+		ModeratorId: int32(moderatorId),
+		Approve:     approve,
+		Reason:      reason,
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrPhotoNotFound
+	}
+	if err != nil {
+		mp.logger.WithFields(&logrus.Fields{
+			"photo_id":     photoId,
+			"moderator_id": moderatorId,
+			"error":        err,
+		}).Error("ModeratePhotoUseCase")
+		return err
+	}
+
+	return nil
+}
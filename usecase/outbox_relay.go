@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+type OutboxRelay struct {
+	notifRepo repository.NotificationsRepository
+	logger    *logger.LogrusLogger
+}
+
+func NewOutboxRelayUseCase(notifRepo repository.NotificationsRepository, logger *logger.LogrusLogger) (*OutboxRelay, error) {
+	if notifRepo == nil || logger == nil {
+		return nil, model.ErrOutboxRelayUC
+	}
+	return &OutboxRelay{notifRepo: notifRepo, logger: logger}, nil
+}
+
+// RenderOutboxEvent works out what an outbox event does. A new or revived
+// match is notified and reopens the pair's chat, or opens it with the
+// comments sent with the likes; an expired one is notified
+// and makes the chat read-only. A reminder is notified with the hours left
+// and leaves the chat alone. An unmatch or a block closes the chat without
+// a notification. Events it does not know, or cannot read, do
+// nothing.
+func RenderOutboxEvent(e model.OutboxEvent) model.OutboxEffect {
+	var payload struct {
+		MatchedId int                  `json:"matchedId"`
+		ExpiresAt time.Time            `json:"expiresAt"`
+		Comments  []model.MatchComment `json:"comments"`
+	}
+	switch e.EventType {
+	case "match", "match_expired", "match_reminder", "chat_closed":
+		if err := json.Unmarshal(e.Payload, &payload); err != nil || payload.MatchedId == 0 {
+			return model.OutboxEffect{}
+		}
+	default:
+		return model.OutboxEffect{}
+	}
+
+	chat := model.ChatChange{FirstId: e.ProfileId, SecondId: payload.MatchedId}
+	notif := model.NotificationSend{NotifType: e.EventType, Read: 0}
+	switch e.EventType {
+	case "match":
+		notif.Content = fmt.Sprintf("You have matched with user %d!", payload.MatchedId)
+		chat.Seed = payload.Comments
+	case "match_expired":
+		notif.Content = fmt.Sprintf("Your match with user %d has expired", payload.MatchedId)
+		chat.ReadOnly = true
+	case "match_reminder":
+		hours := int(math.Ceil(time.Until(payload.ExpiresAt).Hours()))
+		notif.Content = fmt.Sprintf("Your match with user %d expires in %d h. Say hi!", payload.MatchedId, hours)
+		return model.OutboxEffect{Notification: &notif}
+	default:
+		chat.ReadOnly = true
+		return model.OutboxEffect{Chat: &chat}
+	}
+	return model.OutboxEffect{Notification: &notif, Chat: &chat}
+}
+
+// RelayOutbox applies the events queued by the profiles service and returns
+// how many notifications were sent.
+func (or *OutboxRelay) RelayOutbox() (int, error) {
+	return or.notifRepo.RelayOutbox(model.OutboxBatch, model.OutboxRetention, func(e model.OutboxEvent) model.OutboxEffect {
+		effect := RenderOutboxEvent(e)
+		if effect.Notification == nil && effect.Chat == nil {
+			or.logger.WithFields(&logrus.Fields{
+				"event_id":   e.EventId,
+				"event_type": e.EventType,
+			}).Warn("RelayOutbox: skipping event")
+		}
+		return effect
+	})
+}
+
+func (or *OutboxRelay) RunOutboxJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		sent, err := or.RelayOutbox()
+		if err != nil {
+			or.logger.WithFields(&logrus.Fields{"error": err}).Error("RunOutboxJob")
+		} else if sent > 0 {
+			or.logger.WithFields(&logrus.Fields{"sent": sent}).Info("RunOutboxJob")
+		}
+	}
+}
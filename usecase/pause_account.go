@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	userspb "github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PauseAccount struct {
+	UsersService userspb.UsersServiceClient
+	logger       *logger.LogrusLogger
+}
+
+func NewPauseAccountUseCase(
+	UsersService userspb.UsersServiceClient,
+	logger *logger.LogrusLogger,
+) (*PauseAccount, error) {
+	if UsersService == nil || logger == nil {
+		return nil, model.ErrPauseUC
+	}
+	return &PauseAccount{UsersService: UsersService, logger: logger}, nil
+}
+
+// PauseAccount pauses the account until resumeAt, or until it is resumed by
+// hand when resumeAt is nil.
+func (pa *PauseAccount) PauseAccount(userId int, resumeAt *time.Time) (model.PauseStatus, error) {
+	pa.logger.Info("PauseAccountUseCase")
+
+	req := &userspb.PauseAccountRequest{UserId: int32(userId)}
+	if resumeAt != nil {
+		req.ResumeAt = timestamppb.New(*resumeAt)
+	}
+
+	resp, err := pa.UsersService.PauseAccount(context.Background(), req)
+	if status.Code(err) == codes.InvalidArgument {
+		return model.PauseStatus{}, model.ErrInvalidResumeDate
+	}
+	if err != nil {
+		pa.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("PauseAccountUseCase")
+		return model.PauseStatus{}, err
+	}
+
+	return pauseFromProto(resp), nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+)
+
+func profileFromProto(p *profilespb.Profile) model.Profile {
+	if p == nil {
+		return model.Profile{}
+	}
+
+	var prefs []model.Preference
+	for _, pref := range p.Preferences {
+		prefs = append(prefs, model.Preference{
+			Description: pref.Description,
+			Value:       pref.Value,
+		})
+	}
+
+	var params []model.Preference
+	for _, pref := range p.Parametres {
+		params = append(params, model.Preference{
+			Description: pref.Description,
+			Value:       pref.Value,
+		})
+	}
+
+	var completeness *model.Completeness
+	if p.Completeness != nil {
+		c := completenessFromProto(p.Completeness)
+		completeness = &c
+	}
+
+	premium := model.Premium{}
+	if p.Premium != nil {
+		premium.Status = p.Premium.Status
+		premium.Border = p.Premium.Border
+	}
+
+	return model.Profile{
+		ProfileId:    int(p.ProfileId),
+		FirstName:    p.FirstName,
+		LastName:     p.LastName,
+		IsMale:       p.IsMale,
+		Goal:         int(p.Goal),
+		Height:       int(p.Height),
+		Birthday:     p.Birthday.AsTime(),
+		Description:  p.Description,
+		Location:     p.Location,
+		Interests:    p.Interests,
+		Preferences:  prefs,
+		Parameters:   params,
+		Photos:       p.Photos,
+		Premium:      premium,
+		Verified:     p.Verified,
+		Prompts:      promptsFromProto(p.Prompts),
+		Completeness: completeness,
+		Timezone:     p.Timezone,
+	}
+}
+
+func completenessFromProto(c *profilespb.Completeness) model.Completeness {
+	missing := c.GetMissing()
+	if missing == nil {
+		missing = []string{}
+	}
+	return model.Completeness{
+		Score:   int(c.GetScore()),
+		Missing: missing,
+	}
+}
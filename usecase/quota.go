@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// Quota is the single entry point handlers use to enforce per-plan limits
+// on feed loads, swipes, superlikes, rewinds, boosts and like comments.
+type Quota struct {
+	quotaRepo repository.QuotaRepository
+	logger    *logger.LogrusLogger
+}
+
+func NewQuotaUseCase(quotaRepo repository.QuotaRepository, logger *logger.LogrusLogger) (*Quota, error) {
+	if quotaRepo == nil || logger == nil {
+		return nil, model.ErrQuotaUC
+	}
+	return &Quota{quotaRepo: quotaRepo, logger: logger}, nil
+}
+
+// QuotaPlan picks the plan whose limits apply to a user. Unverified free
+// users only get the lower plan when model.UnverifiedPlanEnabled is set;
+// premium users are never held back by missing verification.
+func QuotaPlan(isPremium, isVerified bool) string {
+	if isPremium {
+		return model.PlanPremium
+	}
+	if !isVerified && model.UnverifiedPlanEnabled {
+		return model.PlanUnverified
+	}
+	return model.PlanFree
+}
+
+func quotaRule(plan string, kind string) (model.QuotaRule, error) {
+	rule, ok := model.QuotaPlans[plan][kind]
+	if !ok {
+		return model.QuotaRule{}, model.ErrUnknownQuota
+	}
+	return rule, nil
+}
+
+// quotaWindow returns the counter key for the current window and the moment
+// a counter created now would reset.
+func quotaWindow(rule model.QuotaRule, userID int, kind string, now time.Time) (string, time.Time) {
+	if rule.Window == model.WindowCalendar {
+		start := now.UTC().Truncate(rule.Period)
+		return fmt.Sprintf("quota:%s:%d:%d", kind, userID, start.Unix()), start.Add(rule.Period)
+	}
+	return fmt.Sprintf("quota:%s:%d", kind, userID), now.Add(rule.Period)
+}
+
+func quotaStatus(kind string, rule model.QuotaRule, used int, resetsAt time.Time) model.QuotaStatus {
+	remaining := model.QuotaUnlimited
+	if rule.Limit != model.QuotaUnlimited {
+		remaining = max(rule.Limit-used, 0)
+	}
+	return model.QuotaStatus{
+		Kind:      kind,
+		Limit:     rule.Limit,
+		Used:      used,
+		Remaining: remaining,
+		Window:    rule.Window,
+		ResetsAt:  resetsAt,
+	}
+}
+
+func (q *Quota) usage(rule model.QuotaRule, userID int, kind string) (int, time.Time, error) {
+	now := time.Now()
+	key, resetsAt := quotaWindow(rule, userID, kind, now)
+	used, ttl, err := q.quotaRepo.GetUsage(key)
+	if err != nil {
+		return 0, resetsAt, err
+	}
+	if rule.Window == model.WindowRolling && ttl > 0 {
+		resetsAt = now.Add(ttl)
+	}
+	return used, resetsAt, nil
+}
+
+// Consume takes one unit of kind for the user. When the limit is reached the
+// returned status is still filled so callers can report it, and the error is
+// model.ErrQuotaExceeded.
+func (q *Quota) Consume(userID int, plan string, kind string) (model.QuotaStatus, error) {
+	q.logger.Info("QuotaConsume", "userId", userID, "kind", kind)
+
+	rule, err := quotaRule(plan, kind)
+	if err != nil {
+		return model.QuotaStatus{}, err
+	}
+
+	if rule.Limit == 0 {
+		_, resetsAt := quotaWindow(rule, userID, kind, time.Now())
+		return quotaStatus(kind, rule, 0, resetsAt), model.ErrQuotaExceeded
+	}
+
+	key, resetsAt := quotaWindow(rule, userID, kind, time.Now())
+	used, err := q.quotaRepo.Increment(key, resetsAt)
+	if err != nil {
+		q.logger.WithFields(&logrus.Fields{"userId": userID, "kind": kind, "error": err}).Error("QuotaConsume")
+		return model.QuotaStatus{}, err
+	}
+
+	if rule.Window == model.WindowRolling && used > 1 {
+		if _, current, err := q.usage(rule, userID, kind); err == nil {
+			resetsAt = current
+		}
+	}
+
+	if rule.Limit != model.QuotaUnlimited && used > rule.Limit {
+		if err := q.quotaRepo.Decrement(key); err != nil {
+			q.logger.WithFields(&logrus.Fields{"userId": userID, "kind": kind, "error": err}).Error("QuotaConsume")
+		}
+		q.logger.WithFields(&logrus.Fields{"userId": userID, "kind": kind, "limit": rule.Limit}).Warn("QuotaConsume")
+		return quotaStatus(kind, rule, rule.Limit, resetsAt), model.ErrQuotaExceeded
+	}
+
+	return quotaStatus(kind, rule, used, resetsAt), nil
+}
+
+// Refund gives back a unit taken by Consume when the action it guarded
+// did not happen.
+func (q *Quota) Refund(userID int, plan string, kind string) error {
+	rule, err := quotaRule(plan, kind)
+	if err != nil {
+		return err
+	}
+	if rule.Limit == 0 {
+		return nil
+	}
+
+	key, _ := quotaWindow(rule, userID, kind, time.Now())
+	if err := q.quotaRepo.Decrement(key); err != nil {
+		q.logger.WithFields(&logrus.Fields{"userId": userID, "kind": kind, "error": err}).Error("QuotaRefund")
+		return err
+	}
+	return nil
+}
+
+func (q *Quota) GetQuotas(userID int, plan string) (model.QuotaResponse, error) {
+	q.logger.Info("GetQuotas", "userId", userID)
+
+	resp := model.QuotaResponse{
+		Plan:   plan,
+		Quotas: make([]model.QuotaStatus, 0, len(model.QuotaKinds)),
+	}
+
+	for _, kind := range model.QuotaKinds {
+		rule, err := quotaRule(plan, kind)
+		if err != nil {
+			return resp, err
+		}
+
+		used, resetsAt, err := q.usage(rule, userID, kind)
+		if err != nil {
+			q.logger.WithFields(&logrus.Fields{"userId": userID, "kind": kind, "error": err}).Error("GetQuotas")
+			return resp, err
+		}
+		resp.Quotas = append(resp.Quotas, quotaStatus(kind, rule, used, resetsAt))
+	}
+
+	return resp, nil
+}
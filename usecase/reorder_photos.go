@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReorderPhotos struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewReorderPhotosUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*ReorderPhotos, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrPhotoOrderUC
+	}
+	return &ReorderPhotos{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (rp *ReorderPhotos) ReorderPhotos(userId int, paths []string) error {
+	rp.logger.Info("ReorderPhotosUseCase")
+
+	_, err := rp.ProfilesService.ReorderPhotos(context.Background(), &profilespb.ReorderPhotosRequest{
+		UserId: int32(userId),
+		Paths:  paths,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		return model.ErrInvalidPhotoOrder
+	}
+	if err != nil {
+		rp.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("ReorderPhotosUseCase")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	userspb "github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/delivery"
+	"github.com/sirupsen/logrus"
+)
+
+type ResumeAccount struct {
+	UsersService userspb.UsersServiceClient
+	logger       *logger.LogrusLogger
+}
+
+func NewResumeAccountUseCase(
+	UsersService userspb.UsersServiceClient,
+	logger *logger.LogrusLogger,
+) (*ResumeAccount, error) {
+	if UsersService == nil || logger == nil {
+		return nil, model.ErrPauseUC
+	}
+	return &ResumeAccount{UsersService: UsersService, logger: logger}, nil
+}
+
+func (ra *ResumeAccount) ResumeAccount(userId int) error {
+	ra.logger.Info("ResumeAccountUseCase")
+
+	_, err := ra.UsersService.ResumeAccount(context.Background(), &userspb.ResumeAccountRequest{
+		UserId: int32(userId),
+	})
+	if err != nil {
+		ra.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("ResumeAccountUseCase")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RevertProfile struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewRevertProfileUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*RevertProfile, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrProfileHistoryUC
+	}
+	return &RevertProfile{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (rp *RevertProfile) RevertProfile(profileId int, versionId int, actorId int) error {
+	rp.logger.Info("RevertProfileUseCase")
+
+	_, err := rp.ProfilesService.RevertProfile(context.Background(), &profilespb.RevertProfileRequest{
+		ProfileId: int32(profileId),
+		VersionId: int32(versionId),
+		ActorId:   int32(actorId),
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrVersionNotFound
+	}
+	if err != nil {
+		rp.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"version_id": versionId,
+			"actor_id":   actorId,
+			"error":      err,
+		}).Error("RevertProfileUseCase")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReviewVerification struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewReviewVerificationUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*ReviewVerification, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrVerificationUC
+	}
+	return &ReviewVerification{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (rv *ReviewVerification) ReviewVerification(requestId int, reviewerId int, approve bool, reason string) error {
+	rv.logger.Info("ReviewVerificationUseCase")
+
+	_, err := rv.ProfilesService.ReviewVerification(context.Background(), &profilespb.ReviewVerificationRequest{
+		RequestId:  int32(requestId),
+		ReviewerId: int32(reviewerId),
+		Approve:    approve,
+		Reason:     reason,
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrVerificationNotFound
+	}
+	if err != nil {
+		rv.logger.WithFields(&logrus.Fields{
+			"request_id":  requestId,
+			"reviewer_id": reviewerId,
+			"error":       err,
+		}).Error("ReviewVerificationUseCase")
+		return err
+	}
+
+	return nil
+}
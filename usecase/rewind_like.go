@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RewindLike struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewRewindLikeUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*RewindLike, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrRewindLikeUC
+	}
+	return &RewindLike{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// RewindLike takes back the last swipe of profileId if it was a dislike and
+// returns the profile it was on.
+func (rl *RewindLike) RewindLike(profileId int) (int, error) {
+	rl.logger.Info("RewindLikeUseCase")
+
+	resp, err := rl.ProfilesService.RewindLike(context.Background(), &profilespb.RewindLikeRequest{
+		ProfileId: int32(profileId),
+	})
+	if status.Code(err) == codes.NotFound {
+		return 0, model.ErrNothingToRewind
+	}
+	if err != nil {
+		rl.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("RewindLikeUseCase")
+		return 0, err
+	}
+	return int(resp.ProfileId), nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SaveSearch struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewSaveSearchUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*SaveSearch, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrSavedSearchUC
+	}
+	return &SaveSearch{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (ss *SaveSearch) SaveSearch(profileId int, input model.SaveSearchRequest) (model.SavedSearch, error) {
+	ss.logger.Info("SaveSearchUseCase")
+
+	params := input.Params
+	params.Cursor = ""
+	params.PageSize = 0
+
+	resp, err := ss.ProfilesService.SaveSearch(context.Background(), &profilespb.SaveSearchRequest{
+		ProfileId: int32(profileId),
+		Name:      input.Name,
+		Params:    searchRequestToProto(params),
+		Alerts:    input.Alerts,
+	})
+	if err != nil {
+		ss.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"error":      err,
+		}).Error("SaveSearchUseCase")
+
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return model.SavedSearch{}, model.ErrInvalidSearchRequest
+		case codes.ResourceExhausted:
+			return model.SavedSearch{}, model.ErrTooManySavedSearches
+		}
+		return model.SavedSearch{}, err
+	}
+
+	return savedSearchFromProto(resp), nil
+}
+
+func savedSearchFromProto(s *profilespb.SavedSearch) model.SavedSearch {
+	search := model.SavedSearch{
+		SearchID: int(s.GetSearchId()),
+		Name:     s.GetName(),
+		Params:   searchRequestFromProto(s.GetParams()),
+		Alerts:   s.GetAlerts(),
+	}
+	if s.CreatedAt != nil {
+		search.CreatedAt = s.CreatedAt.AsTime()
+	}
+	return search
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type SearchAlerts struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	notifRepo       repository.NotificationsRepository
+	logger          *logger.LogrusLogger
+}
+
+func NewSearchAlertsUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	notifRepo repository.NotificationsRepository,
+	logger *logger.LogrusLogger,
+) (*SearchAlerts, error) {
+	if ProfilesService == nil || notifRepo == nil || logger == nil {
+		return nil, model.ErrSavedSearchUC
+	}
+	return &SearchAlerts{ProfilesService: ProfilesService, notifRepo: notifRepo, logger: logger}, nil
+}
+
+// SendSearchAlerts asks the profiles service for new hits of saved searches
+// and turns each one into a search_alert notification for its owner.
+func (sa *SearchAlerts) SendSearchAlerts() (int, error) {
+	resp, err := sa.ProfilesService.CollectSearchAlerts(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, alert := range resp.Alerts {
+		notif := model.NotificationSend{
+			NotifType: "search_alert",
+			Content:   fmt.Sprintf("%d new profiles match your saved search %q", len(alert.Hits), alert.Name),
+			Read:      0,
+		}
+
+		if err := sa.notifRepo.AddNotification(int(alert.ProfileId), notif); err != nil {
+			sa.logger.WithFields(&logrus.Fields{
+				"profile_id": alert.ProfileId,
+				"search_id":  alert.SearchId,
+				"error":      err,
+			}).Error("SendSearchAlerts")
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (sa *SearchAlerts) RunSearchAlertsJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		sent, err := sa.SendSearchAlerts()
+		if err != nil {
+			sa.logger.WithFields(&logrus.Fields{"error": err}).Error("RunSearchAlertsJob")
+		} else {
+			sa.logger.WithFields(&logrus.Fields{"sent": sent}).Info("RunSearchAlertsJob")
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SearchProfiles struct {
@@ -26,31 +29,22 @@ func NewSearchProfilesUseCase(
 		logger:          logger,
 	}, nil
 }
-func (gp *SearchProfiles) GetSearchProfiles(forUserId int, params model.SearchProfileRequest) ([]model.FoundProfile, error) {
+func (gp *SearchProfiles) GetSearchProfiles(forUserId int, params model.SearchProfileRequest) (model.FoundProfileResponse, error) {
 	gp.logger.Info("GetProfilesForUserUseCase")
 
-	req := &profilespb.SearchProfileRequest{
-		IDUser:     int32(forUserId),
-		Input:      params.Input,
-		IsMale:     params.IsMale,
-		AgeMin:     int32(params.AgeMin),
-		AgeMax:     int32(params.AgeMax),
-		HeightMin:  int32(params.HeightMin),
-		HeightMax:  int32(params.HeightMax),
-		Goal:       int32(params.Goal),
-		Parametres: make([]*profilespb.Preference, len(params.Preferences)), // нужно преобразовать
-	}
-
-	for i, p := range params.Preferences {
-		req.Parametres[i] = &profilespb.Preference{
-			Description: p.Description,
-			Value:       p.Value,
-		}
-	}
+	req := searchRequestToProto(params)
+	req.IDUser = int32(forUserId)
 
 	resp, err := gp.ProfilesService.SearchProfile(context.Background(), req)
+	if status.Code(err) == codes.InvalidArgument {
+		gp.logger.WithFields(&logrus.Fields{
+			"user_id": forUserId,
+			"error":   err,
+		}).Warn("GetSearchProfilesUseCase")
+		return model.FoundProfileResponse{}, model.ErrInvalidSearchRequest
+	}
 	if err != nil {
-		return nil, err
+		return model.FoundProfileResponse{}, err
 	}
 
 	var profs []model.FoundProfile
@@ -66,8 +60,88 @@ func (gp *SearchProfiles) GetSearchProfiles(forUserId int, params model.SearchPr
 			Fullname: match.Fullname,
 			Age:      int(match.Age),
 			Goal:     int(match.Goal),
+
+			NameHighlight: match.NameHighlight,
+			Snippet:       match.Snippet,
+			Verified:      match.Verified,
 		})
 	}
 
-	return profs, nil
+	found := model.FoundProfileResponse{
+		Profiles:   profs,
+		NextCursor: resp.NextCursor,
+	}
+	if resp.Total != nil {
+		total := int(resp.GetTotal())
+		found.Total = &total
+	}
+
+	if resp.Facets != nil {
+		found.Facets = &model.SearchFacets{
+			Goals:      facetsFromProto(resp.Facets.Goals),
+			AgeBuckets: facetsFromProto(resp.Facets.AgeBuckets),
+			Cities:     facetsFromProto(resp.Facets.Cities),
+			Interests:  facetsFromProto(resp.Facets.Interests),
+		}
+	}
+
+	return found, nil
+}
+
+func facetsFromProto(counts []*profilespb.FacetCount) []model.FacetCount {
+	res := make([]model.FacetCount, 0, len(counts))
+	for _, c := range counts {
+		res = append(res, model.FacetCount{Value: c.Value, Count: int(c.Count)})
+	}
+	return res
+}
+
+func searchRequestToProto(params model.SearchProfileRequest) *profilespb.SearchProfileRequest {
+	req := &profilespb.SearchProfileRequest{
+		Input:      params.Input,
+		IsMale:     params.IsMale,
+		AgeMin:     int32(params.AgeMin),
+		AgeMax:     int32(params.AgeMax),
+		HeightMin:  int32(params.HeightMin),
+		HeightMax:  int32(params.HeightMax),
+		Goal:       int32(params.Goal),
+		Country:    params.Country,
+		City:       params.City,
+		Cursor:     params.Cursor,
+		PageSize:   int32(params.PageSize),
+		SortBy:     params.SortBy,
+		Filter:     profilespb.FilterToProto(params.Filter),
+		Parametres: make([]*profilespb.Preference, len(params.Preferences)),
+	}
+
+	for i, p := range params.Preferences {
+		req.Parametres[i] = &profilespb.Preference{
+			Description: p.Description,
+			Value:       p.Value,
+		}
+	}
+	return req
+}
+
+func searchRequestFromProto(req *profilespb.SearchProfileRequest) model.SearchProfileRequest {
+	params := model.SearchProfileRequest{
+		Input:     req.GetInput(),
+		IsMale:    req.GetIsMale(),
+		AgeMin:    int(req.GetAgeMin()),
+		AgeMax:    int(req.GetAgeMax()),
+		HeightMin: int(req.GetHeightMin()),
+		HeightMax: int(req.GetHeightMax()),
+		Goal:      int(req.GetGoal()),
+		Country:   req.GetCountry(),
+		City:      req.GetCity(),
+		SortBy:    req.GetSortBy(),
+		Filter:    profilespb.FilterFromProto(req.GetFilter()),
+	}
+	for _, p := range req.GetParametres() {
+		params.Preferences = append(params.Preferences, model.Preference{
+			Description: p.GetDescription(),
+			Value:       p.GetValue(),
+		})
+	}
+	return params
 }
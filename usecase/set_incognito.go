@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/repository"
+
+	"github.com/sirupsen/logrus"
+)
+
+type SetIncognito struct {
+	subRepo repository.SubsriptionRepository
+	logger  *logger.LogrusLogger
+}
+
+func NewSetIncognitoUseCase(subRepo repository.SubsriptionRepository, logger *logger.LogrusLogger) (*SetIncognito, error) {
+	if subRepo == nil || logger == nil {
+		return nil, model.ErrSetIncognitoUC
+	}
+	return &SetIncognito{subRepo: subRepo, logger: logger}, nil
+}
+
+func (uc *SetIncognito) SetIncognito(userID int, enabled bool) error {
+	uc.logger.Info("SetIncognito", "userId", userID, "enabled", enabled)
+	err := uc.subRepo.SetIncognito(userID, enabled)
+	if err != nil {
+		uc.logger.WithFields(&logrus.Fields{"userId": userID, "error": err}).Error("SetIncognito")
+		return err
+	}
+	uc.logger.WithFields(&logrus.Fields{"userId": userID, "enabled": enabled}).Info("SetIncognito")
+	return nil
+}
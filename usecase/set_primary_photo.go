@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetPrimaryPhoto struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewSetPrimaryPhotoUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*SetPrimaryPhoto, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrPhotoOrderUC
+	}
+	return &SetPrimaryPhoto{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (sp *SetPrimaryPhoto) SetPrimaryPhoto(userId int, path string) error {
+	sp.logger.Info("SetPrimaryPhotoUseCase")
+
+	_, err := sp.ProfilesService.SetPrimaryPhoto(context.Background(), &profilespb.SetPrimaryPhotoRequest{
+		UserId: int32(userId),
+		Path:   path,
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrPhotoNotFound
+	}
+	if err != nil {
+		sp.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"path":    path,
+			"error":   err,
+		}).Error("SetPrimaryPhotoUseCase")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetSecondLook struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewSetSecondLookUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*SetSecondLook, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrSecondLookUC
+	}
+	return &SetSecondLook{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// SetSecondLook turns on or off whether profileId sees disliked profiles
+// again once the dislike expires.
+func (ss *SetSecondLook) SetSecondLook(profileId int, enabled bool) error {
+	ss.logger.Info("SetSecondLookUseCase")
+
+	_, err := ss.ProfilesService.SetSecondLook(context.Background(), &profilespb.SetSecondLookRequest{
+		ProfileId: int32(profileId),
+		Enabled:   enabled,
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrProfileNotFound
+	}
+	if err != nil {
+		ss.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"enabled":    enabled,
+			"error":      err,
+		}).Error("SetSecondLookUseCase")
+		return err
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
@@ -133,12 +135,19 @@ func (uc *UserSignUp) SaveUserProfile(sentProfile model.Profile) (int, error) {
 			Photos:      sentProfile.Photos,
 			LikedBy:     likedBy,
 			Preferences: prefs,
+			Prompts:     promptsToProto(sentProfile.Prompts),
 		},
 	}
 
 	res, err := uc.ProfilesService.StoreProfile(context.Background(), req)
-	uc.logger.WithFields(&logrus.Fields{"err": err, "profileId": int(res.GetProfileId())})
-	profileId := int(res.ProfileId)
+	if status.Code(err) == codes.InvalidArgument {
+		uc.logger.WithFields(&logrus.Fields{"err": err}).Warn("SaveUserProfile")
+		return -1, model.ErrInvalidPrompts
+	}
+	if err != nil {
+		uc.logger.WithFields(&logrus.Fields{"err": err}).Error("SaveUserProfile")
+		return -1, err
+	}
 
-	return profileId, nil
+	return int(res.ProfileId), nil
 }
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type StartVerification struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewStartVerificationUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*StartVerification, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrVerificationUC
+	}
+	return &StartVerification{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (sv *StartVerification) StartVerification(userId int) (model.VerificationChallenge, error) {
+	sv.logger.Info("StartVerificationUseCase")
+
+	resp, err := sv.ProfilesService.StartVerification(context.Background(), &profilespb.VerificationRequest{
+		UserId: int32(userId),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.AlreadyExists:
+		return model.VerificationChallenge{}, model.ErrAlreadyVerified
+	case codes.FailedPrecondition:
+		return model.VerificationChallenge{}, model.ErrVerificationPending
+	default:
+		sv.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("StartVerificationUseCase")
+		return model.VerificationChallenge{}, err
+	}
+
+	return model.VerificationChallenge{
+		RequestId:   int(resp.RequestId),
+		Pose:        resp.Pose,
+		Instruction: resp.Instruction,
+		UploadURL:   resp.UploadUrl,
+		ExpiresAt:   resp.ExpiresAt.AsTime(),
+		MaxSize:     resp.MaxSize,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SubmitVerification struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewSubmitVerificationUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*SubmitVerification, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrVerificationUC
+	}
+	return &SubmitVerification{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+func (sv *SubmitVerification) SubmitVerification(userId int) (model.VerificationStatus, error) {
+	sv.logger.Info("SubmitVerificationUseCase")
+
+	resp, err := sv.ProfilesService.SubmitVerification(context.Background(), &profilespb.VerificationRequest{
+		UserId: int32(userId),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.FailedPrecondition:
+		return model.VerificationStatus{}, model.ErrVerificationNotFound
+	case codes.NotFound:
+		return model.VerificationStatus{}, model.ErrUploadNotFound
+	case codes.InvalidArgument:
+		return model.VerificationStatus{}, model.ErrInvalidUpload
+	default:
+		sv.logger.WithFields(&logrus.Fields{
+			"user_id": userId,
+			"error":   err,
+		}).Error("SubmitVerificationUseCase")
+		return model.VerificationStatus{}, err
+	}
+
+	return verificationFromProto(resp), nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnblockProfile struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewUnblockProfileUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*UnblockProfile, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrBlockUC
+	}
+	return &UnblockProfile{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// UnblockProfile lifts a block. The block ended the pair's match, so the
+// chat it closed stays read-only until they match again.
+func (ub *UnblockProfile) UnblockProfile(profileId int, blockedId int) error {
+	ub.logger.Info("UnblockProfileUseCase")
+
+	_, err := ub.ProfilesService.UnblockProfile(context.Background(), &profilespb.UnblockProfileRequest{
+		ProfileId: int32(profileId),
+		BlockedId: int32(blockedId),
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrBlockNotFound
+	}
+	if err != nil {
+		ub.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"blocked_id": blockedId,
+			"error":      err,
+		}).Error("UnblockProfileUseCase")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/logger"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
+	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Unmatch struct {
+	ProfilesService profilespb.ProfilesServiceClient
+	logger          *logger.LogrusLogger
+}
+
+func NewUnmatchUseCase(
+	ProfilesService profilespb.ProfilesServiceClient,
+	logger *logger.LogrusLogger,
+) (*Unmatch, error) {
+	if ProfilesService == nil || logger == nil {
+		return nil, model.ErrBlockUC
+	}
+	return &Unmatch{ProfilesService: ProfilesService, logger: logger}, nil
+}
+
+// Unmatch ends the match between two profiles. The profiles service queues
+// their chat to be closed: it is kept for reference but takes no new
+// messages.
+func (um *Unmatch) Unmatch(profileId int, matchedId int, reason string) error {
+	um.logger.Info("UnmatchUseCase")
+
+	_, err := um.ProfilesService.Unmatch(context.Background(), &profilespb.UnmatchRequest{
+		ProfileId: int32(profileId),
+		MatchedId: int32(matchedId),
+		Reason:    reason,
+	})
+	if status.Code(err) == codes.NotFound {
+		return model.ErrMatchNotFound
+	}
+	if err != nil {
+		um.logger.WithFields(&logrus.Fields{
+			"profile_id": profileId,
+			"matched_id": matchedId,
+			"error":      err,
+		}).Error("UnmatchUseCase")
+		return err
+	}
+
+	return nil
+}
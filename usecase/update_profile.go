@@ -7,6 +7,8 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -62,6 +64,8 @@ func (pu *ProfileUpdate) UpdateProfile(value model.Profile, targ model.Profile,
 		LikedBy:     valueLikedBy,
 		Preferences: valuePrefs,
 		Photos:      value.Photos,
+		Prompts:     promptsToProto(value.Prompts),
+		Timezone:    value.Timezone,
 	}
 
 	var targLikedBy []int32
@@ -105,9 +109,14 @@ func (pu *ProfileUpdate) UpdateProfile(value model.Profile, targ model.Profile,
 		Value:     profValue,
 		Targ:      targValue,
 		ProfileId: int32(profileId),
+		// A request without "prompts" keeps them; an empty list clears them.
+		SetPrompts: value.Prompts != nil,
 	}
 
 	_, err := pu.ProfilesService.UpdateProfile(context.Background(), req)
+	if status.Code(err) == codes.InvalidArgument {
+		return model.ErrInvalidPrompts
+	}
 
 	pu.logger.WithFields(&logrus.Fields{
 		"value":     value,
@@ -7,6 +7,8 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/model"
 	profilespb "github.com/go-park-mail-ru/2025_1_ProVVeb/profiles_micro/delivery"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type StaticUpload struct {
@@ -24,7 +26,9 @@ func NewStaticUploadUseCase(
 	return &StaticUpload{ProfilesService: ProfilesService, logger: logger}, nil
 }
 
-func (su *StaticUpload) UploadUserPhoto(user_id int, file []byte, filename string, content_type string) error {
+// UploadUserPhoto uploads a photo and returns the path it was stored under,
+// which differs from filename once the size variants are generated.
+func (su *StaticUpload) UploadUserPhoto(user_id int, file []byte, filename string, content_type string) (string, error) {
 	su.logger.Info("StaticUploadUseCase")
 	req := &profilespb.UploadProfileImageRequest{
 		UserId:      int32(user_id),
@@ -32,9 +36,22 @@ func (su *StaticUpload) UploadUserPhoto(user_id int, file []byte, filename strin
 		Filename:    filename,
 		ContentType: content_type,
 	}
-	_, err := su.ProfilesService.UploadProfileImage(context.Background(), req)
+	resp, err := su.ProfilesService.UploadProfileImage(context.Background(), req)
 	su.logger.WithFields(&logrus.Fields{
 		"upload profile image err": err,
 	})
-	return err
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return "", model.ErrUnsupportedImage
+	case codes.ResourceExhausted:
+		return "", model.ErrTooManyPhotos
+	case codes.FailedPrecondition:
+		return "", model.ErrLowQualityPhoto
+	case codes.AlreadyExists:
+		return "", model.ErrDuplicatePhoto
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp.GetPath(), nil
 }
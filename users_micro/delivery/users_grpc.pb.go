@@ -29,6 +29,9 @@ type UsersServiceClient interface {
 	GetPremium(ctx context.Context, in *GetPremiumRequest, opts ...grpc.CallOption) (*GetPremiumResponse, error)
 	SetPremium(ctx context.Context, in *SetPremiumRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error)
+	GetPause(ctx context.Context, in *GetPauseRequest, opts ...grpc.CallOption) (*PauseStatus, error)
+	PauseAccount(ctx context.Context, in *PauseAccountRequest, opts ...grpc.CallOption) (*PauseStatus, error)
+	ResumeAccount(ctx context.Context, in *ResumeAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type usersServiceClient struct {
@@ -129,6 +132,33 @@ func (c *usersServiceClient) GetAdmin(ctx context.Context, in *GetAdminRequest,
 	return out, nil
 }
 
+func (c *usersServiceClient) GetPause(ctx context.Context, in *GetPauseRequest, opts ...grpc.CallOption) (*PauseStatus, error) {
+	out := new(PauseStatus)
+	err := c.cc.Invoke(ctx, "/users.UsersService/GetPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) PauseAccount(ctx context.Context, in *PauseAccountRequest, opts ...grpc.CallOption) (*PauseStatus, error) {
+	out := new(PauseStatus)
+	err := c.cc.Invoke(ctx, "/users.UsersService/PauseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ResumeAccount(ctx context.Context, in *ResumeAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/users.UsersService/ResumeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UsersServiceServer is the server API for UsersService service.
 // All implementations must embed UnimplementedUsersServiceServer
 // for forward compatibility
@@ -143,6 +173,9 @@ type UsersServiceServer interface {
 	GetPremium(context.Context, *GetPremiumRequest) (*GetPremiumResponse, error)
 	SetPremium(context.Context, *SetPremiumRequest) (*emptypb.Empty, error)
 	GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error)
+	GetPause(context.Context, *GetPauseRequest) (*PauseStatus, error)
+	PauseAccount(context.Context, *PauseAccountRequest) (*PauseStatus, error)
+	ResumeAccount(context.Context, *ResumeAccountRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUsersServiceServer()
 }
 
@@ -180,6 +213,15 @@ func (UnimplementedUsersServiceServer) SetPremium(context.Context, *SetPremiumRe
 func (UnimplementedUsersServiceServer) GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdmin not implemented")
 }
+func (UnimplementedUsersServiceServer) GetPause(context.Context, *GetPauseRequest) (*PauseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPause not implemented")
+}
+func (UnimplementedUsersServiceServer) PauseAccount(context.Context, *PauseAccountRequest) (*PauseStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAccount not implemented")
+}
+func (UnimplementedUsersServiceServer) ResumeAccount(context.Context, *ResumeAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAccount not implemented")
+}
 func (UnimplementedUsersServiceServer) mustEmbedUnimplementedUsersServiceServer() {}
 
 // UnsafeUsersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -373,6 +415,60 @@ func _UsersService_GetAdmin_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_GetPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.UsersService/GetPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetPause(ctx, req.(*GetPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_PauseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).PauseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.UsersService/PauseAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).PauseAccount(ctx, req.(*PauseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ResumeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ResumeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.UsersService/ResumeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ResumeAccount(ctx, req.(*ResumeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UsersService_ServiceDesc is the grpc.ServiceDesc for UsersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,6 +516,18 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdmin",
 			Handler:    _UsersService_GetAdmin_Handler,
 		},
+		{
+			MethodName: "GetPause",
+			Handler:    _UsersService_GetPause_Handler,
+		},
+		{
+			MethodName: "PauseAccount",
+			Handler:    _UsersService_PauseAccount_Handler,
+		},
+		{
+			MethodName: "ResumeAccount",
+			Handler:    _UsersService_ResumeAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "users.proto",
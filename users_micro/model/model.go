@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"time"
 )
 
 type User struct {
@@ -18,6 +19,15 @@ var MaxPasswordLength = 64
 var MinLoginLength = 7
 var MaxLoginLength = 25
 
+// Account states kept in users.status.
+const (
+	UserActive = 1
+	UserPaused = 2
+)
+
+// MaxPauseDuration bounds how far ahead an automatic resume can be set.
+const MaxPauseDuration = 365 * 24 * time.Hour
+
 var PageSize = 10
 var MaxFileSize int64 = 10 << 20
 
@@ -41,4 +51,5 @@ var (
 	ErrInvalidLoginSize      = errors.New("invalid login size")
 	ErrInvalidPassword       = errors.New("invalid password")
 	ErrInvalidPasswordSize   = errors.New("invalid password size")
+	ErrUserNotFound          = errors.New("user not found")
 )
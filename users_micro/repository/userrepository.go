@@ -30,6 +30,10 @@ type UserRepository interface {
 	GetAdmin(userID int) (bool, error)
 
 	GetPremium(userID int) (bool, int, *time.Time, error)
+	IsVerified(userID int) (bool, error)
+
+	GetPause(userID int) (bool, *time.Time, error)
+	SetPause(userID int, paused bool, resumeAt *time.Time) error
 
 	CloseRepo() error
 }
@@ -152,6 +156,22 @@ func (r *UserRepo) GetPremium(userID int) (bool, int, *time.Time, error) {
 	return true, subType, expiresAt, nil
 }
 
+const IsVerifiedQuery = `
+SELECT p.is_verified
+FROM users u
+JOIN profiles p ON p.profile_id = u.profile_id
+WHERE u.user_id = $1;
+`
+
+func (r *UserRepo) IsVerified(userID int) (bool, error) {
+	var verified bool
+	err := r.DB.QueryRow(context.Background(), IsVerifiedQuery, userID).Scan(&verified)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	return verified, err
+}
+
 const GetUserByLoginQuery = `
 SELECT 
 	u.user_id, 
@@ -304,6 +324,54 @@ func (ur *UserRepo) GetAdmin(userID int) (bool, error) {
 	return exists, err
 }
 
+const (
+	GetPauseQuery = `
+SELECT
+    u.status = 2 AND (u.paused_until IS NULL OR u.paused_until > NOW()),
+    CASE WHEN u.status = 2 AND u.paused_until > NOW() THEN u.paused_until END
+FROM users u
+WHERE u.user_id = $1;
+`
+
+	SetPauseQuery = `
+UPDATE users
+SET status = $2, paused_until = $3, updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $1;
+`
+)
+
+// GetPause reports whether the account is paused and when it resumes on its
+// own, if ever. A pause whose resume date has passed counts as resumed.
+func (ur *UserRepo) GetPause(userID int) (bool, *time.Time, error) {
+	var paused bool
+	var resumeAt *time.Time
+
+	err := ur.DB.QueryRow(context.Background(), GetPauseQuery, userID).Scan(&paused, &resumeAt)
+	if err == pgx.ErrNoRows {
+		return false, nil, model.ErrUserNotFound
+	}
+	return paused, resumeAt, err
+}
+
+// SetPause pauses or resumes the account. resumeAt is ignored on resume.
+func (ur *UserRepo) SetPause(userID int, paused bool, resumeAt *time.Time) error {
+	status := model.UserActive
+	if paused {
+		status = model.UserPaused
+	} else {
+		resumeAt = nil
+	}
+
+	tag, err := ur.DB.Exec(context.Background(), SetPauseQuery, userID, status, resumeAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
 func (ur *UserRepo) CloseRepo() error {
 	pool, ok := ur.DB.(*pgxpool.Pool)
 	if !ok {
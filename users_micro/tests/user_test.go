@@ -2,9 +2,12 @@ package repository
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/model"
 	"github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -208,3 +211,45 @@ func TestUserRepo_GetAdmin(t *testing.T) {
 
 	mockDB.AssertExpectations(t)
 }
+
+func TestUserRepo_GetPause(t *testing.T) {
+	mockDB := new(MockDB)
+	repo := &repository.UserRepo{DB: mockDB}
+
+	resumeAt := time.Now().Add(24 * time.Hour)
+
+	mockDB.On("QueryRow", mock.Anything, repository.GetPauseQuery, []interface{}{8}).
+		Return(&MockRow{data: []interface{}{true, &resumeAt}})
+	mockDB.On("QueryRow", mock.Anything, repository.GetPauseQuery, []interface{}{9}).
+		Return(&MockRow{err: pgx.ErrNoRows})
+
+	paused, gotResumeAt, err := repo.GetPause(8)
+	assert.NoError(t, err)
+	assert.True(t, paused)
+	assert.Equal(t, &resumeAt, gotResumeAt)
+
+	_, _, err = repo.GetPause(9)
+	assert.Equal(t, model.ErrUserNotFound, err)
+
+	mockDB.AssertExpectations(t)
+}
+
+func TestUserRepo_SetPause(t *testing.T) {
+	mockDB := new(MockDB)
+	repo := &repository.UserRepo{DB: mockDB}
+
+	resumeAt := time.Now().Add(24 * time.Hour)
+
+	mockDB.On("Exec", mock.Anything, repository.SetPauseQuery, []interface{}{8, model.UserPaused, &resumeAt}).
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	mockDB.On("Exec", mock.Anything, repository.SetPauseQuery, []interface{}{8, model.UserActive, (*time.Time)(nil)}).
+		Return(pgconn.NewCommandTag("UPDATE 1"), nil)
+	mockDB.On("Exec", mock.Anything, repository.SetPauseQuery, []interface{}{9, model.UserActive, (*time.Time)(nil)}).
+		Return(pgconn.NewCommandTag("UPDATE 0"), nil)
+
+	assert.NoError(t, repo.SetPause(8, true, &resumeAt))
+	assert.NoError(t, repo.SetPause(8, false, &resumeAt))
+	assert.Equal(t, model.ErrUserNotFound, repo.SetPause(9, false, nil))
+
+	mockDB.AssertExpectations(t)
+}
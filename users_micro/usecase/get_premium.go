@@ -5,24 +5,36 @@ import (
 	"time"
 
 	users "github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/delivery"
+	"github.com/sirupsen/logrus"
 )
 
 func (uss *UserServiceServer) GetPremium(ctx context.Context, req *users.GetPremiumRequest) (*users.GetPremiumResponse, error) {
 	uss.Logger.Info("GetPremium", "UserId", req.UserId)
 
 	hasSub, subType, expiresAt, err := uss.UserRepo.GetPremium(int(req.UserId))
+	if err != nil {
+		return &users.GetPremiumResponse{UserId: req.UserId}, err
+	}
+
+	verified, err := uss.UserRepo.IsVerified(int(req.UserId))
+	if err != nil {
+		uss.Logger.WithFields(&logrus.Fields{"user_id": req.UserId, "error": err}).Error("GetPremium")
+		return &users.GetPremiumResponse{UserId: req.UserId}, err
+	}
 
 	if !hasSub || expiresAt == nil || time.Now().After(*expiresAt) {
 		return &users.GetPremiumResponse{
 			UserId:     req.UserId,
 			IsSubsribe: false,
 			Type:       0,
-		}, err
+			IsVerified: verified,
+		}, nil
 	}
 
 	return &users.GetPremiumResponse{
 		UserId:     req.UserId,
 		IsSubsribe: true,
 		Type:       int32(subType),
-	}, err
+		IsVerified: verified,
+	}, nil
 }
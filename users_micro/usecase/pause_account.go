@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	users "github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/delivery"
+	"github.com/go-park-mail-ru/2025_1_ProVVeb/users_micro/model"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func pauseToProto(paused bool, resumeAt *time.Time) *users.PauseStatus {
+	resp := &users.PauseStatus{Paused: paused}
+	if paused && resumeAt != nil {
+		resp.ResumeAt = timestamppb.New(*resumeAt)
+	}
+	return resp
+}
+
+func (uss *UserServiceServer) GetPause(ctx context.Context, req *users.GetPauseRequest) (*users.PauseStatus, error) {
+	uss.Logger.Info("GetPause", "userId", req.UserId)
+
+	paused, resumeAt, err := uss.UserRepo.GetPause(int(req.UserId))
+	if err == model.ErrUserNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		uss.Logger.WithFields(&logrus.Fields{"userId": req.UserId, "error": err}).Error("GetPause")
+		return nil, status.Errorf(codes.Internal, "failed to get pause: %v", err)
+	}
+
+	return pauseToProto(paused, resumeAt), nil
+}
+
+// PauseAccount hides the account from everyone else until it is resumed or,
+// when resume_at is set, until then. Pausing a paused account replaces its
+// resume date.
+func (uss *UserServiceServer) PauseAccount(ctx context.Context, req *users.PauseAccountRequest) (*users.PauseStatus, error) {
+	uss.Logger.Info("PauseAccount", "userId", req.UserId)
+
+	var resumeAt *time.Time
+	if req.ResumeAt != nil {
+		at := req.ResumeAt.AsTime()
+		now := time.Now()
+		if !at.After(now) || at.After(now.Add(model.MaxPauseDuration)) {
+			return nil, status.Errorf(codes.InvalidArgument, "resume date must be within %v from now", model.MaxPauseDuration)
+		}
+		resumeAt = &at
+	}
+
+	err := uss.UserRepo.SetPause(int(req.UserId), true, resumeAt)
+	if err == model.ErrUserNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		uss.Logger.WithFields(&logrus.Fields{"userId": req.UserId, "error": err}).Error("PauseAccount")
+		return nil, status.Errorf(codes.Internal, "failed to pause account: %v", err)
+	}
+
+	return pauseToProto(true, resumeAt), nil
+}
+
+func (uss *UserServiceServer) ResumeAccount(ctx context.Context, req *users.ResumeAccountRequest) (*emptypb.Empty, error) {
+	uss.Logger.Info("ResumeAccount", "userId", req.UserId)
+
+	err := uss.UserRepo.SetPause(int(req.UserId), false, nil)
+	if err == model.ErrUserNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		uss.Logger.WithFields(&logrus.Fields{"userId": req.UserId, "error": err}).Error("ResumeAccount")
+		return nil, status.Errorf(codes.Internal, "failed to resume account: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -19,7 +19,7 @@ func (uss *UserServiceServer) SaveUserData(
 		Password: uss.UserRepo.Hash(req.User.Login + "_" + req.User.Password),
 		Email:    req.User.Email,
 		Phone:    req.User.Phone,
-		Status:   int(req.User.Status),
+		Status:   model.UserActive,
 	}
 	userId, err := uss.UserRepo.StoreUser(user)
 	uss.Logger.WithFields(&logrus.Fields{"userId": req.UserId, "error": err})